@@ -24,7 +24,6 @@ const (
 	VideoService_UploadVideo_FullMethodName                     = "/video.VideoService/UploadVideo"
 	VideoService_BatchGetVideoInfo_FullMethodName               = "/video.VideoService/BatchGetVideoInfo"
 	VideoService_CheckVideoExists_FullMethodName                = "/video.VideoService/CheckVideoExists"
-	VideoService_CalcVideoScore_FullMethodName                  = "/video.VideoService/CalcVideoScore"
 	VideoService_GetVideoFavoriteAndCommentCount_FullMethodName = "/video.VideoService/GetVideoFavoriteAndCommentCount"
 )
 
@@ -42,7 +41,6 @@ type VideoServiceClient interface {
 	BatchGetVideoInfo(ctx context.Context, in *BatchGetVideoInfoRequest, opts ...grpc.CallOption) (*BatchGetVideoInfoReply, error)
 	// 检查视频是否存在
 	CheckVideoExists(ctx context.Context, in *CheckVideoExistsRequest, opts ...grpc.CallOption) (*CheckVideoExistsReply, error)
-	CalcVideoScore(ctx context.Context, in *CalcVideoScoreRequest, opts ...grpc.CallOption) (*CalcVideoScoreReply, error)
 	GetVideoFavoriteAndCommentCount(ctx context.Context, in *GetVideoFavoriteAndCommentCountRequest, opts ...grpc.CallOption) (*GetVideoFavoriteAndCommentCountReply, error)
 }
 
@@ -104,16 +102,6 @@ func (c *videoServiceClient) CheckVideoExists(ctx context.Context, in *CheckVide
 	return out, nil
 }
 
-func (c *videoServiceClient) CalcVideoScore(ctx context.Context, in *CalcVideoScoreRequest, opts ...grpc.CallOption) (*CalcVideoScoreReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CalcVideoScoreReply)
-	err := c.cc.Invoke(ctx, VideoService_CalcVideoScore_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
 func (c *videoServiceClient) GetVideoFavoriteAndCommentCount(ctx context.Context, in *GetVideoFavoriteAndCommentCountRequest, opts ...grpc.CallOption) (*GetVideoFavoriteAndCommentCountReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVideoFavoriteAndCommentCountReply)
@@ -138,7 +126,6 @@ type VideoServiceServer interface {
 	BatchGetVideoInfo(context.Context, *BatchGetVideoInfoRequest) (*BatchGetVideoInfoReply, error)
 	// 检查视频是否存在
 	CheckVideoExists(context.Context, *CheckVideoExistsRequest) (*CheckVideoExistsReply, error)
-	CalcVideoScore(context.Context, *CalcVideoScoreRequest) (*CalcVideoScoreReply, error)
 	GetVideoFavoriteAndCommentCount(context.Context, *GetVideoFavoriteAndCommentCountRequest) (*GetVideoFavoriteAndCommentCountReply, error)
 	mustEmbedUnimplementedVideoServiceServer()
 }
@@ -165,9 +152,6 @@ func (UnimplementedVideoServiceServer) BatchGetVideoInfo(context.Context, *Batch
 func (UnimplementedVideoServiceServer) CheckVideoExists(context.Context, *CheckVideoExistsRequest) (*CheckVideoExistsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckVideoExists not implemented")
 }
-func (UnimplementedVideoServiceServer) CalcVideoScore(context.Context, *CalcVideoScoreRequest) (*CalcVideoScoreReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcVideoScore not implemented")
-}
 func (UnimplementedVideoServiceServer) GetVideoFavoriteAndCommentCount(context.Context, *GetVideoFavoriteAndCommentCountRequest) (*GetVideoFavoriteAndCommentCountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoFavoriteAndCommentCount not implemented")
 }
@@ -282,24 +266,6 @@ func _VideoService_CheckVideoExists_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_CalcVideoScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalcVideoScoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(VideoServiceServer).CalcVideoScore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: VideoService_CalcVideoScore_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).CalcVideoScore(ctx, req.(*CalcVideoScoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
 func _VideoService_GetVideoFavoriteAndCommentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVideoFavoriteAndCommentCountRequest)
 	if err := dec(in); err != nil {
@@ -345,10 +311,6 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckVideoExists",
 			Handler:    _VideoService_CheckVideoExists_Handler,
 		},
-		{
-			MethodName: "CalcVideoScore",
-			Handler:    _VideoService_CalcVideoScore_Handler,
-		},
 		{
 			MethodName: "GetVideoFavoriteAndCommentCount",
 			Handler:    _VideoService_GetVideoFavoriteAndCommentCount_Handler,
@@ -44,7 +44,9 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, re
 	grpcServer := server.NewGRPCServer(confServer, commentService, logger)
 	httpServer := server.NewHTTPServer(confServer, commentService, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, httpServer, registrar)
+	relay := data.NewOutboxRelay(confData, db, logger)
+	checker := data.NewCounterChecker(confData, dataData, logger)
+	app := newApp(logger, grpcServer, httpServer, registrar, relay, checker)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -16,6 +16,19 @@ type CommentRepo interface {
 	GetCommentList(ctx context.Context, videoId, page, pageSize int64) ([]*model.Comment, error)
 }
 
+const (
+	// 评论事件类型，由 video-service 消费维护评论数和分数
+	EventCommentCreated = "CommentCreated"
+	EventCommentDeleted = "CommentDeleted"
+)
+
+// CommentEvent 发表、删除评论事件
+type CommentEvent struct {
+	VideoID   int64 `json:"video_id"`
+	UserID    int64 `json:"user_id"`
+	CommentID int64 `json:"comment_id"`
+}
+
 type CommentUsecase struct {
 	repo CommentRepo
 	log  *log.Helper
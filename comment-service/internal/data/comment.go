@@ -9,13 +9,13 @@ import (
 	"comment-service/internal/data/query"
 	middleware "comment-service/internal/pkg/middle"
 	"comment-service/internal/pkg/tracing"
+	"common/outbox"
 	"context"
 	"errors"
 	"fmt"
-	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
-	"time"
+	"strconv"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -120,14 +120,12 @@ func (c *commentRepo) CreateComment(ctx context.Context, req *param.CreateCommen
 			return err
 		}
 
-		if _, err = txQuery.Video.
-			WithContext(ctx).
-			Where(txQuery.Video.ID.Eq(req.VideoId)).
-			UpdateSimple(txQuery.Video.CommentCnt.Add(1)); err != nil {
-			return err
-		}
-		return nil
-
+		// 评论数由 video-service 消费事件更新
+		return c.addCommentEvent(tx, biz.EventCommentCreated, biz.CommentEvent{
+			VideoID:   req.VideoId,
+			UserID:    req.UserID,
+			CommentID: cid,
+		})
 	})
 
 	if err != nil {
@@ -135,89 +133,29 @@ func (c *commentRepo) CreateComment(ctx context.Context, req *param.CreateCommen
 		return nil, err
 	}
 
-	keyVideoComment := fmt.Sprintf("video:comment:%d", req.VideoId)
-
-	ctxCache, spanCache := tracing.StartSpan(ctx, "Redis.CheckCache")
-	if err = c.checkVideoCommentInCache(ctxCache, keyVideoComment, req.VideoId); err != nil {
-		spanCache.RecordError(err)
-		spanCache.End()
-		return nil, err
-	}
-	spanCache.End()
-
-	// 更新到redis
-	ctxIncr, spanIncr := tracing.StartSpan(ctx, "Redis.Incr")
-	if err := c.data.rdb.Incr(ctxIncr, keyVideoComment).Err(); err != nil {
+	// 更新到redis，评论与事件已经提交，计数更新失败时只记录日志不返回错误
+	ctxIncr, spanIncr := tracing.StartSpan(ctx, "Redis.IncrCounter")
+	if err := c.data.comments.Incr(ctxIncr, req.VideoId, 1); err != nil {
 		spanIncr.RecordError(err)
-		spanIncr.End()
-		return nil, err
+		c.log.WithContext(ctx).Errorf("incr comment counter error for vid=%d: %v", req.VideoId, err)
+		c.dropCommentCounter(ctxIncr, req.VideoId)
 	}
 	spanIncr.End()
 
-	ctxScore, spanScore := tracing.StartSpan(ctx, "UpdateVideoScoreAfterLike")
-	err = c.UpdateVideoScoreAfterLike(ctxScore, req.VideoId)
-	if err != nil {
-		spanScore.RecordError(err)
-		spanScore.End()
-		return nil, err
-	}
-	spanScore.End()
-
 	return &param.CreateCommentResponse{
 		CommentID: cid,
 		Message:   "create comment success",
 	}, nil
 }
 
-// UpdateVideoScoreAfterLike 更新分数
-func (r *commentRepo) UpdateVideoScoreAfterLike(ctx context.Context, videoID int64) error {
-	video, err := r.data.VideoClient.GetVideoFavoriteAndCommentCount(ctx, &pbVideo.GetVideoFavoriteAndCommentCountRequest{VideoId: videoID}) // 获取点赞数、评论数、上传时间
-	if err != nil {
-		r.log.Errorf("GetVideo error: %v", err)
-		return err
-	}
-	score, err := r.data.VideoClient.CalcVideoScore(ctx, &pbVideo.CalcVideoScoreRequest{
-		FavoriteCount: video.FavoriteCount,
-		CommentCount:  video.CommentCount,
-		UploadTime:    video.UploadTime,
+// addCommentEvent 在评论事务中写入事件，以视频 id 作为聚合键
+func (c *commentRepo) addCommentEvent(tx *gorm.DB, eventType string, event biz.CommentEvent) error {
+	return outbox.Add(tx, outbox.Event{
+		Topic: c.data.videoEventTopic,
+		Key:   strconv.FormatInt(event.VideoID, 10),
+		Type:  eventType,
+		Data:  event,
 	})
-	if err != nil {
-		r.log.Errorf("CalcVideoScore error: %v", err)
-		return err
-	}
-	err = r.data.rdb.ZAdd(ctx, "video:score", redis.Z{
-		Score:  float64(score.Score),
-		Member: videoID,
-	}).Err()
-	if err != nil {
-		r.log.Errorf("ZAdd video:score error: %v", err)
-		return err
-	}
-	return nil
-}
-
-func (c *commentRepo) checkVideoCommentInCache(ctx context.Context, keyVideoComment string, vid int64) error {
-	val, err := c.data.rdb.Exists(ctx, keyVideoComment).Result()
-	if err != nil {
-		return err
-	}
-
-	if val == 0 {
-		var cnt int64
-		err = c.data.query.
-			WithContext(ctx).
-			Video.
-			Where(query.Video.ID.Eq(vid)).Select(query.Video.CommentCnt).
-			Scan(&cnt)
-		if err != nil {
-			return err
-		}
-		err = c.data.rdb.Set(ctx, keyVideoComment, cnt, 24*time.Hour).Err()
-		if err != nil {
-			return err
-		}
-	}
-	return nil
 }
 
 // DeleteComment 删除评论
@@ -229,53 +167,55 @@ func (c *commentRepo) DeleteComment(ctx context.Context, commentID, vid int64) e
 	if !exist {
 		return errors.New("COMMENT_NOT_FOUND ,comment not found or already deleted")
 	}
+	var deleted bool
+	var videoID int64
 	err = c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txQuery := query.Use(tx)
-		_, err = txQuery.Comment.
+		comment, err := txQuery.Comment.
 			WithContext(ctx).
-			Where(c.data.query.Comment.ID.Eq(commentID)).
-			Update(c.data.query.Comment.IsDeleted, true)
+			Where(txQuery.Comment.ID.Eq(commentID)).
+			First()
 		if err != nil {
 			return err
 		}
-		if _, err := txQuery.Video.
+		result, err := txQuery.Comment.
 			WithContext(ctx).
-			Where(txQuery.Video.ID.Eq(vid)).
-			UpdateSimple(txQuery.Video.CommentCnt.Sub(1)); err != nil {
+			Where(txQuery.Comment.ID.Eq(commentID), txQuery.Comment.IsDeleted.Is(false)).
+			Update(txQuery.Comment.IsDeleted, true)
+		if err != nil {
 			return err
 		}
-		return nil
+		// 并发删除时只有一次生效
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		deleted, videoID = true, comment.VideoID
+		return c.addCommentEvent(tx, biz.EventCommentDeleted, biz.CommentEvent{
+			VideoID:   comment.VideoID,
+			UserID:    comment.UserID,
+			CommentID: commentID,
+		})
 	})
 	if err != nil {
 		return err
 	}
-	keyVideoComment := fmt.Sprintf("video:comment:%d", vid)
-	if err = c.checkVideoCommentInCache(ctx, keyVideoComment, vid); err != nil {
-		return err
-	}
-
-	// 更新到redis
-	if err := c.data.rdb.Decr(ctx, keyVideoComment).Err(); err != nil {
-		return err
+	if !deleted {
+		return nil
 	}
-
-	// 可选: 防止负数出现
-	val, err := c.data.rdb.Get(ctx, keyVideoComment).Int64()
-	if err != nil {
-		return err
-	}
-	if val < 0 {
-		if err := c.data.rdb.Set(ctx, keyVideoComment, 0, 0).Err(); err != nil {
-			return err
-		}
+	// 更新到redis，计数不会小于 0；以评论所属的视频为准，不信任调用方传入的 vid
+	// 删除已经提交，计数更新失败时只记录日志不返回错误
+	if err := c.data.comments.Incr(ctx, videoID, -1); err != nil {
+		c.log.WithContext(ctx).Errorf("decr comment counter error for vid=%d: %v", videoID, err)
+		c.dropCommentCounter(ctx, videoID)
 	}
+	return nil
+}
 
-	err = c.UpdateVideoScoreAfterLike(ctx, vid)
-	if err != nil {
-		return err
+// dropCommentCounter 评论数更新失败时删除缓存，下次读取时回源；删除也失败时由漂移检查修正
+func (c *commentRepo) dropCommentCounter(ctx context.Context, vid int64) {
+	if err := c.data.comments.Delete(ctx, vid); err != nil {
+		c.log.WithContext(ctx).Errorf("drop comment counter error for vid=%d: %v", vid, err)
 	}
-
-	return nil
 }
 
 func (c *commentRepo) CheckVideoExist(ctx context.Context, videoId int64) (bool, error) {
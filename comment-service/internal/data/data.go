@@ -4,6 +4,8 @@ import (
 	"comment-service/internal/conf"
 	"comment-service/internal/data/query"
 	"comment-service/internal/pkg"
+	"common/counter"
+	"common/outbox"
 	"context"
 	"errors"
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
@@ -25,7 +27,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewCommentRepo, NewDB, NewRedisClient, NewDiscover, NewUserServiceClient, NewVideoServiceClient)
+var ProviderSet = wire.NewSet(NewData, NewCommentRepo, NewDB, NewRedisClient, NewDiscover, NewUserServiceClient, NewVideoServiceClient, NewOutboxRelay, NewCounterChecker)
 
 // Data .
 type Data struct {
@@ -35,9 +37,13 @@ type Data struct {
 	rdb *redis.Client
 	idg *pkg.IDGenerator
 
+	comments *counter.Counter // 视频评论数缓存
+
 	query       *query.Query
 	UserClient  pbUser.UserServiceClient
 	VideoClient pbVideo.VideoServiceClient
+
+	videoEventTopic string
 }
 
 // NewData .
@@ -55,9 +61,56 @@ func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, cu
 		UserClient:  cu,
 		VideoClient: cv,
 		idg:         idg,
+		comments:    counter.New(rdb, counter.VideoComment, commentLoader(db), c.GetCounter().GetTtl().AsDuration()),
+
+		videoEventTopic: c.GetKafka().GetVideoEventTopic(),
 	}, cleanup, nil
 }
 
+// commentLoader 按评论表统计未删除的评论数，回复也计入
+func commentLoader(db *gorm.DB) counter.Loader {
+	return func(ctx context.Context, ids []int64) (map[int64]int64, error) {
+		var rows []struct {
+			VideoID int64
+			Cnt     int64
+		}
+		err := db.WithContext(ctx).
+			Table("comment").
+			Select("video_id, COUNT(*) AS cnt").
+			Where("video_id IN ?", ids).
+			Where("is_deleted = 0").
+			Group("video_id").
+			Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[int64]int64, len(rows))
+		for _, r := range rows {
+			res[r.VideoID] = r.Cnt
+		}
+		return res, nil
+	}
+}
+
+// NewCounterChecker 定期检查评论数缓存与评论表是否一致
+func NewCounterChecker(c *conf.Data, d *Data, logger log.Logger) *counter.Checker {
+	return counter.NewChecker(counter.CheckerConfig{
+		Interval:  c.GetCounter().GetCheckInterval().AsDuration(),
+		BatchSize: int(c.GetCounter().GetCheckBatchSize()),
+		Fix:       c.GetCounter().GetCheckFix(),
+	}, logger, d.comments)
+}
+
+// NewOutboxRelay 发布 outbox 中的事件
+func NewOutboxRelay(c *conf.Data, db *gorm.DB, logger log.Logger) *outbox.Relay {
+	return outbox.NewRelay(db, outbox.Config{
+		Brokers:   c.GetKafka().GetBrokers(),
+		Interval:  c.GetOutbox().GetInterval().AsDuration(),
+		BatchSize: int(c.GetOutbox().GetBatchSize()),
+		Retention: c.GetOutbox().GetRetention().AsDuration(),
+	}, logger)
+}
+
 func NewDiscover(cfg *conf.Registry) registry.Discovery {
 	// new consul client
 	c := api.DefaultConfig()
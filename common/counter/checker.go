@@ -0,0 +1,173 @@
+package counter
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultCheckInterval  = 10 * time.Minute
+	defaultCheckBatchSize = 200
+)
+
+// 缓存值仍为检查时读到的值才修正，期间有新的累加时保留缓存
+var fixScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2], 'KEEPTTL')
+	return 1
+end
+return 0
+`)
+
+// Drift 缓存与数据库不一致的计数
+type Drift struct {
+	ID     int64
+	Cached int64
+	Actual int64
+}
+
+// Check 对比缓存中的计数与数据库，返回不一致的项；未缓存的 id 跳过。fix 为 true 时以数据库为准修正缓存
+func (c *Counter) Check(ctx context.Context, ids []int64, fix bool) ([]Drift, error) {
+	if len(ids) == 0 || c.load == nil {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, Key(c.kind, id))
+	}
+	vals, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	raw := make(map[int64]string, len(ids))
+	cached := make(map[int64]int64, len(ids))
+	cachedIDs := make([]int64, 0, len(ids))
+	for i, id := range ids {
+		s, ok := vals[i].(string)
+		if !ok {
+			continue
+		}
+		raw[id] = s
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			// 无法解析的值按 -1 处理，一定会报告为漂移
+			n = -1
+		}
+		cached[id] = n
+		cachedIDs = append(cachedIDs, id)
+	}
+	if len(cachedIDs) == 0 {
+		return nil, nil
+	}
+
+	actual, err := c.load(ctx, cachedIDs)
+	if err != nil {
+		return nil, err
+	}
+	var drifts []Drift
+	for _, id := range cachedIDs {
+		if cached[id] != actual[id] {
+			drifts = append(drifts, Drift{ID: id, Cached: cached[id], Actual: actual[id]})
+		}
+	}
+	if fix && len(drifts) > 0 {
+		pipe := c.rdb.Pipeline()
+		for _, d := range drifts {
+			fixScript.Run(ctx, pipe, []string{Key(c.kind, d.ID)}, raw[d.ID], d.Actual)
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return drifts, err
+		}
+	}
+	return drifts, nil
+}
+
+// CheckerConfig 漂移检查配置，零值使用默认值
+type CheckerConfig struct {
+	Interval  time.Duration // 检查间隔
+	BatchSize int           // 每批对比的 key 数
+	Fix       bool          // 是否以数据库为准修正缓存
+}
+
+// Checker 定期扫描缓存中的计数，与数据库对比并报告漂移
+type Checker struct {
+	counters []*Counter
+	conf     CheckerConfig
+	log      *log.Helper
+}
+
+// NewChecker new a counter checker.
+func NewChecker(c CheckerConfig, logger log.Logger, counters ...*Counter) *Checker {
+	if c.Interval <= 0 {
+		c.Interval = defaultCheckInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultCheckBatchSize
+	}
+	return &Checker{counters: counters, conf: c, log: log.NewHelper(logger)}
+}
+
+// Start 定时检查，每轮扫描全部缓存的计数
+func (ck *Checker) Start(ctx context.Context) error {
+	ck.log.Info("counter checker start")
+
+	ticker := time.NewTicker(ck.conf.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			for _, c := range ck.counters {
+				ck.check(ctx, c)
+			}
+		}
+	}
+}
+
+// check 用 SCAN 分批遍历一种计数的缓存，逐条报告漂移
+func (ck *Checker) check(ctx context.Context, c *Counter) {
+	start := time.Now()
+	prefix := keyPrefix(c.kind)
+	var (
+		cursor         uint64
+		total, drifted int
+	)
+	for {
+		keys, next, err := c.rdb.Scan(ctx, cursor, prefix+"*", int64(ck.conf.BatchSize)).Result()
+		if err != nil {
+			ck.log.Errorf("scan %s counters failed: %v", c.kind, err)
+			return
+		}
+		ids := make([]int64, 0, len(keys))
+		for _, k := range keys {
+			if id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		drifts, err := c.Check(ctx, ids, ck.conf.Fix)
+		if err != nil {
+			ck.log.Errorf("check %s counters failed: %v", c.kind, err)
+			return
+		}
+		for _, d := range drifts {
+			ck.log.Warnf("%s counter of video %d drifted: cached %d, actual %d", c.kind, d.ID, d.Cached, d.Actual)
+		}
+		total += len(ids)
+		drifted += len(drifts)
+		if cursor = next; cursor == 0 {
+			break
+		}
+	}
+	ck.log.Infof("checked %d %s counters in %s, %d drifted, fixed: %v", total, c.kind, time.Since(start), drifted, ck.conf.Fix && drifted > 0)
+}
+
+func (ck *Checker) Stop(ctx context.Context) error {
+	ck.log.Info("counter checker stop")
+	return nil
+}
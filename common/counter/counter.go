@@ -0,0 +1,183 @@
+package counter
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// 视频计数缓存，点赞数、评论数在各服务间共用同一套 key
+// 回源的 Loader 由各服务按自己拥有的数据提供
+
+// Kind 计数类型
+type Kind string
+
+const (
+	VideoLike    Kind = "like"    // 点赞数
+	VideoComment Kind = "comment" // 评论数
+)
+
+const defaultTTL = 24 * time.Hour
+
+// 缓存存在时累加并保持过期时间，结果不小于 0；不存在时返回 -1，不创建 key
+var incrScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+local v = redis.call('INCRBY', KEYS[1], ARGV[1])
+if v < 0 then
+	redis.call('SET', KEYS[1], 0, 'KEEPTTL')
+	v = 0
+end
+return v
+`)
+
+// Key 计数缓存 key：video:count:{kind}:{video_id}，value 为计数
+func Key(kind Kind, id int64) string {
+	return keyPrefix(kind) + strconv.FormatInt(id, 10)
+}
+
+func keyPrefix(kind Kind) string {
+	return "video:count:" + string(kind) + ":"
+}
+
+// Loader 从数据库批量查询计数，结果中没有的 id 视为 0
+type Loader func(ctx context.Context, ids []int64) (map[int64]int64, error)
+
+// Counter 一种计数的缓存，未命中时通过 Loader 回源并回填
+type Counter struct {
+	rdb  *redis.Client
+	kind Kind
+	load Loader
+	ttl  time.Duration
+	sf   singleflight.Group
+}
+
+// New 创建计数缓存，ttl 为 0 时使用默认值
+func New(rdb *redis.Client, kind Kind, load Loader, ttl time.Duration) *Counter {
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+	return &Counter{rdb: rdb, kind: kind, load: load, ttl: ttl}
+}
+
+// NewReader 创建只读的计数缓存，未命中时不回源也不回填，由拥有数据的服务回填
+func NewReader(rdb *redis.Client, kind Kind) *Counter {
+	return &Counter{rdb: rdb, kind: kind}
+}
+
+// Kind 计数类型
+func (c *Counter) Kind() Kind {
+	return c.kind
+}
+
+// Get 读取单个计数
+func (c *Counter) Get(ctx context.Context, id int64) (int64, error) {
+	res, err := c.MGet(ctx, []int64{id})
+	if err != nil {
+		return 0, err
+	}
+	return res[id], nil
+}
+
+// MGet 批量读取计数，未命中的回源并回填；并发回源相同的一批 id 时只查询一次数据库。
+// 只读的计数缓存不回源，结果中没有未命中的 id
+func (c *Counter) MGet(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	res := make(map[int64]int64, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, Key(c.kind, id))
+	}
+	vals, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []int64
+	for i, id := range ids {
+		s, ok := vals[i].(string)
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			missing = append(missing, id)
+			continue
+		}
+		res[id] = n
+	}
+	if len(missing) == 0 || c.load == nil {
+		return res, nil
+	}
+
+	loaded, err := c.fill(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range missing {
+		res[id] = loaded[id]
+	}
+	return res, nil
+}
+
+// Incr 累加计数，调用前数据库应已写入本次变更；缓存不存在时不回源，由下次读取回填。
+// 写入路径回源会把并发写入已提交的变更计入回填值，这些写入随后的累加会重复计数
+func (c *Counter) Incr(ctx context.Context, id, delta int64) error {
+	return incrScript.Run(ctx, c.rdb, []string{Key(c.kind, id)}, delta).Err()
+}
+
+// Delete 删除缓存，下次读取时回源
+func (c *Counter) Delete(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, Key(c.kind, id))
+	}
+	return c.rdb.Del(ctx, keys...).Err()
+}
+
+// fill 回源并回填缓存，期间已被其他请求回填的 key 不覆盖
+func (c *Counter) fill(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	v, err, _ := c.sf.Do(c.flightKey(ids), func() (interface{}, error) {
+		loaded, err := c.load(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		pipe := c.rdb.Pipeline()
+		for _, id := range ids {
+			pipe.SetNX(ctx, Key(c.kind, id), loaded[id], c.ttl)
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+		return loaded, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[int64]int64), nil
+}
+
+// flightKey 同一批 id 不论顺序都合并为一次回源
+func (c *Counter) flightKey(ids []int64) string {
+	sorted := append([]int64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var b strings.Builder
+	b.WriteString(string(c.kind))
+	for _, id := range sorted {
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatInt(id, 10))
+	}
+	return b.String()
+}
@@ -0,0 +1,22 @@
+module common
+
+go 1.21
+
+require (
+	github.com/go-kratos/kratos/v2 v2.8.4
+	github.com/google/uuid v1.6.0
+	github.com/redis/go-redis/v9 v9.11.0
+	github.com/segmentio/kafka-go v0.4.48
+	golang.org/x/sync v0.9.0
+	gorm.io/gorm v1.25.11
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	github.com/klauspost/compress v1.15.9 // indirect
+	github.com/pierrec/lz4/v4 v4.1.15 // indirect
+	golang.org/x/text v0.14.0 // indirect
+)
@@ -0,0 +1,34 @@
+package invalidate
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 本地缓存失效通知：数据修改方发布，缓存了该数据的服务订阅后删除本地缓存
+
+// Channel 缓存失效频道，user-service 修改资料、job-service 消费到视频变更后发布，feed-service 订阅
+const Channel = "cache:invalidate"
+
+// 失效消息的数据类型，与订阅方的缓存名一致
+const (
+	KindVideo  = "video"
+	KindAuthor = "author"
+)
+
+// Message 失效消息，ids 为修改过的数据 id
+type Message struct {
+	Kind string  `json:"kind"`
+	IDs  []int64 `json:"ids"`
+}
+
+// Publish 发布失效消息；pub/sub 不保证送达，订阅方断线期间的消息由本地缓存过期兜底
+func Publish(ctx context.Context, rdb *redis.Client, kind string, ids ...int64) error {
+	msg, err := json.Marshal(Message{Kind: kind, IDs: ids})
+	if err != nil {
+		return err
+	}
+	return rdb.Publish(ctx, Channel, msg).Err()
+}
@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	StatusPending int32 = 0 // 待发布
+	StatusSent    int32 = 1 // 已发布
+)
+
+const TableNameMessage = "outbox_events"
+
+// Message outbox 表记录，与业务数据在同一事务中写入
+type Message struct {
+	ID           int64      `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	EventID      string     `gorm:"column:event_id;not null" json:"event_id"`
+	Topic        string     `gorm:"column:topic;not null" json:"topic"`
+	AggregateKey string     `gorm:"column:aggregate_key;not null" json:"aggregate_key"`
+	EventType    string     `gorm:"column:event_type;not null" json:"event_type"`
+	Payload      string     `gorm:"column:payload;not null" json:"payload"`
+	Status       int32      `gorm:"column:status;not null" json:"status"`
+	Attempts     int32      `gorm:"column:attempts;not null" json:"attempts"`
+	LastError    string     `gorm:"column:last_error" json:"last_error"`
+	CreatedAt    time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	SentAt       *time.Time `gorm:"column:sent_at" json:"sent_at"`
+}
+
+// TableName Message's table name
+func (*Message) TableName() string {
+	return TableNameMessage
+}
+
+// Event 待发布的事件
+type Event struct {
+	Topic string
+	Key   string // 聚合键，作为消息 key，同一 key 的事件按写入顺序发布到同一分区
+	Type  string
+	Data  any
+}
+
+// Envelope 发布到 kafka 的消息体
+type Envelope struct {
+	EventID    string          `json:"event_id"`
+	Type       string          `json:"type"`
+	OccurredAt int64           `json:"occurred_at"` // 毫秒时间戳
+	Data       json.RawMessage `json:"data"`
+}
+
+// Add 在业务事务中写入事件，事务提交后由 Relay 发布
+func Add(tx *gorm.DB, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	msgs := make([]*Message, 0, len(events))
+	for _, e := range events {
+		data, err := json.Marshal(e.Data)
+		if err != nil {
+			return err
+		}
+		env := Envelope{
+			EventID:    uuid.NewString(),
+			Type:       e.Type,
+			OccurredAt: now.UnixMilli(),
+			Data:       data,
+		}
+		payload, err := json.Marshal(env)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &Message{
+			EventID:      env.EventID,
+			Topic:        e.Topic,
+			AggregateKey: e.Key,
+			EventType:    e.Type,
+			Payload:      string(payload),
+			Status:       StatusPending,
+			CreatedAt:    now,
+		})
+	}
+	return tx.Create(msgs).Error
+}
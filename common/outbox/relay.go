@@ -0,0 +1,193 @@
+package outbox
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultInterval  = time.Second
+	defaultBatchSize = 100
+	defaultRetention = 7 * 24 * time.Hour
+	cleanupInterval  = time.Hour
+	cleanupBatchSize = 1000
+	// last_error 列的长度
+	maxLastErrorLen = 512
+)
+
+// Config relay 配置，零值使用默认值
+type Config struct {
+	Brokers   []string
+	Interval  time.Duration // 扫描待发布事件的间隔
+	BatchSize int           // 每批发布的事件数
+	Retention time.Duration // 已发布事件的保留时间，过期后清理
+}
+
+// Relay 将 outbox 表中待发布的事件按写入顺序发布到 kafka，至少投递一次
+type Relay struct {
+	db     *gorm.DB
+	writer *kafka.Writer
+	conf   Config
+	log    *log.Helper
+}
+
+// NewRelay new an outbox relay.
+func NewRelay(db *gorm.DB, c Config, logger log.Logger) *Relay {
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+	if c.Retention <= 0 {
+		c.Retention = defaultRetention
+	}
+	return &Relay{
+		db: db,
+		writer: &kafka.Writer{
+			Addr: kafka.TCP(c.Brokers...),
+			// 相同 key 写入同一分区，保证同一聚合的事件有序
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+		conf: c,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Start 定时发布，一批发不完时立即发下一批；定期清理过期的已发布事件
+func (r *Relay) Start(ctx context.Context) error {
+	r.log.Info("outbox relay start")
+
+	ticker := time.NewTicker(r.conf.Interval)
+	defer ticker.Stop()
+	cleanup := time.NewTicker(cleanupInterval)
+	defer cleanup.Stop()
+	for {
+		for {
+			n, err := r.relay(ctx)
+			if err != nil {
+				r.log.Errorf("outbox relay failed: %v", err)
+			}
+			if err != nil || n < r.conf.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-cleanup.C:
+			r.cleanup(ctx)
+		}
+	}
+}
+
+// relay 锁定一批待发布事件并发布，发布成功后标记为已发布
+// 多实例部署时 FOR UPDATE 使各实例串行发布，避免同一 key 的事件乱序
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	var (
+		n        int
+		writeErr error
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []*Message
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ?", StatusPending).
+			Order("id").
+			Limit(r.conf.BatchSize).
+			Find(&msgs).Error
+		if err != nil {
+			return err
+		}
+		n = len(msgs)
+		if n == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, n)
+		kms := make([]kafka.Message, 0, n)
+		for _, m := range msgs {
+			ids = append(ids, m.ID)
+			kms = append(kms, kafka.Message{
+				Topic: m.Topic,
+				Key:   []byte(m.AggregateKey),
+				Value: []byte(m.Payload),
+			})
+		}
+		if writeErr = r.writer.WriteMessages(ctx, kms...); writeErr != nil {
+			// 记录失败次数，事件保持待发布，下次按原顺序重试
+			return tx.Model(&Message{}).Where("id IN ?", ids).Updates(map[string]any{
+				"attempts":   gorm.Expr("attempts + 1"),
+				"last_error": truncateError(writeErr),
+			}).Error
+		}
+		return tx.Model(&Message{}).Where("id IN ?", ids).Updates(map[string]any{
+			"status":  StatusSent,
+			"sent_at": time.Now(),
+		}).Error
+	})
+	if err == nil {
+		err = writeErr
+	}
+	return n, err
+}
+
+// cleanup 分批删除超过保留时间的已发布事件，避免长时间锁表
+func (r *Relay) cleanup(ctx context.Context) {
+	deadline := time.Now().Add(-r.conf.Retention)
+	var total int64
+	for {
+		var ids []int64
+		err := r.db.WithContext(ctx).Model(&Message{}).
+			Where("status = ? AND sent_at < ?", StatusSent, deadline).
+			Order("id").
+			Limit(cleanupBatchSize).
+			Pluck("id", &ids).Error
+		if err != nil {
+			r.log.Errorf("query expired outbox events failed: %v", err)
+			return
+		}
+		if len(ids) == 0 {
+			break
+		}
+		res := r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&Message{})
+		if res.Error != nil {
+			r.log.Errorf("delete expired outbox events failed: %v", res.Error)
+			return
+		}
+		total += res.RowsAffected
+		if len(ids) < cleanupBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		r.log.Infof("outbox cleanup deleted %d events sent before %s", total, deadline.Format(time.DateTime))
+	}
+}
+
+// truncateError 截断到 last_error 列的长度，批量发布失败的错误可能很长，超长时严格模式下更新会失败
+func truncateError(err error) string {
+	s := err.Error()
+	if len(s) <= maxLastErrorLen {
+		return s
+	}
+	// 不截断在多字节字符中间
+	n := maxLastErrorLen
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func (r *Relay) Stop(ctx context.Context) error {
+	r.log.Info("outbox relay stop")
+	return r.writer.Close()
+}
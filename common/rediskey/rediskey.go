@@ -0,0 +1,40 @@
+package rediskey
+
+import "strconv"
+
+// 多个服务共同读写的 redis key，写入方与读取方都从这里引用
+// 只在一个服务内使用的 key 仍由各服务自己定义
+
+const (
+	// VideoScore 视频热榜 zset，member 为视频id，只由 job-service 按 score.weights 写入
+	VideoScore = "video:score"
+	// VideoScoreDirty 有互动、待 job-service 重算分数的视频集合
+	VideoScoreDirty = "video:score:dirty"
+	// VideoScoreRebuildRequest 热榜缺失时 feed-service 请求 job-service 全量重算，由 job-service 处理后删除
+	VideoScoreRebuildRequest = "video:score:rebuild:request"
+
+	// VideoGeo 附近视频 GEO 集合，member 为视频id，坐标已模糊处理；
+	// video-service 发布公开视频时写入，job-service 在视频删除或改为私密时移除
+	VideoGeo = "video:geo"
+)
+
+// FeedInbox 关注流收件箱 zset：feed:inbox:{user_id}，member 为视频id，score 为发布时间毫秒；
+// job-service 推送、relation-service 取关时移除、feed-service 读取与重建
+func FeedInbox(userID int64) string {
+	return "feed:inbox:" + strconv.FormatInt(userID, 10)
+}
+
+// VideoCity 同城视频 zset：video:city:{city_code}，score 为发布时间毫秒；只写入已发布的公开视频
+func VideoCity(cityCode string) string {
+	return "video:city:" + cityCode
+}
+
+// UserProfileTag 用户兴趣画像的话题偏好 zset：user:profile:tag:{user_id}，由 job-service 写入，feed-service 读取
+func UserProfileTag(userID int64) string {
+	return "user:profile:tag:" + strconv.FormatInt(userID, 10)
+}
+
+// UserProfileAuthor 用户兴趣画像的作者偏好 zset：user:profile:author:{user_id}，member 为作者id
+func UserProfileAuthor(userID int64) string {
+	return "user:profile:author:" + strconv.FormatInt(userID, 10)
+}
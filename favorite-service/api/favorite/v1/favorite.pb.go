@@ -139,8 +139,9 @@ type GetUserFavoriteVideoListRequest struct {
 	TargetUserId  int64                  `protobuf:"varint,1,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"` // 已废弃，使用 cursor 翻页
 	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，为空时取第一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,9 +211,18 @@ func (x *GetUserFavoriteVideoListRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetUserFavoriteVideoListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type GetUserFavoriteVideoListReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -254,6 +264,20 @@ func (x *GetUserFavoriteVideoListReply) GetVideos() []*Video {
 	return nil
 }
 
+func (x *GetUserFavoriteVideoListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetUserFavoriteVideoListReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type Video struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
@@ -346,39 +370,1227 @@ func (x *Video) GetPublishTime() int64 {
 	return 0
 }
 
-var File_favorite_v1_favorite_proto protoreflect.FileDescriptor
+type CollectFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	VideoCount    int32                  `protobuf:"varint,6,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_favorite_v1_favorite_proto_rawDesc = "" +
-	"\n" +
-	"\x1afavorite/v1/favorite.proto\x12\bfavorite\x1a\x1cgoogle/api/annotations.proto\"\x8d\x01\n" +
-	"\x15FavoriteActionRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
-	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x19\n" +
-	"\bvideo_id\x18\x03 \x01(\x03R\avideoId\x12\x1f\n" +
-	"\vaction_type\x18\x04 \x01(\x05R\n" +
-	"actionType\"/\n" +
-	"\x13FavoriteActionReply\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xac\x01\n" +
-	"\x1fGetUserFavoriteVideoListRequest\x12$\n" +
-	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x12\n" +
-	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x05 \x01(\x05R\x05limit\"H\n" +
-	"\x1dGetUserFavoriteVideoListReply\x12'\n" +
-	"\x06videos\x18\x01 \x03(\v2\x0f.favorite.VideoR\x06videos\"\xd9\x01\n" +
-	"\x05Video\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
-	"\tcover_url\x18\x03 \x01(\tR\bcoverUrl\x12\x1b\n" +
-	"\tauthor_id\x18\x04 \x01(\x03R\bauthorId\x12\x1d\n" +
-	"\n" +
-	"like_count\x18\x05 \x01(\x03R\tlikeCount\x12#\n" +
-	"\rcomment_count\x18\x06 \x01(\x03R\fcommentCount\x12!\n" +
-	"\fpublish_time\x18\a \x01(\x03R\vpublishTime2\x93\x02\n" +
+func (x *CollectFolder) Reset() {
+	*x = CollectFolder{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectFolder) ProtoMessage() {}
+
+func (x *CollectFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectFolder.ProtoReflect.Descriptor instead.
+func (*CollectFolder) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CollectFolder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CollectFolder) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CollectFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectFolder) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *CollectFolder) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *CollectFolder) GetVideoCount() int32 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *CollectFolder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CollectActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	VideoId       int64                  `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FolderId      int64                  `protobuf:"varint,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`       // 为 0 时收藏到默认收藏夹；取消收藏时为 0 表示从全部收藏夹移除
+	ActionType    int32                  `protobuf:"varint,5,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // 1：收藏， 2： 取消
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectActionRequest) Reset() {
+	*x = CollectActionRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectActionRequest) ProtoMessage() {}
+
+func (x *CollectActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectActionRequest.ProtoReflect.Descriptor instead.
+func (*CollectActionRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CollectActionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CollectActionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CollectActionRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *CollectActionRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *CollectActionRequest) GetActionType() int32 {
+	if x != nil {
+		return x.ActionType
+	}
+	return 0
+}
+
+type CollectActionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectActionReply) Reset() {
+	*x = CollectActionReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectActionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectActionReply) ProtoMessage() {}
+
+func (x *CollectActionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectActionReply.ProtoReflect.Descriptor instead.
+func (*CollectActionReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CollectActionReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateCollectFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectFolderRequest) Reset() {
+	*x = CreateCollectFolderRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectFolderRequest) ProtoMessage() {}
+
+func (x *CreateCollectFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectFolderRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateCollectFolderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateCollectFolderRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CreateCollectFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectFolderRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type CreateCollectFolderReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *CollectFolder         `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectFolderReply) Reset() {
+	*x = CreateCollectFolderReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectFolderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectFolderReply) ProtoMessage() {}
+
+func (x *CreateCollectFolderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectFolderReply.ProtoReflect.Descriptor instead.
+func (*CreateCollectFolderReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCollectFolderReply) GetFolder() *CollectFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type UpdateCollectFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	FolderId      int64                  `protobuf:"varint,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectFolderRequest) Reset() {
+	*x = UpdateCollectFolderRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectFolderRequest) ProtoMessage() {}
+
+func (x *UpdateCollectFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectFolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectFolderRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateCollectFolderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateCollectFolderRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *UpdateCollectFolderRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *UpdateCollectFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectFolderRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type UpdateCollectFolderReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *CollectFolder         `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectFolderReply) Reset() {
+	*x = UpdateCollectFolderReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectFolderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectFolderReply) ProtoMessage() {}
+
+func (x *UpdateCollectFolderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectFolderReply.ProtoReflect.Descriptor instead.
+func (*UpdateCollectFolderReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateCollectFolderReply) GetFolder() *CollectFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type DeleteCollectFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	FolderId      int64                  `protobuf:"varint,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectFolderRequest) Reset() {
+	*x = DeleteCollectFolderRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectFolderRequest) ProtoMessage() {}
+
+func (x *DeleteCollectFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectFolderRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteCollectFolderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteCollectFolderRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *DeleteCollectFolderRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+type DeleteCollectFolderReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectFolderReply) Reset() {
+	*x = DeleteCollectFolderReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectFolderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectFolderReply) ProtoMessage() {}
+
+func (x *DeleteCollectFolderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectFolderReply.ProtoReflect.Descriptor instead.
+func (*DeleteCollectFolderReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteCollectFolderReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCollectFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetUserId  int64                  `protobuf:"varint,1,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectFoldersRequest) Reset() {
+	*x = ListCollectFoldersRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectFoldersRequest) ProtoMessage() {}
+
+func (x *ListCollectFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCollectFoldersRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *ListCollectFoldersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListCollectFoldersRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ListCollectFoldersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*CollectFolder       `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectFoldersReply) Reset() {
+	*x = ListCollectFoldersReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectFoldersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectFoldersReply) ProtoMessage() {}
+
+func (x *ListCollectFoldersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectFoldersReply.ProtoReflect.Descriptor instead.
+func (*ListCollectFoldersReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCollectFoldersReply) GetFolders() []*CollectFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type ListCollectFolderVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectFolderVideosRequest) Reset() {
+	*x = ListCollectFolderVideosRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectFolderVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectFolderVideosRequest) ProtoMessage() {}
+
+func (x *ListCollectFolderVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectFolderVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectFolderVideosRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCollectFolderVideosRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *ListCollectFolderVideosRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListCollectFolderVideosRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ListCollectFolderVideosRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectFolderVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCollectFolderVideosReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectFolderVideosReply) Reset() {
+	*x = ListCollectFolderVideosReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectFolderVideosReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectFolderVideosReply) ProtoMessage() {}
+
+func (x *ListCollectFolderVideosReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectFolderVideosReply.ProtoReflect.Descriptor instead.
+func (*ListCollectFolderVideosReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCollectFolderVideosReply) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListCollectFolderVideosReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type BatchIsFavoritedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoIds      []int64                `protobuf:"varint,2,rep,packed,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"` // 最多 100 个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchIsFavoritedRequest) Reset() {
+	*x = BatchIsFavoritedRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchIsFavoritedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchIsFavoritedRequest) ProtoMessage() {}
+
+func (x *BatchIsFavoritedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchIsFavoritedRequest.ProtoReflect.Descriptor instead.
+func (*BatchIsFavoritedRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchIsFavoritedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchIsFavoritedRequest) GetVideoIds() []int64 {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+type BatchIsFavoritedReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FavoritedVideoIds []int64                `protobuf:"varint,1,rep,packed,name=favorited_video_ids,json=favoritedVideoIds,proto3" json:"favorited_video_ids,omitempty"` // 已点赞的视频
+	CollectedVideoIds []int64                `protobuf:"varint,2,rep,packed,name=collected_video_ids,json=collectedVideoIds,proto3" json:"collected_video_ids,omitempty"` // 已收藏的视频
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BatchIsFavoritedReply) Reset() {
+	*x = BatchIsFavoritedReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchIsFavoritedReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchIsFavoritedReply) ProtoMessage() {}
+
+func (x *BatchIsFavoritedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchIsFavoritedReply.ProtoReflect.Descriptor instead.
+func (*BatchIsFavoritedReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchIsFavoritedReply) GetFavoritedVideoIds() []int64 {
+	if x != nil {
+		return x.FavoritedVideoIds
+	}
+	return nil
+}
+
+func (x *BatchIsFavoritedReply) GetCollectedVideoIds() []int64 {
+	if x != nil {
+		return x.CollectedVideoIds
+	}
+	return nil
+}
+
+type ListVideoLikersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // 可选，登录后返回是否已关注点赞的用户
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"` // 分页游标，首页为空，之后传上一页返回的 next_cursor
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideoLikersRequest) Reset() {
+	*x = ListVideoLikersRequest{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideoLikersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideoLikersRequest) ProtoMessage() {}
+
+func (x *ListVideoLikersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideoLikersRequest.ProtoReflect.Descriptor instead.
+func (*ListVideoLikersRequest) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListVideoLikersRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ListVideoLikersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListVideoLikersRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ListVideoLikersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListVideoLikersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListVideoLikersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likers        []*Liker               `protobuf:"bytes,1,rep,name=likers,proto3" json:"likers,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideoLikersReply) Reset() {
+	*x = ListVideoLikersReply{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideoLikersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideoLikersReply) ProtoMessage() {}
+
+func (x *ListVideoLikersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideoLikersReply.ProtoReflect.Descriptor instead.
+func (*ListVideoLikersReply) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListVideoLikersReply) GetLikers() []*Liker {
+	if x != nil {
+		return x.Likers
+	}
+	return nil
+}
+
+func (x *ListVideoLikersReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListVideoLikersReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type Liker struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	IsFollow      bool                   `protobuf:"varint,4,opt,name=is_follow,json=isFollow,proto3" json:"is_follow,omitempty"` // 当前用户是否已关注
+	LikedAt       int64                  `protobuf:"varint,5,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"`    // 点赞时间，秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Liker) Reset() {
+	*x = Liker{}
+	mi := &file_favorite_v1_favorite_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Liker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Liker) ProtoMessage() {}
+
+func (x *Liker) ProtoReflect() protoreflect.Message {
+	mi := &file_favorite_v1_favorite_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Liker.ProtoReflect.Descriptor instead.
+func (*Liker) Descriptor() ([]byte, []int) {
+	return file_favorite_v1_favorite_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Liker) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Liker) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Liker) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Liker) GetIsFollow() bool {
+	if x != nil {
+		return x.IsFollow
+	}
+	return false
+}
+
+func (x *Liker) GetLikedAt() int64 {
+	if x != nil {
+		return x.LikedAt
+	}
+	return 0
+}
+
+var File_favorite_v1_favorite_proto protoreflect.FileDescriptor
+
+const file_favorite_v1_favorite_proto_rawDesc = "" +
+	"\n" +
+	"\x1afavorite/v1/favorite.proto\x12\bfavorite\x1a\x1cgoogle/api/annotations.proto\"\x8d\x01\n" +
+	"\x15FavoriteActionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\x03R\avideoId\x12\x1f\n" +
+	"\vaction_type\x18\x04 \x01(\x05R\n" +
+	"actionType\"/\n" +
+	"\x13FavoriteActionReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xc4\x01\n" +
+	"\x1fGetUserFavoriteVideoListRequest\x12$\n" +
+	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"\x84\x01\n" +
+	"\x1dGetUserFavoriteVideoListReply\x12'\n" +
+	"\x06videos\x18\x01 \x03(\v2\x0f.favorite.VideoR\x06videos\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\xd9\x01\n" +
+	"\x05Video\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tcover_url\x18\x03 \x01(\tR\bcoverUrl\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\x03R\bauthorId\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x05 \x01(\x03R\tlikeCount\x12#\n" +
+	"\rcomment_count\x18\x06 \x01(\x03R\fcommentCount\x12!\n" +
+	"\fpublish_time\x18\a \x01(\x03R\vpublishTime\"\xc8\x01\n" +
+	"\rCollectFolder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x04 \x01(\bR\bisPublic\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bR\tisDefault\x12\x1f\n" +
+	"\vvideo_count\x18\x06 \x01(\x05R\n" +
+	"videoCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\xa9\x01\n" +
+	"\x14CollectActionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\x03R\avideoId\x12\x1b\n" +
+	"\tfolder_id\x18\x04 \x01(\x03R\bfolderId\x12\x1f\n" +
+	"\vaction_type\x18\x05 \x01(\x05R\n" +
+	"actionType\".\n" +
+	"\x12CollectActionReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x87\x01\n" +
+	"\x1aCreateCollectFolderRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x04 \x01(\bR\bisPublic\"K\n" +
+	"\x18CreateCollectFolderReply\x12/\n" +
+	"\x06folder\x18\x01 \x01(\v2\x17.favorite.CollectFolderR\x06folder\"\xa4\x01\n" +
+	"\x1aUpdateCollectFolderRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\x03R\bfolderId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x05 \x01(\bR\bisPublic\"K\n" +
+	"\x18UpdateCollectFolderReply\x12/\n" +
+	"\x06folder\x18\x01 \x01(\v2\x17.favorite.CollectFolderR\x06folder\"s\n" +
+	"\x1aDeleteCollectFolderRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\x03R\bfolderId\"4\n" +
+	"\x18DeleteCollectFolderReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"|\n" +
+	"\x19ListCollectFoldersRequest\x12$\n" +
+	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"L\n" +
+	"\x17ListCollectFoldersReply\x121\n" +
+	"\afolders\x18\x01 \x03(\v2\x17.favorite.CollectFolderR\afolders\"\xa2\x01\n" +
+	"\x1eListCollectFolderVideosRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\x03R\bfolderId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"]\n" +
+	"\x1cListCollectFolderVideosReply\x12'\n" +
+	"\x06videos\x18\x01 \x03(\v2\x0f.favorite.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"O\n" +
+	"\x17BatchIsFavoritedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tvideo_ids\x18\x02 \x03(\x03R\bvideoIds\"w\n" +
+	"\x15BatchIsFavoritedReply\x12.\n" +
+	"\x13favorited_video_ids\x18\x01 \x03(\x03R\x11favoritedVideoIds\x12.\n" +
+	"\x13collected_video_ids\x18\x02 \x03(\x03R\x11collectedVideoIds\"\x9c\x01\n" +
+	"\x16ListVideoLikersRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"{\n" +
+	"\x14ListVideoLikersReply\x12'\n" +
+	"\x06likers\x18\x01 \x03(\v2\x0f.favorite.LikerR\x06likers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x82\x01\n" +
+	"\x05Liker\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x1b\n" +
+	"\tis_follow\x18\x04 \x01(\bR\bisFollow\x12\x19\n" +
+	"\bliked_at\x18\x05 \x01(\x03R\alikedAt2\xf6\t\n" +
 	"\x0fFavoriteService\x12q\n" +
 	"\x0eFavoriteAction\x12\x1f.favorite.FavoriteActionRequest\x1a\x1d.favorite.FavoriteActionReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/favorite/action\x12\x8c\x01\n" +
-	"\x18GetUserFavoriteVideoList\x12).favorite.GetUserFavoriteVideoListRequest\x1a'.favorite.GetUserFavoriteVideoListReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/favorite/videosB\x14Z\x12favorite/api/v1;v1b\x06proto3"
+	"\x18GetUserFavoriteVideoList\x12).favorite.GetUserFavoriteVideoListRequest\x1a'.favorite.GetUserFavoriteVideoListReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/favorite/videos\x12m\n" +
+	"\rCollectAction\x12\x1e.favorite.CollectActionRequest\x1a\x1c.favorite.CollectActionReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/collect/action\x12\x86\x01\n" +
+	"\x13CreateCollectFolder\x12$.favorite.CreateCollectFolderRequest\x1a\".favorite.CreateCollectFolderReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/collect/folder/create\x12\x86\x01\n" +
+	"\x13UpdateCollectFolder\x12$.favorite.UpdateCollectFolderRequest\x1a\".favorite.UpdateCollectFolderReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/collect/folder/update\x12\x86\x01\n" +
+	"\x13DeleteCollectFolder\x12$.favorite.DeleteCollectFolderRequest\x1a\".favorite.DeleteCollectFolderReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/collect/folder/delete\x12z\n" +
+	"\x12ListCollectFolders\x12#.favorite.ListCollectFoldersRequest\x1a!.favorite.ListCollectFoldersReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/collect/folders\x12\x8f\x01\n" +
+	"\x17ListCollectFolderVideos\x12(.favorite.ListCollectFolderVideosRequest\x1a&.favorite.ListCollectFolderVideosReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/collect/folder/videos\x12V\n" +
+	"\x10BatchIsFavorited\x12!.favorite.BatchIsFavoritedRequest\x1a\x1f.favorite.BatchIsFavoritedReply\x12q\n" +
+	"\x0fListVideoLikers\x12 .favorite.ListVideoLikersRequest\x1a\x1e.favorite.ListVideoLikersReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/favorite/likersB\x14Z\x12favorite/api/v1;v1b\x06proto3"
 
 var (
 	file_favorite_v1_favorite_proto_rawDescOnce sync.Once
@@ -392,25 +1604,64 @@ func file_favorite_v1_favorite_proto_rawDescGZIP() []byte {
 	return file_favorite_v1_favorite_proto_rawDescData
 }
 
-var file_favorite_v1_favorite_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_favorite_v1_favorite_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_favorite_v1_favorite_proto_goTypes = []any{
 	(*FavoriteActionRequest)(nil),           // 0: favorite.FavoriteActionRequest
 	(*FavoriteActionReply)(nil),             // 1: favorite.FavoriteActionReply
 	(*GetUserFavoriteVideoListRequest)(nil), // 2: favorite.GetUserFavoriteVideoListRequest
 	(*GetUserFavoriteVideoListReply)(nil),   // 3: favorite.GetUserFavoriteVideoListReply
 	(*Video)(nil),                           // 4: favorite.Video
+	(*CollectFolder)(nil),                   // 5: favorite.CollectFolder
+	(*CollectActionRequest)(nil),            // 6: favorite.CollectActionRequest
+	(*CollectActionReply)(nil),              // 7: favorite.CollectActionReply
+	(*CreateCollectFolderRequest)(nil),      // 8: favorite.CreateCollectFolderRequest
+	(*CreateCollectFolderReply)(nil),        // 9: favorite.CreateCollectFolderReply
+	(*UpdateCollectFolderRequest)(nil),      // 10: favorite.UpdateCollectFolderRequest
+	(*UpdateCollectFolderReply)(nil),        // 11: favorite.UpdateCollectFolderReply
+	(*DeleteCollectFolderRequest)(nil),      // 12: favorite.DeleteCollectFolderRequest
+	(*DeleteCollectFolderReply)(nil),        // 13: favorite.DeleteCollectFolderReply
+	(*ListCollectFoldersRequest)(nil),       // 14: favorite.ListCollectFoldersRequest
+	(*ListCollectFoldersReply)(nil),         // 15: favorite.ListCollectFoldersReply
+	(*ListCollectFolderVideosRequest)(nil),  // 16: favorite.ListCollectFolderVideosRequest
+	(*ListCollectFolderVideosReply)(nil),    // 17: favorite.ListCollectFolderVideosReply
+	(*BatchIsFavoritedRequest)(nil),         // 18: favorite.BatchIsFavoritedRequest
+	(*BatchIsFavoritedReply)(nil),           // 19: favorite.BatchIsFavoritedReply
+	(*ListVideoLikersRequest)(nil),          // 20: favorite.ListVideoLikersRequest
+	(*ListVideoLikersReply)(nil),            // 21: favorite.ListVideoLikersReply
+	(*Liker)(nil),                           // 22: favorite.Liker
 }
 var file_favorite_v1_favorite_proto_depIdxs = []int32{
-	4, // 0: favorite.GetUserFavoriteVideoListReply.videos:type_name -> favorite.Video
-	0, // 1: favorite.FavoriteService.FavoriteAction:input_type -> favorite.FavoriteActionRequest
-	2, // 2: favorite.FavoriteService.GetUserFavoriteVideoList:input_type -> favorite.GetUserFavoriteVideoListRequest
-	1, // 3: favorite.FavoriteService.FavoriteAction:output_type -> favorite.FavoriteActionReply
-	3, // 4: favorite.FavoriteService.GetUserFavoriteVideoList:output_type -> favorite.GetUserFavoriteVideoListReply
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: favorite.GetUserFavoriteVideoListReply.videos:type_name -> favorite.Video
+	5,  // 1: favorite.CreateCollectFolderReply.folder:type_name -> favorite.CollectFolder
+	5,  // 2: favorite.UpdateCollectFolderReply.folder:type_name -> favorite.CollectFolder
+	5,  // 3: favorite.ListCollectFoldersReply.folders:type_name -> favorite.CollectFolder
+	4,  // 4: favorite.ListCollectFolderVideosReply.videos:type_name -> favorite.Video
+	22, // 5: favorite.ListVideoLikersReply.likers:type_name -> favorite.Liker
+	0,  // 6: favorite.FavoriteService.FavoriteAction:input_type -> favorite.FavoriteActionRequest
+	2,  // 7: favorite.FavoriteService.GetUserFavoriteVideoList:input_type -> favorite.GetUserFavoriteVideoListRequest
+	6,  // 8: favorite.FavoriteService.CollectAction:input_type -> favorite.CollectActionRequest
+	8,  // 9: favorite.FavoriteService.CreateCollectFolder:input_type -> favorite.CreateCollectFolderRequest
+	10, // 10: favorite.FavoriteService.UpdateCollectFolder:input_type -> favorite.UpdateCollectFolderRequest
+	12, // 11: favorite.FavoriteService.DeleteCollectFolder:input_type -> favorite.DeleteCollectFolderRequest
+	14, // 12: favorite.FavoriteService.ListCollectFolders:input_type -> favorite.ListCollectFoldersRequest
+	16, // 13: favorite.FavoriteService.ListCollectFolderVideos:input_type -> favorite.ListCollectFolderVideosRequest
+	18, // 14: favorite.FavoriteService.BatchIsFavorited:input_type -> favorite.BatchIsFavoritedRequest
+	20, // 15: favorite.FavoriteService.ListVideoLikers:input_type -> favorite.ListVideoLikersRequest
+	1,  // 16: favorite.FavoriteService.FavoriteAction:output_type -> favorite.FavoriteActionReply
+	3,  // 17: favorite.FavoriteService.GetUserFavoriteVideoList:output_type -> favorite.GetUserFavoriteVideoListReply
+	7,  // 18: favorite.FavoriteService.CollectAction:output_type -> favorite.CollectActionReply
+	9,  // 19: favorite.FavoriteService.CreateCollectFolder:output_type -> favorite.CreateCollectFolderReply
+	11, // 20: favorite.FavoriteService.UpdateCollectFolder:output_type -> favorite.UpdateCollectFolderReply
+	13, // 21: favorite.FavoriteService.DeleteCollectFolder:output_type -> favorite.DeleteCollectFolderReply
+	15, // 22: favorite.FavoriteService.ListCollectFolders:output_type -> favorite.ListCollectFoldersReply
+	17, // 23: favorite.FavoriteService.ListCollectFolderVideos:output_type -> favorite.ListCollectFolderVideosReply
+	19, // 24: favorite.FavoriteService.BatchIsFavorited:output_type -> favorite.BatchIsFavoritedReply
+	21, // 25: favorite.FavoriteService.ListVideoLikers:output_type -> favorite.ListVideoLikersReply
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_favorite_v1_favorite_proto_init() }
@@ -424,7 +1675,7 @@ func file_favorite_v1_favorite_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_favorite_v1_favorite_proto_rawDesc), len(file_favorite_v1_favorite_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
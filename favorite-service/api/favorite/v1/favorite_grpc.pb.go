@@ -21,6 +21,14 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	FavoriteService_FavoriteAction_FullMethodName           = "/favorite.FavoriteService/FavoriteAction"
 	FavoriteService_GetUserFavoriteVideoList_FullMethodName = "/favorite.FavoriteService/GetUserFavoriteVideoList"
+	FavoriteService_CollectAction_FullMethodName            = "/favorite.FavoriteService/CollectAction"
+	FavoriteService_CreateCollectFolder_FullMethodName      = "/favorite.FavoriteService/CreateCollectFolder"
+	FavoriteService_UpdateCollectFolder_FullMethodName      = "/favorite.FavoriteService/UpdateCollectFolder"
+	FavoriteService_DeleteCollectFolder_FullMethodName      = "/favorite.FavoriteService/DeleteCollectFolder"
+	FavoriteService_ListCollectFolders_FullMethodName       = "/favorite.FavoriteService/ListCollectFolders"
+	FavoriteService_ListCollectFolderVideos_FullMethodName  = "/favorite.FavoriteService/ListCollectFolderVideos"
+	FavoriteService_BatchIsFavorited_FullMethodName         = "/favorite.FavoriteService/BatchIsFavorited"
+	FavoriteService_ListVideoLikers_FullMethodName          = "/favorite.FavoriteService/ListVideoLikers"
 )
 
 // FavoriteServiceClient is the client API for FavoriteService service.
@@ -29,6 +37,22 @@ const (
 type FavoriteServiceClient interface {
 	FavoriteAction(ctx context.Context, in *FavoriteActionRequest, opts ...grpc.CallOption) (*FavoriteActionReply, error)
 	GetUserFavoriteVideoList(ctx context.Context, in *GetUserFavoriteVideoListRequest, opts ...grpc.CallOption) (*GetUserFavoriteVideoListReply, error)
+	// 收藏、取消收藏视频
+	CollectAction(ctx context.Context, in *CollectActionRequest, opts ...grpc.CallOption) (*CollectActionReply, error)
+	// 创建收藏夹
+	CreateCollectFolder(ctx context.Context, in *CreateCollectFolderRequest, opts ...grpc.CallOption) (*CreateCollectFolderReply, error)
+	// 修改收藏夹名称、公开状态
+	UpdateCollectFolder(ctx context.Context, in *UpdateCollectFolderRequest, opts ...grpc.CallOption) (*UpdateCollectFolderReply, error)
+	// 删除收藏夹，默认收藏夹不能删除
+	DeleteCollectFolder(ctx context.Context, in *DeleteCollectFolderRequest, opts ...grpc.CallOption) (*DeleteCollectFolderReply, error)
+	// 获取用户收藏夹列表，非本人只能看到公开收藏夹
+	ListCollectFolders(ctx context.Context, in *ListCollectFoldersRequest, opts ...grpc.CallOption) (*ListCollectFoldersReply, error)
+	// 获取收藏夹中的视频
+	ListCollectFolderVideos(ctx context.Context, in *ListCollectFolderVideosRequest, opts ...grpc.CallOption) (*ListCollectFolderVideosReply, error)
+	// 批量查询用户是否点赞、收藏了视频，供视频流等服务内部调用
+	BatchIsFavorited(ctx context.Context, in *BatchIsFavoritedRequest, opts ...grpc.CallOption) (*BatchIsFavoritedReply, error)
+	// 点赞了视频的用户，按点赞时间倒序
+	ListVideoLikers(ctx context.Context, in *ListVideoLikersRequest, opts ...grpc.CallOption) (*ListVideoLikersReply, error)
 }
 
 type favoriteServiceClient struct {
@@ -59,12 +83,108 @@ func (c *favoriteServiceClient) GetUserFavoriteVideoList(ctx context.Context, in
 	return out, nil
 }
 
+func (c *favoriteServiceClient) CollectAction(ctx context.Context, in *CollectActionRequest, opts ...grpc.CallOption) (*CollectActionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectActionReply)
+	err := c.cc.Invoke(ctx, FavoriteService_CollectAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) CreateCollectFolder(ctx context.Context, in *CreateCollectFolderRequest, opts ...grpc.CallOption) (*CreateCollectFolderReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectFolderReply)
+	err := c.cc.Invoke(ctx, FavoriteService_CreateCollectFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) UpdateCollectFolder(ctx context.Context, in *UpdateCollectFolderRequest, opts ...grpc.CallOption) (*UpdateCollectFolderReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCollectFolderReply)
+	err := c.cc.Invoke(ctx, FavoriteService_UpdateCollectFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) DeleteCollectFolder(ctx context.Context, in *DeleteCollectFolderRequest, opts ...grpc.CallOption) (*DeleteCollectFolderReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectFolderReply)
+	err := c.cc.Invoke(ctx, FavoriteService_DeleteCollectFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) ListCollectFolders(ctx context.Context, in *ListCollectFoldersRequest, opts ...grpc.CallOption) (*ListCollectFoldersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectFoldersReply)
+	err := c.cc.Invoke(ctx, FavoriteService_ListCollectFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) ListCollectFolderVideos(ctx context.Context, in *ListCollectFolderVideosRequest, opts ...grpc.CallOption) (*ListCollectFolderVideosReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectFolderVideosReply)
+	err := c.cc.Invoke(ctx, FavoriteService_ListCollectFolderVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) BatchIsFavorited(ctx context.Context, in *BatchIsFavoritedRequest, opts ...grpc.CallOption) (*BatchIsFavoritedReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchIsFavoritedReply)
+	err := c.cc.Invoke(ctx, FavoriteService_BatchIsFavorited_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) ListVideoLikers(ctx context.Context, in *ListVideoLikersRequest, opts ...grpc.CallOption) (*ListVideoLikersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVideoLikersReply)
+	err := c.cc.Invoke(ctx, FavoriteService_ListVideoLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FavoriteServiceServer is the server API for FavoriteService service.
 // All implementations must embed UnimplementedFavoriteServiceServer
 // for forward compatibility.
 type FavoriteServiceServer interface {
 	FavoriteAction(context.Context, *FavoriteActionRequest) (*FavoriteActionReply, error)
 	GetUserFavoriteVideoList(context.Context, *GetUserFavoriteVideoListRequest) (*GetUserFavoriteVideoListReply, error)
+	// 收藏、取消收藏视频
+	CollectAction(context.Context, *CollectActionRequest) (*CollectActionReply, error)
+	// 创建收藏夹
+	CreateCollectFolder(context.Context, *CreateCollectFolderRequest) (*CreateCollectFolderReply, error)
+	// 修改收藏夹名称、公开状态
+	UpdateCollectFolder(context.Context, *UpdateCollectFolderRequest) (*UpdateCollectFolderReply, error)
+	// 删除收藏夹，默认收藏夹不能删除
+	DeleteCollectFolder(context.Context, *DeleteCollectFolderRequest) (*DeleteCollectFolderReply, error)
+	// 获取用户收藏夹列表，非本人只能看到公开收藏夹
+	ListCollectFolders(context.Context, *ListCollectFoldersRequest) (*ListCollectFoldersReply, error)
+	// 获取收藏夹中的视频
+	ListCollectFolderVideos(context.Context, *ListCollectFolderVideosRequest) (*ListCollectFolderVideosReply, error)
+	// 批量查询用户是否点赞、收藏了视频，供视频流等服务内部调用
+	BatchIsFavorited(context.Context, *BatchIsFavoritedRequest) (*BatchIsFavoritedReply, error)
+	// 点赞了视频的用户，按点赞时间倒序
+	ListVideoLikers(context.Context, *ListVideoLikersRequest) (*ListVideoLikersReply, error)
 	mustEmbedUnimplementedFavoriteServiceServer()
 }
 
@@ -81,6 +201,30 @@ func (UnimplementedFavoriteServiceServer) FavoriteAction(context.Context, *Favor
 func (UnimplementedFavoriteServiceServer) GetUserFavoriteVideoList(context.Context, *GetUserFavoriteVideoListRequest) (*GetUserFavoriteVideoListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserFavoriteVideoList not implemented")
 }
+func (UnimplementedFavoriteServiceServer) CollectAction(context.Context, *CollectActionRequest) (*CollectActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAction not implemented")
+}
+func (UnimplementedFavoriteServiceServer) CreateCollectFolder(context.Context, *CreateCollectFolderRequest) (*CreateCollectFolderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectFolder not implemented")
+}
+func (UnimplementedFavoriteServiceServer) UpdateCollectFolder(context.Context, *UpdateCollectFolderRequest) (*UpdateCollectFolderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectFolder not implemented")
+}
+func (UnimplementedFavoriteServiceServer) DeleteCollectFolder(context.Context, *DeleteCollectFolderRequest) (*DeleteCollectFolderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectFolder not implemented")
+}
+func (UnimplementedFavoriteServiceServer) ListCollectFolders(context.Context, *ListCollectFoldersRequest) (*ListCollectFoldersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectFolders not implemented")
+}
+func (UnimplementedFavoriteServiceServer) ListCollectFolderVideos(context.Context, *ListCollectFolderVideosRequest) (*ListCollectFolderVideosReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectFolderVideos not implemented")
+}
+func (UnimplementedFavoriteServiceServer) BatchIsFavorited(context.Context, *BatchIsFavoritedRequest) (*BatchIsFavoritedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchIsFavorited not implemented")
+}
+func (UnimplementedFavoriteServiceServer) ListVideoLikers(context.Context, *ListVideoLikersRequest) (*ListVideoLikersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVideoLikers not implemented")
+}
 func (UnimplementedFavoriteServiceServer) mustEmbedUnimplementedFavoriteServiceServer() {}
 func (UnimplementedFavoriteServiceServer) testEmbeddedByValue()                         {}
 
@@ -138,6 +282,150 @@ func _FavoriteService_GetUserFavoriteVideoList_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FavoriteService_CollectAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).CollectAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_CollectAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).CollectAction(ctx, req.(*CollectActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_CreateCollectFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).CreateCollectFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_CreateCollectFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).CreateCollectFolder(ctx, req.(*CreateCollectFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_UpdateCollectFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).UpdateCollectFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_UpdateCollectFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).UpdateCollectFolder(ctx, req.(*UpdateCollectFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_DeleteCollectFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).DeleteCollectFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_DeleteCollectFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).DeleteCollectFolder(ctx, req.(*DeleteCollectFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_ListCollectFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).ListCollectFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_ListCollectFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).ListCollectFolders(ctx, req.(*ListCollectFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_ListCollectFolderVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectFolderVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).ListCollectFolderVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_ListCollectFolderVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).ListCollectFolderVideos(ctx, req.(*ListCollectFolderVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_BatchIsFavorited_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchIsFavoritedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).BatchIsFavorited(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_BatchIsFavorited_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).BatchIsFavorited(ctx, req.(*BatchIsFavoritedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_ListVideoLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideoLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).ListVideoLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_ListVideoLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).ListVideoLikers(ctx, req.(*ListVideoLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FavoriteService_ServiceDesc is the grpc.ServiceDesc for FavoriteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +441,38 @@ var FavoriteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserFavoriteVideoList",
 			Handler:    _FavoriteService_GetUserFavoriteVideoList_Handler,
 		},
+		{
+			MethodName: "CollectAction",
+			Handler:    _FavoriteService_CollectAction_Handler,
+		},
+		{
+			MethodName: "CreateCollectFolder",
+			Handler:    _FavoriteService_CreateCollectFolder_Handler,
+		},
+		{
+			MethodName: "UpdateCollectFolder",
+			Handler:    _FavoriteService_UpdateCollectFolder_Handler,
+		},
+		{
+			MethodName: "DeleteCollectFolder",
+			Handler:    _FavoriteService_DeleteCollectFolder_Handler,
+		},
+		{
+			MethodName: "ListCollectFolders",
+			Handler:    _FavoriteService_ListCollectFolders_Handler,
+		},
+		{
+			MethodName: "ListCollectFolderVideos",
+			Handler:    _FavoriteService_ListCollectFolderVideos_Handler,
+		},
+		{
+			MethodName: "BatchIsFavorited",
+			Handler:    _FavoriteService_BatchIsFavorited_Handler,
+		},
+		{
+			MethodName: "ListVideoLikers",
+			Handler:    _FavoriteService_ListVideoLikers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "favorite/v1/favorite.proto",
@@ -19,18 +19,46 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationFavoriteServiceCollectAction = "/favorite.FavoriteService/CollectAction"
+const OperationFavoriteServiceCreateCollectFolder = "/favorite.FavoriteService/CreateCollectFolder"
+const OperationFavoriteServiceDeleteCollectFolder = "/favorite.FavoriteService/DeleteCollectFolder"
 const OperationFavoriteServiceFavoriteAction = "/favorite.FavoriteService/FavoriteAction"
 const OperationFavoriteServiceGetUserFavoriteVideoList = "/favorite.FavoriteService/GetUserFavoriteVideoList"
+const OperationFavoriteServiceListCollectFolderVideos = "/favorite.FavoriteService/ListCollectFolderVideos"
+const OperationFavoriteServiceListCollectFolders = "/favorite.FavoriteService/ListCollectFolders"
+const OperationFavoriteServiceListVideoLikers = "/favorite.FavoriteService/ListVideoLikers"
+const OperationFavoriteServiceUpdateCollectFolder = "/favorite.FavoriteService/UpdateCollectFolder"
 
 type FavoriteServiceHTTPServer interface {
+	// CollectAction 收藏、取消收藏视频
+	CollectAction(context.Context, *CollectActionRequest) (*CollectActionReply, error)
+	// CreateCollectFolder 创建收藏夹
+	CreateCollectFolder(context.Context, *CreateCollectFolderRequest) (*CreateCollectFolderReply, error)
+	// DeleteCollectFolder 删除收藏夹，默认收藏夹不能删除
+	DeleteCollectFolder(context.Context, *DeleteCollectFolderRequest) (*DeleteCollectFolderReply, error)
 	FavoriteAction(context.Context, *FavoriteActionRequest) (*FavoriteActionReply, error)
 	GetUserFavoriteVideoList(context.Context, *GetUserFavoriteVideoListRequest) (*GetUserFavoriteVideoListReply, error)
+	// ListCollectFolderVideos 获取收藏夹中的视频
+	ListCollectFolderVideos(context.Context, *ListCollectFolderVideosRequest) (*ListCollectFolderVideosReply, error)
+	// ListCollectFolders 获取用户收藏夹列表，非本人只能看到公开收藏夹
+	ListCollectFolders(context.Context, *ListCollectFoldersRequest) (*ListCollectFoldersReply, error)
+	// ListVideoLikers 点赞了视频的用户，按点赞时间倒序
+	ListVideoLikers(context.Context, *ListVideoLikersRequest) (*ListVideoLikersReply, error)
+	// UpdateCollectFolder 修改收藏夹名称、公开状态
+	UpdateCollectFolder(context.Context, *UpdateCollectFolderRequest) (*UpdateCollectFolderReply, error)
 }
 
 func RegisterFavoriteServiceHTTPServer(s *http.Server, srv FavoriteServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/favorite/action", _FavoriteService_FavoriteAction0_HTTP_Handler(srv))
 	r.GET("/api/favorite/videos", _FavoriteService_GetUserFavoriteVideoList0_HTTP_Handler(srv))
+	r.POST("/api/collect/action", _FavoriteService_CollectAction0_HTTP_Handler(srv))
+	r.POST("/api/collect/folder/create", _FavoriteService_CreateCollectFolder0_HTTP_Handler(srv))
+	r.POST("/api/collect/folder/update", _FavoriteService_UpdateCollectFolder0_HTTP_Handler(srv))
+	r.POST("/api/collect/folder/delete", _FavoriteService_DeleteCollectFolder0_HTTP_Handler(srv))
+	r.GET("/api/collect/folders", _FavoriteService_ListCollectFolders0_HTTP_Handler(srv))
+	r.GET("/api/collect/folder/videos", _FavoriteService_ListCollectFolderVideos0_HTTP_Handler(srv))
+	r.GET("/api/favorite/likers", _FavoriteService_ListVideoLikers0_HTTP_Handler(srv))
 }
 
 func _FavoriteService_FavoriteAction0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
@@ -74,9 +102,161 @@ func _FavoriteService_GetUserFavoriteVideoList0_HTTP_Handler(srv FavoriteService
 	}
 }
 
+func _FavoriteService_CollectAction0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CollectActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceCollectAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CollectAction(ctx, req.(*CollectActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CollectActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_CreateCollectFolder0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCollectFolderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceCreateCollectFolder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCollectFolder(ctx, req.(*CreateCollectFolderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCollectFolderReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_UpdateCollectFolder0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCollectFolderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceUpdateCollectFolder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCollectFolder(ctx, req.(*UpdateCollectFolderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCollectFolderReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_DeleteCollectFolder0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCollectFolderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceDeleteCollectFolder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCollectFolder(ctx, req.(*DeleteCollectFolderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCollectFolderReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_ListCollectFolders0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCollectFoldersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceListCollectFolders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCollectFolders(ctx, req.(*ListCollectFoldersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCollectFoldersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_ListCollectFolderVideos0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCollectFolderVideosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceListCollectFolderVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCollectFolderVideos(ctx, req.(*ListCollectFolderVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCollectFolderVideosReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_ListVideoLikers0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVideoLikersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceListVideoLikers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVideoLikers(ctx, req.(*ListVideoLikersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVideoLikersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type FavoriteServiceHTTPClient interface {
+	CollectAction(ctx context.Context, req *CollectActionRequest, opts ...http.CallOption) (rsp *CollectActionReply, err error)
+	CreateCollectFolder(ctx context.Context, req *CreateCollectFolderRequest, opts ...http.CallOption) (rsp *CreateCollectFolderReply, err error)
+	DeleteCollectFolder(ctx context.Context, req *DeleteCollectFolderRequest, opts ...http.CallOption) (rsp *DeleteCollectFolderReply, err error)
 	FavoriteAction(ctx context.Context, req *FavoriteActionRequest, opts ...http.CallOption) (rsp *FavoriteActionReply, err error)
 	GetUserFavoriteVideoList(ctx context.Context, req *GetUserFavoriteVideoListRequest, opts ...http.CallOption) (rsp *GetUserFavoriteVideoListReply, err error)
+	ListCollectFolderVideos(ctx context.Context, req *ListCollectFolderVideosRequest, opts ...http.CallOption) (rsp *ListCollectFolderVideosReply, err error)
+	ListCollectFolders(ctx context.Context, req *ListCollectFoldersRequest, opts ...http.CallOption) (rsp *ListCollectFoldersReply, err error)
+	ListVideoLikers(ctx context.Context, req *ListVideoLikersRequest, opts ...http.CallOption) (rsp *ListVideoLikersReply, err error)
+	UpdateCollectFolder(ctx context.Context, req *UpdateCollectFolderRequest, opts ...http.CallOption) (rsp *UpdateCollectFolderReply, err error)
 }
 
 type FavoriteServiceHTTPClientImpl struct {
@@ -87,6 +267,45 @@ func NewFavoriteServiceHTTPClient(client *http.Client) FavoriteServiceHTTPClient
 	return &FavoriteServiceHTTPClientImpl{client}
 }
 
+func (c *FavoriteServiceHTTPClientImpl) CollectAction(ctx context.Context, in *CollectActionRequest, opts ...http.CallOption) (*CollectActionReply, error) {
+	var out CollectActionReply
+	pattern := "/api/collect/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFavoriteServiceCollectAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FavoriteServiceHTTPClientImpl) CreateCollectFolder(ctx context.Context, in *CreateCollectFolderRequest, opts ...http.CallOption) (*CreateCollectFolderReply, error) {
+	var out CreateCollectFolderReply
+	pattern := "/api/collect/folder/create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFavoriteServiceCreateCollectFolder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FavoriteServiceHTTPClientImpl) DeleteCollectFolder(ctx context.Context, in *DeleteCollectFolderRequest, opts ...http.CallOption) (*DeleteCollectFolderReply, error) {
+	var out DeleteCollectFolderReply
+	pattern := "/api/collect/folder/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFavoriteServiceDeleteCollectFolder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *FavoriteServiceHTTPClientImpl) FavoriteAction(ctx context.Context, in *FavoriteActionRequest, opts ...http.CallOption) (*FavoriteActionReply, error) {
 	var out FavoriteActionReply
 	pattern := "/api/favorite/action"
@@ -112,3 +331,55 @@ func (c *FavoriteServiceHTTPClientImpl) GetUserFavoriteVideoList(ctx context.Con
 	}
 	return &out, nil
 }
+
+func (c *FavoriteServiceHTTPClientImpl) ListCollectFolderVideos(ctx context.Context, in *ListCollectFolderVideosRequest, opts ...http.CallOption) (*ListCollectFolderVideosReply, error) {
+	var out ListCollectFolderVideosReply
+	pattern := "/api/collect/folder/videos"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFavoriteServiceListCollectFolderVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FavoriteServiceHTTPClientImpl) ListCollectFolders(ctx context.Context, in *ListCollectFoldersRequest, opts ...http.CallOption) (*ListCollectFoldersReply, error) {
+	var out ListCollectFoldersReply
+	pattern := "/api/collect/folders"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFavoriteServiceListCollectFolders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FavoriteServiceHTTPClientImpl) ListVideoLikers(ctx context.Context, in *ListVideoLikersRequest, opts ...http.CallOption) (*ListVideoLikersReply, error) {
+	var out ListVideoLikersReply
+	pattern := "/api/favorite/likers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFavoriteServiceListVideoLikers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FavoriteServiceHTTPClientImpl) UpdateCollectFolder(ctx context.Context, in *UpdateCollectFolderRequest, opts ...http.CallOption) (*UpdateCollectFolderReply, error) {
+	var out UpdateCollectFolderReply
+	pattern := "/api/collect/folder/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFavoriteServiceUpdateCollectFolder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
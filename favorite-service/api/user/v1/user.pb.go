@@ -22,6 +22,155 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// =========================批量获取用户信息============================
+type BatchGetUserInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorIds     []int64                `protobuf:"varint,1,rep,packed,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUserInfoRequest) Reset() {
+	*x = BatchGetUserInfoRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUserInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUserInfoRequest) ProtoMessage() {}
+
+func (x *BatchGetUserInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUserInfoRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUserInfoRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchGetUserInfoRequest) GetAuthorIds() []int64 {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+type BatchGetUserInfoReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*Author              `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUserInfoReply) Reset() {
+	*x = BatchGetUserInfoReply{}
+	mi := &file_user_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUserInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUserInfoReply) ProtoMessage() {}
+
+func (x *BatchGetUserInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUserInfoReply.ProtoReflect.Descriptor instead.
+func (*BatchGetUserInfoReply) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchGetUserInfoReply) GetUsers() []*Author {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_user_v1_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Author) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
 // =========================用户存在============================
 type CheckUserExistByUserIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -32,7 +181,7 @@ type CheckUserExistByUserIDRequest struct {
 
 func (x *CheckUserExistByUserIDRequest) Reset() {
 	*x = CheckUserExistByUserIDRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[0]
+	mi := &file_user_v1_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +193,7 @@ func (x *CheckUserExistByUserIDRequest) String() string {
 func (*CheckUserExistByUserIDRequest) ProtoMessage() {}
 
 func (x *CheckUserExistByUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[0]
+	mi := &file_user_v1_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +206,7 @@ func (x *CheckUserExistByUserIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserExistByUserIDRequest.ProtoReflect.Descriptor instead.
 func (*CheckUserExistByUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CheckUserExistByUserIDRequest) GetUserId() int64 {
@@ -76,7 +225,7 @@ type CheckUserExistByUserIDReply struct {
 
 func (x *CheckUserExistByUserIDReply) Reset() {
 	*x = CheckUserExistByUserIDReply{}
-	mi := &file_user_v1_user_proto_msgTypes[1]
+	mi := &file_user_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -88,7 +237,7 @@ func (x *CheckUserExistByUserIDReply) String() string {
 func (*CheckUserExistByUserIDReply) ProtoMessage() {}
 
 func (x *CheckUserExistByUserIDReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[1]
+	mi := &file_user_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -101,7 +250,7 @@ func (x *CheckUserExistByUserIDReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserExistByUserIDReply.ProtoReflect.Descriptor instead.
 func (*CheckUserExistByUserIDReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{1}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CheckUserExistByUserIDReply) GetExist() bool {
@@ -121,7 +270,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[2]
+	mi := &file_user_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +282,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[2]
+	mi := &file_user_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +295,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RegisterRequest) GetUsername() string {
@@ -175,7 +324,7 @@ type RegisterReply struct {
 
 func (x *RegisterReply) Reset() {
 	*x = RegisterReply{}
-	mi := &file_user_v1_user_proto_msgTypes[3]
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +336,7 @@ func (x *RegisterReply) String() string {
 func (*RegisterReply) ProtoMessage() {}
 
 func (x *RegisterReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[3]
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +349,7 @@ func (x *RegisterReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
 func (*RegisterReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RegisterReply) GetStatusCode() int32 {
@@ -242,7 +391,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[4]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +403,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[4]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +416,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LoginRequest) GetUsername() string {
@@ -297,7 +446,7 @@ type LoginReply struct {
 
 func (x *LoginReply) Reset() {
 	*x = LoginReply{}
-	mi := &file_user_v1_user_proto_msgTypes[5]
+	mi := &file_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +458,7 @@ func (x *LoginReply) String() string {
 func (*LoginReply) ProtoMessage() {}
 
 func (x *LoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[5]
+	mi := &file_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +471,7 @@ func (x *LoginReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
 func (*LoginReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LoginReply) GetStatusCode() int32 {
@@ -371,7 +520,7 @@ type UserInfoRequest struct {
 
 func (x *UserInfoRequest) Reset() {
 	*x = UserInfoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[6]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +532,7 @@ func (x *UserInfoRequest) String() string {
 func (*UserInfoRequest) ProtoMessage() {}
 
 func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[6]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +545,7 @@ func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
 func (*UserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserInfoRequest) GetUserId() int64 {
@@ -424,7 +573,7 @@ type UserInfoReply struct {
 
 func (x *UserInfoReply) Reset() {
 	*x = UserInfoReply{}
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +585,7 @@ func (x *UserInfoReply) String() string {
 func (*UserInfoReply) ProtoMessage() {}
 
 func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +598,7 @@ func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
 func (*UserInfoReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserInfoReply) GetStatusCode() int32 {
@@ -492,7 +641,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +653,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +666,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *User) GetId() int64 {
@@ -608,7 +757,7 @@ type ParseTokenRequest struct {
 
 func (x *ParseTokenRequest) Reset() {
 	*x = ParseTokenRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +769,7 @@ func (x *ParseTokenRequest) String() string {
 func (*ParseTokenRequest) ProtoMessage() {}
 
 func (x *ParseTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +782,7 @@ func (x *ParseTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseTokenRequest.ProtoReflect.Descriptor instead.
 func (*ParseTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ParseTokenRequest) GetToken() string {
@@ -659,7 +808,7 @@ type ParseTokenReply struct {
 
 func (x *ParseTokenReply) Reset() {
 	*x = ParseTokenReply{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +820,7 @@ func (x *ParseTokenReply) String() string {
 func (*ParseTokenReply) ProtoMessage() {}
 
 func (x *ParseTokenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +833,7 @@ func (x *ParseTokenReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseTokenReply.ProtoReflect.Descriptor instead.
 func (*ParseTokenReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ParseTokenReply) GetUserId() int64 {
@@ -703,7 +852,7 @@ type RefreshRequest struct {
 
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +864,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +877,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RefreshRequest) GetRefreshToken() string {
@@ -749,7 +898,7 @@ type RefreshReply struct {
 
 func (x *RefreshReply) Reset() {
 	*x = RefreshReply{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -761,7 +910,7 @@ func (x *RefreshReply) String() string {
 func (*RefreshReply) ProtoMessage() {}
 
 func (x *RefreshReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +923,7 @@ func (x *RefreshReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshReply.ProtoReflect.Descriptor instead.
 func (*RefreshReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RefreshReply) GetStatusCode() int32 {
@@ -803,6 +952,16 @@ var File_user_v1_user_proto protoreflect.FileDescriptor
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"\x12user/v1/user.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\"8\n" +
+	"\x17BatchGetUserInfoRequest\x12\x1d\n" +
+	"\n" +
+	"author_ids\x18\x01 \x03(\x03R\tauthorIds\";\n" +
+	"\x15BatchGetUserInfoReply\x12\"\n" +
+	"\x05users\x18\x01 \x03(\v2\f.user.AuthorR\x05users\"K\n" +
+	"\x06Author\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\"8\n" +
 	"\x1dCheckUserExistByUserIDRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"3\n" +
 	"\x1bCheckUserExistByUserIDReply\x12\x14\n" +
@@ -865,7 +1024,7 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05token2\xed\x03\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token2\xbd\x04\n" +
 	"\vUserService\x12U\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x13.user.RegisterReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/user/register\x12I\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x10.user.LoginReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/user/login\x12I\n" +
@@ -873,7 +1032,8 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\fRefreshToken\x12\x14.user.RefreshRequest\x1a\x12.user.RefreshReply\x12<\n" +
 	"\n" +
 	"ParseToken\x12\x17.user.ParseTokenRequest\x1a\x15.user.ParseTokenReply\x12y\n" +
-	"\x16CheckUserExistByUserID\x12#.user.CheckUserExistByUserIDRequest\x1a!.user.CheckUserExistByUserIDReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/user/checkB\x15Z\x13user/api/user/v1;v1b\x06proto3"
+	"\x16CheckUserExistByUserID\x12#.user.CheckUserExistByUserIDRequest\x1a!.user.CheckUserExistByUserIDReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/user/check\x12N\n" +
+	"\x10BatchGetUserInfo\x12\x1d.user.BatchGetUserInfoRequest\x1a\x1b.user.BatchGetUserInfoReplyB\x15Z\x13user/api/user/v1;v1b\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -887,41 +1047,47 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_user_v1_user_proto_goTypes = []any{
-	(*CheckUserExistByUserIDRequest)(nil), // 0: user.CheckUserExistByUserIDRequest
-	(*CheckUserExistByUserIDReply)(nil),   // 1: user.CheckUserExistByUserIDReply
-	(*RegisterRequest)(nil),               // 2: user.RegisterRequest
-	(*RegisterReply)(nil),                 // 3: user.RegisterReply
-	(*LoginRequest)(nil),                  // 4: user.LoginRequest
-	(*LoginReply)(nil),                    // 5: user.LoginReply
-	(*UserInfoRequest)(nil),               // 6: user.UserInfoRequest
-	(*UserInfoReply)(nil),                 // 7: user.UserInfoReply
-	(*User)(nil),                          // 8: user.User
-	(*ParseTokenRequest)(nil),             // 9: user.ParseTokenRequest
-	(*ParseTokenReply)(nil),               // 10: user.ParseTokenReply
-	(*RefreshRequest)(nil),                // 11: user.RefreshRequest
-	(*RefreshReply)(nil),                  // 12: user.RefreshReply
+	(*BatchGetUserInfoRequest)(nil),       // 0: user.BatchGetUserInfoRequest
+	(*BatchGetUserInfoReply)(nil),         // 1: user.BatchGetUserInfoReply
+	(*Author)(nil),                        // 2: user.Author
+	(*CheckUserExistByUserIDRequest)(nil), // 3: user.CheckUserExistByUserIDRequest
+	(*CheckUserExistByUserIDReply)(nil),   // 4: user.CheckUserExistByUserIDReply
+	(*RegisterRequest)(nil),               // 5: user.RegisterRequest
+	(*RegisterReply)(nil),                 // 6: user.RegisterReply
+	(*LoginRequest)(nil),                  // 7: user.LoginRequest
+	(*LoginReply)(nil),                    // 8: user.LoginReply
+	(*UserInfoRequest)(nil),               // 9: user.UserInfoRequest
+	(*UserInfoReply)(nil),                 // 10: user.UserInfoReply
+	(*User)(nil),                          // 11: user.User
+	(*ParseTokenRequest)(nil),             // 12: user.ParseTokenRequest
+	(*ParseTokenReply)(nil),               // 13: user.ParseTokenReply
+	(*RefreshRequest)(nil),                // 14: user.RefreshRequest
+	(*RefreshReply)(nil),                  // 15: user.RefreshReply
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	8,  // 0: user.UserInfoReply.user:type_name -> user.User
-	2,  // 1: user.UserService.Register:input_type -> user.RegisterRequest
-	4,  // 2: user.UserService.Login:input_type -> user.LoginRequest
-	6,  // 3: user.UserService.UserInfo:input_type -> user.UserInfoRequest
-	11, // 4: user.UserService.RefreshToken:input_type -> user.RefreshRequest
-	9,  // 5: user.UserService.ParseToken:input_type -> user.ParseTokenRequest
-	0,  // 6: user.UserService.CheckUserExistByUserID:input_type -> user.CheckUserExistByUserIDRequest
-	3,  // 7: user.UserService.Register:output_type -> user.RegisterReply
-	5,  // 8: user.UserService.Login:output_type -> user.LoginReply
-	7,  // 9: user.UserService.UserInfo:output_type -> user.UserInfoReply
-	12, // 10: user.UserService.RefreshToken:output_type -> user.RefreshReply
-	10, // 11: user.UserService.ParseToken:output_type -> user.ParseTokenReply
-	1,  // 12: user.UserService.CheckUserExistByUserID:output_type -> user.CheckUserExistByUserIDReply
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	2,  // 0: user.BatchGetUserInfoReply.users:type_name -> user.Author
+	11, // 1: user.UserInfoReply.user:type_name -> user.User
+	5,  // 2: user.UserService.Register:input_type -> user.RegisterRequest
+	7,  // 3: user.UserService.Login:input_type -> user.LoginRequest
+	9,  // 4: user.UserService.UserInfo:input_type -> user.UserInfoRequest
+	14, // 5: user.UserService.RefreshToken:input_type -> user.RefreshRequest
+	12, // 6: user.UserService.ParseToken:input_type -> user.ParseTokenRequest
+	3,  // 7: user.UserService.CheckUserExistByUserID:input_type -> user.CheckUserExistByUserIDRequest
+	0,  // 8: user.UserService.BatchGetUserInfo:input_type -> user.BatchGetUserInfoRequest
+	6,  // 9: user.UserService.Register:output_type -> user.RegisterReply
+	8,  // 10: user.UserService.Login:output_type -> user.LoginReply
+	10, // 11: user.UserService.UserInfo:output_type -> user.UserInfoReply
+	15, // 12: user.UserService.RefreshToken:output_type -> user.RefreshReply
+	13, // 13: user.UserService.ParseToken:output_type -> user.ParseTokenReply
+	4,  // 14: user.UserService.CheckUserExistByUserID:output_type -> user.CheckUserExistByUserIDReply
+	1,  // 15: user.UserService.BatchGetUserInfo:output_type -> user.BatchGetUserInfoReply
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -935,7 +1101,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
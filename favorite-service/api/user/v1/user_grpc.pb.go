@@ -25,6 +25,7 @@ const (
 	UserService_RefreshToken_FullMethodName           = "/user.UserService/RefreshToken"
 	UserService_ParseToken_FullMethodName             = "/user.UserService/ParseToken"
 	UserService_CheckUserExistByUserID_FullMethodName = "/user.UserService/CheckUserExistByUserID"
+	UserService_BatchGetUserInfo_FullMethodName       = "/user.UserService/BatchGetUserInfo"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -37,6 +38,7 @@ type UserServiceClient interface {
 	RefreshToken(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshReply, error)
 	ParseToken(ctx context.Context, in *ParseTokenRequest, opts ...grpc.CallOption) (*ParseTokenReply, error)
 	CheckUserExistByUserID(ctx context.Context, in *CheckUserExistByUserIDRequest, opts ...grpc.CallOption) (*CheckUserExistByUserIDReply, error)
+	BatchGetUserInfo(ctx context.Context, in *BatchGetUserInfoRequest, opts ...grpc.CallOption) (*BatchGetUserInfoReply, error)
 }
 
 type userServiceClient struct {
@@ -107,6 +109,16 @@ func (c *userServiceClient) CheckUserExistByUserID(ctx context.Context, in *Chec
 	return out, nil
 }
 
+func (c *userServiceClient) BatchGetUserInfo(ctx context.Context, in *BatchGetUserInfoRequest, opts ...grpc.CallOption) (*BatchGetUserInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetUserInfoReply)
+	err := c.cc.Invoke(ctx, UserService_BatchGetUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -117,6 +129,7 @@ type UserServiceServer interface {
 	RefreshToken(context.Context, *RefreshRequest) (*RefreshReply, error)
 	ParseToken(context.Context, *ParseTokenRequest) (*ParseTokenReply, error)
 	CheckUserExistByUserID(context.Context, *CheckUserExistByUserIDRequest) (*CheckUserExistByUserIDReply, error)
+	BatchGetUserInfo(context.Context, *BatchGetUserInfoRequest) (*BatchGetUserInfoReply, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -145,6 +158,9 @@ func (UnimplementedUserServiceServer) ParseToken(context.Context, *ParseTokenReq
 func (UnimplementedUserServiceServer) CheckUserExistByUserID(context.Context, *CheckUserExistByUserIDRequest) (*CheckUserExistByUserIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckUserExistByUserID not implemented")
 }
+func (UnimplementedUserServiceServer) BatchGetUserInfo(context.Context, *BatchGetUserInfoRequest) (*BatchGetUserInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUserInfo not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -274,6 +290,24 @@ func _UserService_CheckUserExistByUserID_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BatchGetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchGetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BatchGetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchGetUserInfo(ctx, req.(*BatchGetUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +339,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckUserExistByUserID",
 			Handler:    _UserService_CheckUserExistByUserID_Handler,
 		},
+		{
+			MethodName: "BatchGetUserInfo",
+			Handler:    _UserService_BatchGetUserInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
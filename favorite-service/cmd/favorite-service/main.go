@@ -6,6 +6,8 @@ import (
 	"github.com/go-kratos/kratos/v2/registry"
 	"os"
 
+	"common/counter"
+	"common/outbox"
 	"favorite-service/internal/conf"
 
 	"github.com/go-kratos/kratos/v2"
@@ -35,7 +37,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Registrar, ob *outbox.Relay, ck *counter.Checker) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +47,8 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Re
 		kratos.Server(
 			gs,
 			hs,
+			ob,
+			ck,
 		),
 		kratos.Registrar(reg),
 	)
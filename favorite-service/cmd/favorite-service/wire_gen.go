@@ -32,17 +32,23 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, re
 	discovery := data.NewDiscover(registry)
 	userServiceClient := data.NewUserServiceClient(confData, discovery)
 	videoServiceClient := data.NewVideoServiceClient(confData, discovery)
-	dataData, cleanup, err := data.NewData(confData, logger, db, client, userServiceClient, videoServiceClient)
+	relationServiceClient := data.NewRelationServiceClient(confData, discovery)
+	dataData, cleanup, err := data.NewData(confData, logger, db, client, userServiceClient, videoServiceClient, relationServiceClient)
 	if err != nil {
 		return nil, nil, err
 	}
 	favoriteRepo := data.NewFavoriteRepo(dataData, logger)
-	favoriteUsecase := biz.NewFavoriteUsecase(favoriteRepo, logger)
-	favoriteService := service.NewFavoriteService(favoriteUsecase)
+	likerRepo := data.NewLikerRepo(dataData, logger)
+	favoriteUsecase := biz.NewFavoriteUsecase(favoriteRepo, likerRepo, logger)
+	collectRepo := data.NewCollectRepo(dataData, logger)
+	collectUsecase := biz.NewCollectUsecase(collectRepo, favoriteRepo, logger)
+	favoriteService := service.NewFavoriteService(favoriteUsecase, collectUsecase)
 	grpcServer := server.NewGRPCServer(confServer, favoriteService, logger)
 	httpServer := server.NewHTTPServer(confServer, favoriteService, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, httpServer, registrar)
+	relay := data.NewOutboxRelay(confData, db, logger)
+	checker := data.NewCounterChecker(confData, dataData, logger)
+	app := newApp(logger, grpcServer, httpServer, registrar, relay, checker)
 	return app, func() {
 		cleanup()
 	}, nil
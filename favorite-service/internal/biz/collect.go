@@ -0,0 +1,251 @@
+package biz
+
+import (
+	"context"
+	v1 "favorite-service/api/favorite/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultCollectFolderName 默认收藏夹名称，首次收藏时自动创建
+	DefaultCollectFolderName = "默认收藏夹"
+	// MaxCollectFolderNameLen 收藏夹名称最大字符数
+	MaxCollectFolderNameLen = 32
+	// MaxCollectFolders 每个用户最多可创建的收藏夹数
+	MaxCollectFolders = 50
+)
+
+const (
+	// 收藏事件类型，用户首次收藏、最后一个收藏夹移除视频时写入，由 video-service 消费维护收藏数和分数
+	EventVideoCollected   = "VideoCollected"
+	EventVideoUncollected = "VideoUncollected"
+)
+
+// VideoCollectEvent 收藏、取消收藏事件
+type VideoCollectEvent struct {
+	VideoID int64 `json:"video_id"`
+	UserID  int64 `json:"user_id"`
+}
+
+// CollectFolder 收藏夹
+type CollectFolder struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	IsPublic  bool
+	IsDefault bool
+	VideoCnt  int32
+	CreatedAt time.Time
+}
+
+// CollectRepo 收藏夹仓储
+type CollectRepo interface {
+	GetFolder(ctx context.Context, folderID int64) (*CollectFolder, error)
+	GetOrCreateDefaultFolder(ctx context.Context, uid int64) (*CollectFolder, error)
+	CountFolders(ctx context.Context, uid int64) (int64, error)
+	CreateFolder(ctx context.Context, folder *CollectFolder) error
+	UpdateFolder(ctx context.Context, folder *CollectFolder) error
+	DeleteFolder(ctx context.Context, uid int64, folderID int64) error
+	ListFolders(ctx context.Context, uid int64, onlyPublic bool) ([]*CollectFolder, error)
+	AddCollect(ctx context.Context, uid int64, folderID int64, vid int64) error
+	RemoveCollect(ctx context.Context, uid int64, folderID int64, vid int64) error
+	ListFolderVideoIDs(ctx context.Context, folderID int64, page, pageSize int) ([]int64, error)
+	// BatchIsCollected 返回 vids 中用户收藏过的视频，收藏在任意收藏夹中都算
+	BatchIsCollected(ctx context.Context, uid int64, vids []int64) ([]int64, error)
+	CheckVideoExists(ctx context.Context, vid int64) (bool, error)
+}
+
+type CollectUsecase struct {
+	repo  CollectRepo
+	frepo FavoriteRepo
+	log   *log.Helper
+}
+
+func NewCollectUsecase(repo CollectRepo, frepo FavoriteRepo, logger log.Logger) *CollectUsecase {
+	return &CollectUsecase{repo: repo, frepo: frepo, log: log.NewHelper(logger)}
+}
+
+// CollectAction 收藏、取消收藏视频
+func (uc *CollectUsecase) CollectAction(ctx context.Context, uid int64, actionType int32, vid int64, folderID int64) error {
+	uc.log.WithContext(ctx).Infof("CollectAction: user_id=%d action_type=%d video_id=%d folder_id=%d", uid, actionType, vid, folderID)
+
+	switch actionType {
+	// action_type=1，为收藏
+	case 1:
+		exists, err := uc.repo.CheckVideoExists(ctx, vid)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.NotFound("VIDEO_NOT_FOUND", "视频不存在")
+		}
+		var folder *CollectFolder
+		if folderID == 0 {
+			folder, err = uc.repo.GetOrCreateDefaultFolder(ctx, uid)
+		} else {
+			folder, err = uc.ownedFolder(ctx, uid, folderID)
+		}
+		if err != nil {
+			return err
+		}
+		return uc.repo.AddCollect(ctx, uid, folder.ID, vid)
+	// action_type=2，为取消收藏，folder_id 为 0 时从全部收藏夹移除
+	case 2:
+		if folderID != 0 {
+			if _, err := uc.ownedFolder(ctx, uid, folderID); err != nil {
+				return err
+			}
+		}
+		return uc.repo.RemoveCollect(ctx, uid, folderID, vid)
+	default:
+		return errors.BadRequest("INVALID_PARAM", "invalid action type")
+	}
+}
+
+// CreateFolder 创建收藏夹
+func (uc *CollectUsecase) CreateFolder(ctx context.Context, uid int64, name string, isPublic bool) (*CollectFolder, error) {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+	cnt, err := uc.repo.CountFolders(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if cnt >= MaxCollectFolders {
+		return nil, errors.BadRequest("TOO_MANY_FOLDERS", "收藏夹数量已达上限")
+	}
+	folder := &CollectFolder{UserID: uid, Name: name, IsPublic: isPublic}
+	if err := uc.repo.CreateFolder(ctx, folder); err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// UpdateFolder 修改收藏夹
+func (uc *CollectUsecase) UpdateFolder(ctx context.Context, uid int64, folderID int64, name string, isPublic bool) (*CollectFolder, error) {
+	folder, err := uc.ownedFolder(ctx, uid, folderID)
+	if err != nil {
+		return nil, err
+	}
+	// 默认收藏夹只能修改公开状态
+	if !folder.IsDefault {
+		if folder.Name, err = normalizeFolderName(name); err != nil {
+			return nil, err
+		}
+	}
+	folder.IsPublic = isPublic
+	if err := uc.repo.UpdateFolder(ctx, folder); err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// DeleteFolder 删除收藏夹及其中的收藏
+func (uc *CollectUsecase) DeleteFolder(ctx context.Context, uid int64, folderID int64) error {
+	folder, err := uc.ownedFolder(ctx, uid, folderID)
+	if err != nil {
+		return err
+	}
+	if folder.IsDefault {
+		return errors.BadRequest("DEFAULT_FOLDER", "默认收藏夹不能删除")
+	}
+	return uc.repo.DeleteFolder(ctx, uid, folderID)
+}
+
+// ListFolders 获取收藏夹列表
+func (uc *CollectUsecase) ListFolders(ctx context.Context, viewerID int64, targetUID int64) ([]*CollectFolder, error) {
+	exists, err := uc.frepo.CheckUserExists(ctx, targetUID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NotFound("USER_NOT_FOUND", "user not exists")
+	}
+	return uc.repo.ListFolders(ctx, targetUID, viewerID != targetUID)
+}
+
+// ListFolderVideos 获取收藏夹中的视频，按收藏时间倒序
+func (uc *CollectUsecase) ListFolderVideos(ctx context.Context, viewerID int64, folderID int64, page, pageSize int) ([]*v1.Video, int32, error) {
+	folder, err := uc.repo.GetFolder(ctx, folderID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if folder == nil || (!folder.IsPublic && folder.UserID != viewerID) {
+		return nil, 0, errors.NotFound("FOLDER_NOT_FOUND", "收藏夹不存在")
+	}
+
+	ids, err := uc.repo.ListFolderVideoIDs(ctx, folderID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(ids) == 0 {
+		return []*v1.Video{}, folder.VideoCnt, nil
+	}
+
+	videos, err := uc.frepo.BatchGetVideoInfo(ctx, ids, 1, len(ids))
+	if err != nil {
+		return nil, 0, err
+	}
+	// 按收藏顺序返回，已删除的视频直接跳过
+	byID := make(map[int64]*v1.Video, len(videos))
+	for _, v := range videos {
+		byID[v.Id] = &v1.Video{
+			VideoId:      v.Id,
+			Title:        v.Title,
+			CoverUrl:     v.CoverUrl,
+			AuthorId:     v.UserId,
+			LikeCount:    int64(v.FavoriteCnt),
+			CommentCount: int64(v.CommentCnt),
+			PublishTime:  v.CreatedAt.AsTime().Unix(),
+		}
+	}
+	videoList := make([]*v1.Video, 0, len(ids))
+	for _, id := range ids {
+		if v, ok := byID[id]; ok {
+			videoList = append(videoList, v)
+		}
+	}
+	return videoList, folder.VideoCnt, nil
+}
+
+// BatchIsCollected 批量查询用户已收藏的视频
+func (uc *CollectUsecase) BatchIsCollected(ctx context.Context, uid int64, vids []int64) ([]int64, error) {
+	if uid == 0 || len(vids) == 0 {
+		return []int64{}, nil
+	}
+	return uc.repo.BatchIsCollected(ctx, uid, vids)
+}
+
+// ownedFolder 获取当前用户自己的收藏夹
+func (uc *CollectUsecase) ownedFolder(ctx context.Context, uid int64, folderID int64) (*CollectFolder, error) {
+	folder, err := uc.repo.GetFolder(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if folder == nil {
+		return nil, errors.NotFound("FOLDER_NOT_FOUND", "收藏夹不存在")
+	}
+	if folder.UserID != uid {
+		return nil, errors.Forbidden("FORBIDDEN", "不能操作他人的收藏夹")
+	}
+	return folder, nil
+}
+
+func normalizeFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.BadRequest("INVALID_PARAM", "收藏夹名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > MaxCollectFolderNameLen {
+		return "", errors.BadRequest("INVALID_PARAM", "收藏夹名称过长")
+	}
+	if name == DefaultCollectFolderName {
+		return "", errors.BadRequest("INVALID_PARAM", "收藏夹名称已被占用")
+	}
+	return name, nil
+}
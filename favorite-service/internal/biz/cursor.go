@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FavoriteCursor 点赞列表游标，记录上一页最后一条点赞的时间与 id，按 (created_at, id) 倒序翻页
+type FavoriteCursor struct {
+	CreatedAt int64 // 毫秒
+	ID        int64
+}
+
+// Time 点赞时间
+func (c *FavoriteCursor) Time() time.Time {
+	return time.UnixMilli(c.CreatedAt)
+}
+
+func encodeFavoriteCursor(c *FavoriteCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.CreatedAt, c.ID)))
+}
+
+// decodeFavoriteCursor 空游标表示第一页
+func decodeFavoriteCursor(s string) (*FavoriteCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid favorite cursor: %s", b)
+	}
+	c := new(FavoriteCursor)
+	var err1, err2 error
+	c.CreatedAt, err1 = strconv.ParseInt(parts[0], 10, 64)
+	c.ID, err2 = strconv.ParseInt(parts[1], 10, 64)
+	if err1 != nil || err2 != nil {
+		return nil, fmt.Errorf("invalid favorite cursor: %s", b)
+	}
+	return c, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	v1 "favorite-service/api/favorite/v1"
 	pbVideo "favorite-service/api/video/v1"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -13,18 +14,43 @@ type FavoriteRepo interface {
 	ParseToken(context.Context, string, string) (int64, error)
 	AddFavorite(ctx context.Context, uid int64, vid int64) error
 	RemoveFavorite(ctx context.Context, uid int64, vid int64) error
-	GetUserFavoriteVideoIDs(ctx context.Context, uid int64) ([]int64, error)
+	// ListUserFavorites 按 (created_at, id) 倒序读取用户游标之后的点赞
+	ListUserFavorites(ctx context.Context, uid int64, cursor *FavoriteCursor, limit int) ([]*Like, error)
 	CheckUserExists(ctx context.Context, uid int64) (bool, error)
 	BatchGetVideoInfo(ctx context.Context, ids []int64, page int, pageSize int) ([]*pbVideo.Video, error)
+	// BatchIsFavorited 返回 vids 中用户已点赞的视频
+	BatchIsFavorited(ctx context.Context, uid int64, vids []int64) ([]int64, error)
+}
+
+const (
+	// 点赞事件类型，由 video-service 消费维护点赞数和分数
+	EventVideoLiked   = "VideoLiked"
+	EventVideoUnliked = "VideoUnliked"
+)
+
+const (
+	// 用户点赞列表每页默认数量与上限
+	DefaultFavoritePageSize = 10
+	MaxFavoritePageSize     = 30
+)
+
+// MaxBatchFavoriteQuery 批量查询点赞、收藏状态时最多的视频数
+const MaxBatchFavoriteQuery = 100
+
+// VideoLikeEvent 点赞、取消点赞事件
+type VideoLikeEvent struct {
+	VideoID int64 `json:"video_id"`
+	UserID  int64 `json:"user_id"`
 }
 
 type FavoriteUsecase struct {
-	repo FavoriteRepo
-	log  *log.Helper
+	repo   FavoriteRepo
+	likers LikerRepo
+	log    *log.Helper
 }
 
-func NewFavoriteUsecase(repo FavoriteRepo, logger log.Logger) *FavoriteUsecase {
-	return &FavoriteUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewFavoriteUsecase(repo FavoriteRepo, likers LikerRepo, logger log.Logger) *FavoriteUsecase {
+	return &FavoriteUsecase{repo: repo, likers: likers, log: log.NewHelper(logger)}
 }
 
 // ParseToken 解析token获取用户id
@@ -54,34 +80,58 @@ func (uc *FavoriteUsecase) FavoriteAction(ctx context.Context, uid int64, action
 
 }
 
-func (uc *FavoriteUsecase) GetUserFavoriteVideoList(ctx context.Context, uid int64, page, pageSize int) ([]*v1.Video, error) {
-	uc.log.WithContext(ctx).Infof("GetUserFavoriteVideoList: uid=%d", uid)
+// GetUserFavoriteVideoList 用户点赞的视频，按点赞时间倒序
+func (uc *FavoriteUsecase) GetUserFavoriteVideoList(ctx context.Context, uid int64, cursor string, limit int) ([]*v1.Video, string, bool, error) {
+	uc.log.WithContext(ctx).Infof("GetUserFavoriteVideoList: uid=%d cursor=%s", uid, cursor)
+	cur, err := decodeFavoriteCursor(cursor)
+	if err != nil {
+		return nil, "", false, kerrors.BadRequest("INVALID_CURSOR", "游标不合法")
+	}
+
 	// 1. 检查用户是否存在
 	exists, err := uc.repo.CheckUserExists(ctx, uid)
 	if err != nil {
-		return nil, err
+		return nil, "", false, err
 	}
 	if !exists {
-		return nil, errors.New("user not exists")
+		return nil, "", false, errors.New("user not exists")
 	}
 
-	// 2. 根据uid获取视频ids
-	ids, err := uc.repo.GetUserFavoriteVideoIDs(ctx, uid)
+	// 2. 多取一条判断是否还有下一页
+	likes, err := uc.repo.ListUserFavorites(ctx, uid, cur, limit+1)
 	if err != nil {
-		return nil, err
+		return nil, "", false, err
 	}
-	if len(ids) == 0 {
-		return []*v1.Video{}, nil
+	hasMore := len(likes) > limit
+	if hasMore {
+		likes = likes[:limit]
+	}
+	if len(likes) == 0 {
+		return []*v1.Video{}, "", false, nil
+	}
+	last := likes[len(likes)-1]
+	nextCursor := encodeFavoriteCursor(&FavoriteCursor{CreatedAt: last.CreatedAt.UnixMilli(), ID: last.ID})
+
+	ids := make([]int64, 0, len(likes))
+	for _, l := range likes {
+		ids = append(ids, l.VideoID)
 	}
 
-	// 3. 根据获取的视频ids批量查询视频信息（video-service）
-	uc.log.WithContext(ctx).Infof("GetUserFavoriteVideoList: ids=%v", ids)
-	videos, err := uc.repo.BatchGetVideoInfo(ctx, ids, page, pageSize)
+	// 3. 根据获取的视频ids批量查询视频信息（video-service），已删除的视频不返回
+	videos, err := uc.repo.BatchGetVideoInfo(ctx, ids, 1, len(ids))
 	if err != nil {
-		return nil, err
+		return nil, "", false, err
 	}
-	videoList := make([]*v1.Video, 0, len(videos))
+	byID := make(map[int64]*pbVideo.Video, len(videos))
 	for _, v := range videos {
+		byID[v.Id] = v
+	}
+	videoList := make([]*v1.Video, 0, len(videos))
+	for _, id := range ids {
+		v, ok := byID[id]
+		if !ok {
+			continue
+		}
 		videoList = append(videoList, &v1.Video{
 			VideoId:      v.Id,
 			Title:        v.Title,
@@ -93,5 +143,13 @@ func (uc *FavoriteUsecase) GetUserFavoriteVideoList(ctx context.Context, uid int
 		})
 	}
 
-	return videoList, nil
+	return videoList, nextCursor, hasMore, nil
+}
+
+// BatchIsFavorited 批量查询用户已点赞的视频
+func (uc *FavoriteUsecase) BatchIsFavorited(ctx context.Context, uid int64, vids []int64) ([]int64, error) {
+	if uid == 0 || len(vids) == 0 {
+		return []int64{}, nil
+	}
+	return uc.repo.BatchIsFavorited(ctx, uid, vids)
 }
@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+	v1 "favorite-service/api/favorite/v1"
+	pbUser "favorite-service/api/user/v1"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// 点赞用户列表每页默认数量与上限
+	DefaultLikerPageSize = 20
+	MaxLikerPageSize     = 50
+	// FollowStateTimeout 查询关注状态的超时时间，超时后按未关注返回
+	FollowStateTimeout = 200 * time.Millisecond
+)
+
+// Like 一条点赞记录
+type Like struct {
+	ID        int64
+	UserID    int64
+	VideoID   int64
+	CreatedAt time.Time
+}
+
+// LikerRepo 点赞用户列表
+type LikerRepo interface {
+	// ListVideoLikes 按 (created_at, id) 倒序读取游标之后的点赞记录
+	ListVideoLikes(ctx context.Context, vid int64, cursor *FavoriteCursor, limit int) ([]*Like, error)
+	BatchGetUserInfo(ctx context.Context, uids []int64) ([]*pbUser.Author, error)
+	// BatchIsFollowing 当前用户关注了哪些用户
+	BatchIsFollowing(ctx context.Context, uid int64, toUserIDs []int64) (map[int64]bool, error)
+	// CheckVideoVisible 视频是否已发布、公开且未删除，或 viewer 是作者
+	CheckVideoVisible(ctx context.Context, vid, viewer int64) (bool, error)
+}
+
+// ListVideoLikers 点赞了视频的用户，按点赞时间倒序；viewer 为 0 时不查询关注状态
+// 只有作者能查看未发布、私密或已删除视频的点赞用户
+func (uc *FavoriteUsecase) ListVideoLikers(ctx context.Context, viewer, vid int64, cursor string, limit int) ([]*v1.Liker, string, bool, error) {
+	cur, err := decodeFavoriteCursor(cursor)
+	if err != nil {
+		return nil, "", false, errors.BadRequest("INVALID_CURSOR", "游标不合法")
+	}
+	visible, err := uc.likers.CheckVideoVisible(ctx, vid, viewer)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if !visible {
+		return nil, "", false, errors.NotFound("VIDEO_NOT_FOUND", "视频不存在")
+	}
+
+	// 1. 多取一条判断是否还有下一页
+	likes, err := uc.likers.ListVideoLikes(ctx, vid, cur, limit+1)
+	if err != nil {
+		return nil, "", false, err
+	}
+	hasMore := len(likes) > limit
+	if hasMore {
+		likes = likes[:limit]
+	}
+	if len(likes) == 0 {
+		return []*v1.Liker{}, "", false, nil
+	}
+	last := likes[len(likes)-1]
+	nextCursor := encodeFavoriteCursor(&FavoriteCursor{CreatedAt: last.CreatedAt.UnixMilli(), ID: last.ID})
+
+	uids := make([]int64, 0, len(likes))
+	for _, l := range likes {
+		uids = append(uids, l.UserID)
+	}
+
+	// 2. 用户资料，已注销的用户只返回 id
+	users, err := uc.likers.BatchGetUserInfo(ctx, uids)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("ListVideoLikers: get user info failed: %v", err)
+		return nil, "", false, err
+	}
+	profiles := make(map[int64]*pbUser.Author, len(users))
+	for _, u := range users {
+		profiles[u.Id] = u
+	}
+
+	// 3. 关注状态，查询失败或超时时按未关注返回
+	var following map[int64]bool
+	if viewer != 0 {
+		fctx, cancel := context.WithTimeout(ctx, FollowStateTimeout)
+		following, err = uc.likers.BatchIsFollowing(fctx, viewer, uids)
+		cancel()
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("ListVideoLikers: get follow state of %d failed: %v", viewer, err)
+		}
+	}
+
+	res := make([]*v1.Liker, 0, len(likes))
+	for _, l := range likes {
+		liker := &v1.Liker{Id: l.UserID, IsFollow: following[l.UserID], LikedAt: l.CreatedAt.Unix()}
+		if p, ok := profiles[l.UserID]; ok {
+			liker.Name, liker.AvatarUrl = p.Name, p.AvatarUrl
+		}
+		res = append(res, liker)
+	}
+	return res, nextCursor, hasMore, nil
+}
@@ -143,13 +143,17 @@ func (x *Server) GetGrpc() *Server_GRPC {
 }
 
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	UserService   *Data_UserService      `protobuf:"bytes,3,opt,name=user_service,json=userService,proto3" json:"user_service,omitempty"`
-	VideoService  *Data_VideoService     `protobuf:"bytes,4,opt,name=video_service,json=videoService,proto3" json:"video_service,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Database        *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis           *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	UserService     *Data_UserService      `protobuf:"bytes,3,opt,name=user_service,json=userService,proto3" json:"user_service,omitempty"`
+	VideoService    *Data_VideoService     `protobuf:"bytes,4,opt,name=video_service,json=videoService,proto3" json:"video_service,omitempty"`
+	Kafka           *Data_Kafka            `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Outbox          *Data_Outbox           `protobuf:"bytes,6,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	Counter         *Data_Counter          `protobuf:"bytes,7,opt,name=counter,proto3" json:"counter,omitempty"`
+	RelationService *Data_RelationService  `protobuf:"bytes,8,opt,name=relation_service,json=relationService,proto3" json:"relation_service,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Data) Reset() {
@@ -210,6 +214,34 @@ func (x *Data) GetVideoService() *Data_VideoService {
 	return nil
 }
 
+func (x *Data) GetKafka() *Data_Kafka {
+	if x != nil {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (x *Data) GetOutbox() *Data_Outbox {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
+func (x *Data) GetCounter() *Data_Counter {
+	if x != nil {
+		return x.Counter
+	}
+	return nil
+}
+
+func (x *Data) GetRelationService() *Data_RelationService {
+	if x != nil {
+		return x.RelationService
+	}
+	return nil
+}
+
 type Registry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Consul        *Registry_Consul       `protobuf:"bytes,1,opt,name=consul,proto3" json:"consul,omitempty"`
@@ -634,6 +666,231 @@ func (x *Data_VideoService) GetEndpoint() string {
 	return ""
 }
 
+type Data_RelationService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_RelationService) Reset() {
+	*x = Data_RelationService{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_RelationService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_RelationService) ProtoMessage() {}
+
+func (x *Data_RelationService) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_RelationService.ProtoReflect.Descriptor instead.
+func (*Data_RelationService) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_RelationService) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type Data_Kafka struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Brokers         []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	VideoEventTopic string                 `protobuf:"bytes,2,opt,name=video_event_topic,json=videoEventTopic,proto3" json:"video_event_topic,omitempty"` // 点赞事件 topic，由 video-service 消费
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Kafka) Reset() {
+	*x = Data_Kafka{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Kafka) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Kafka) ProtoMessage() {}
+
+func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
+func (*Data_Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Kafka) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Data_Kafka) GetVideoEventTopic() string {
+	if x != nil {
+		return x.VideoEventTopic
+	}
+	return ""
+}
+
+type Data_Outbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Retention     *durationpb.Duration   `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"` // 已发布事件的保留时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Outbox) Reset() {
+	*x = Data_Outbox{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Outbox) ProtoMessage() {}
+
+func (x *Data_Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Outbox.ProtoReflect.Descriptor instead.
+func (*Data_Outbox) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_Outbox) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_Outbox) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Outbox) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+// 视频点赞数缓存，漂移检查定期对比缓存与点赞表
+type Data_Counter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Ttl            *durationpb.Duration   `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	CheckInterval  *durationpb.Duration   `protobuf:"bytes,2,opt,name=check_interval,json=checkInterval,proto3" json:"check_interval,omitempty"`
+	CheckBatchSize int32                  `protobuf:"varint,3,opt,name=check_batch_size,json=checkBatchSize,proto3" json:"check_batch_size,omitempty"`
+	CheckFix       bool                   `protobuf:"varint,4,opt,name=check_fix,json=checkFix,proto3" json:"check_fix,omitempty"` // 是否以数据库为准修正漂移的缓存
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_Counter) Reset() {
+	*x = Data_Counter{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Counter) ProtoMessage() {}
+
+func (x *Data_Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Counter.ProtoReflect.Descriptor instead.
+func (*Data_Counter) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Data_Counter) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Counter) GetCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CheckInterval
+	}
+	return nil
+}
+
+func (x *Data_Counter) GetCheckBatchSize() int32 {
+	if x != nil {
+		return x.CheckBatchSize
+	}
+	return 0
+}
+
+func (x *Data_Counter) GetCheckFix() bool {
+	if x != nil {
+		return x.CheckFix
+	}
+	return false
+}
+
 type Registry_Consul struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -643,7 +900,7 @@ type Registry_Consul struct {
 
 func (x *Registry_Consul) Reset() {
 	*x = Registry_Consul{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +912,7 @@ func (x *Registry_Consul) String() string {
 func (*Registry_Consul) ProtoMessage() {}
 
 func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,12 +956,16 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb9\x04\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xf3\t\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12?\n" +
 	"\fuser_service\x18\x03 \x01(\v2\x1c.kratos.api.Data.UserServiceR\vuserService\x12B\n" +
-	"\rvideo_service\x18\x04 \x01(\v2\x1d.kratos.api.Data.VideoServiceR\fvideoService\x1a:\n" +
+	"\rvideo_service\x18\x04 \x01(\v2\x1d.kratos.api.Data.VideoServiceR\fvideoService\x12,\n" +
+	"\x05kafka\x18\x05 \x01(\v2\x16.kratos.api.Data.KafkaR\x05kafka\x12/\n" +
+	"\x06outbox\x18\x06 \x01(\v2\x17.kratos.api.Data.OutboxR\x06outbox\x122\n" +
+	"\acounter\x18\a \x01(\v2\x18.kratos.api.Data.CounterR\acounter\x12K\n" +
+	"\x10relation_service\x18\b \x01(\v2 .kratos.api.Data.RelationServiceR\x0frelationService\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
@@ -716,7 +977,22 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\vUserService\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a*\n" +
 	"\fVideoService\x12\x1a\n" +
-	"\bendpoint\x18\x02 \x01(\tR\bendpoint\"]\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x1a-\n" +
+	"\x0fRelationService\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1aM\n" +
+	"\x05Kafka\x12\x18\n" +
+	"\abrokers\x18\x01 \x03(\tR\abrokers\x12*\n" +
+	"\x11video_event_topic\x18\x02 \x01(\tR\x0fvideoEventTopic\x1a\x97\x01\n" +
+	"\x06Outbox\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x127\n" +
+	"\tretention\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tretention\x1a\xbf\x01\n" +
+	"\aCounter\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12@\n" +
+	"\x0echeck_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rcheckInterval\x12(\n" +
+	"\x10check_batch_size\x18\x03 \x01(\x05R\x0echeckBatchSize\x12\x1b\n" +
+	"\tcheck_fix\x18\x04 \x01(\bR\bcheckFix\"]\n" +
 	"\bRegistry\x123\n" +
 	"\x06consul\x18\x01 \x01(\v2\x1b.kratos.api.Registry.ConsulR\x06consul\x1a\x1c\n" +
 	"\x06Consul\x12\x12\n" +
@@ -737,21 +1013,25 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Registry)(nil),            // 3: kratos.api.Registry
-	(*Service)(nil),             // 4: kratos.api.Service
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*Data_UserService)(nil),    // 9: kratos.api.Data.UserService
-	(*Data_VideoService)(nil),   // 10: kratos.api.Data.VideoService
-	(*Registry_Consul)(nil),     // 11: kratos.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Bootstrap)(nil),            // 0: kratos.api.Bootstrap
+	(*Server)(nil),               // 1: kratos.api.Server
+	(*Data)(nil),                 // 2: kratos.api.Data
+	(*Registry)(nil),             // 3: kratos.api.Registry
+	(*Service)(nil),              // 4: kratos.api.Service
+	(*Server_HTTP)(nil),          // 5: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),          // 6: kratos.api.Server.GRPC
+	(*Data_Database)(nil),        // 7: kratos.api.Data.Database
+	(*Data_Redis)(nil),           // 8: kratos.api.Data.Redis
+	(*Data_UserService)(nil),     // 9: kratos.api.Data.UserService
+	(*Data_VideoService)(nil),    // 10: kratos.api.Data.VideoService
+	(*Data_RelationService)(nil), // 11: kratos.api.Data.RelationService
+	(*Data_Kafka)(nil),           // 12: kratos.api.Data.Kafka
+	(*Data_Outbox)(nil),          // 13: kratos.api.Data.Outbox
+	(*Data_Counter)(nil),         // 14: kratos.api.Data.Counter
+	(*Registry_Consul)(nil),      // 15: kratos.api.Registry.Consul
+	(*durationpb.Duration)(nil),  // 16: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -764,16 +1044,24 @@ var file_conf_conf_proto_depIdxs = []int32{
 	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
 	9,  // 8: kratos.api.Data.user_service:type_name -> kratos.api.Data.UserService
 	10, // 9: kratos.api.Data.video_service:type_name -> kratos.api.Data.VideoService
-	11, // 10: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	12, // 11: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 12: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 13: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	12, // 14: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	12, // 10: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
+	13, // 11: kratos.api.Data.outbox:type_name -> kratos.api.Data.Outbox
+	14, // 12: kratos.api.Data.counter:type_name -> kratos.api.Data.Counter
+	11, // 13: kratos.api.Data.relation_service:type_name -> kratos.api.Data.RelationService
+	15, // 14: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
+	16, // 15: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	16, // 16: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	16, // 17: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	16, // 18: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	16, // 19: kratos.api.Data.Outbox.interval:type_name -> google.protobuf.Duration
+	16, // 20: kratos.api.Data.Outbox.retention:type_name -> google.protobuf.Duration
+	16, // 21: kratos.api.Data.Counter.ttl:type_name -> google.protobuf.Duration
+	16, // 22: kratos.api.Data.Counter.check_interval:type_name -> google.protobuf.Duration
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -787,7 +1075,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
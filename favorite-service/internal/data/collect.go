@@ -0,0 +1,396 @@
+package data
+
+import (
+	"common/outbox"
+	"context"
+	"favorite-service/internal/biz"
+	"favorite-service/internal/data/model"
+	"favorite-service/internal/data/query"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+
+	pbVideo "favorite-service/api/video/v1"
+)
+
+const (
+	// 收藏夹中的视频集合，%d 为收藏夹id
+	keyCollectFolder = "collect:folder:%d"
+	// 用户收藏过的视频集合（任一收藏夹），%d 为用户id
+	keyCollectUser = "collect:user:%d"
+)
+
+type collectRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCollectRepo .
+func NewCollectRepo(data *Data, logger log.Logger) biz.CollectRepo {
+	return &collectRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetFolder 获取收藏夹，不存在时返回 nil
+func (r *collectRepo) GetFolder(ctx context.Context, folderID int64) (*biz.CollectFolder, error) {
+	folder, err := r.data.query.CollectFolder.WithContext(ctx).
+		Where(r.data.query.CollectFolder.ID.Eq(folderID)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizFolder(folder), nil
+}
+
+// GetOrCreateDefaultFolder 获取默认收藏夹，不存在时创建
+func (r *collectRepo) GetOrCreateDefaultFolder(ctx context.Context, uid int64) (*biz.CollectFolder, error) {
+	f := r.data.query.CollectFolder
+	folder, err := f.WithContext(ctx).Where(f.UserID.Eq(uid), f.IsDefault.Is(true)).First()
+	if err == nil {
+		return toBizFolder(folder), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// 并发创建时依赖 (user_id, name) 唯一索引，冲突后重新查询
+	folder = &model.CollectFolder{UserID: uid, Name: biz.DefaultCollectFolderName, IsDefault: true}
+	if err := f.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(folder); err != nil {
+		return nil, err
+	}
+	folder, err = f.WithContext(ctx).Where(f.UserID.Eq(uid), f.IsDefault.Is(true)).First()
+	if err != nil {
+		return nil, err
+	}
+	return toBizFolder(folder), nil
+}
+
+// CountFolders 用户收藏夹数量
+func (r *collectRepo) CountFolders(ctx context.Context, uid int64) (int64, error) {
+	return r.data.query.CollectFolder.WithContext(ctx).
+		Where(r.data.query.CollectFolder.UserID.Eq(uid)).
+		Count()
+}
+
+// CreateFolder 创建收藏夹
+func (r *collectRepo) CreateFolder(ctx context.Context, folder *biz.CollectFolder) error {
+	f := r.data.query.CollectFolder
+	exists, err := f.WithContext(ctx).Where(f.UserID.Eq(folder.UserID), f.Name.Eq(folder.Name)).Count()
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return errors.BadRequest("FOLDER_EXISTS", "收藏夹名称已存在")
+	}
+
+	m := &model.CollectFolder{UserID: folder.UserID, Name: folder.Name, IsPublic: folder.IsPublic}
+	if err := f.WithContext(ctx).Create(m); err != nil {
+		r.log.WithContext(ctx).Errorf("create collect folder error: %v", err)
+		return err
+	}
+	*folder = *toBizFolder(m)
+	return nil
+}
+
+// UpdateFolder 修改收藏夹名称、公开状态
+func (r *collectRepo) UpdateFolder(ctx context.Context, folder *biz.CollectFolder) error {
+	f := r.data.query.CollectFolder
+	exists, err := f.WithContext(ctx).Where(f.UserID.Eq(folder.UserID), f.Name.Eq(folder.Name), f.ID.Neq(folder.ID)).Count()
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return errors.BadRequest("FOLDER_EXISTS", "收藏夹名称已存在")
+	}
+
+	_, err = f.WithContext(ctx).
+		Where(f.ID.Eq(folder.ID), f.UserID.Eq(folder.UserID)).
+		UpdateSimple(f.Name.Value(folder.Name), f.IsPublic.Value(folder.IsPublic))
+	return err
+}
+
+// DeleteFolder 删除收藏夹，不在其他收藏夹中的视频写入取消收藏事件
+func (r *collectRepo) DeleteFolder(ctx context.Context, uid int64, folderID int64) error {
+	var uncollected []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txQuery := query.Use(tx)
+		ci := txQuery.CollectItem
+
+		if err := lockUserCollects(ctx, txQuery, uid); err != nil {
+			return err
+		}
+		items, err := ci.WithContext(ctx).Where(ci.FolderID.Eq(folderID)).Find()
+		if err != nil {
+			return err
+		}
+		if _, err := ci.WithContext(ctx).Where(ci.FolderID.Eq(folderID)).Delete(); err != nil {
+			return err
+		}
+		if _, err := txQuery.CollectFolder.WithContext(ctx).
+			Where(txQuery.CollectFolder.ID.Eq(folderID), txQuery.CollectFolder.UserID.Eq(uid)).
+			Delete(); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			left, err := r.uncollectIfLast(ctx, tx, uid, item.VideoID)
+			if err != nil {
+				return err
+			}
+			if left == 0 {
+				uncollected = append(uncollected, item.VideoID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 同步更新redis
+	if err := r.data.rdb.Del(ctx, fmt.Sprintf(keyCollectFolder, folderID)).Err(); err != nil {
+		r.log.WithContext(ctx).Errorf("Redis Del error for folder=%d: %v", folderID, err)
+	}
+	if len(uncollected) > 0 {
+		members := make([]interface{}, 0, len(uncollected))
+		for _, vid := range uncollected {
+			members = append(members, vid)
+		}
+		if err := r.data.rdb.SRem(ctx, fmt.Sprintf(keyCollectUser, uid), members...).Err(); err != nil {
+			r.log.WithContext(ctx).Errorf("Redis SRem error for uid=%d: %v", uid, err)
+		}
+	}
+	return nil
+}
+
+// ListFolders 获取用户收藏夹，默认收藏夹在最前
+func (r *collectRepo) ListFolders(ctx context.Context, uid int64, onlyPublic bool) ([]*biz.CollectFolder, error) {
+	f := r.data.query.CollectFolder
+	db := f.WithContext(ctx).Where(f.UserID.Eq(uid))
+	if onlyPublic {
+		db = db.Where(f.IsPublic.Is(true))
+	}
+	folders, err := db.Order(f.IsDefault.Desc(), f.CreatedAt.Desc(), f.ID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*biz.CollectFolder, 0, len(folders))
+	for _, folder := range folders {
+		res = append(res, toBizFolder(folder))
+	}
+	return res, nil
+}
+
+// AddCollect 收藏视频到收藏夹
+func (r *collectRepo) AddCollect(ctx context.Context, uid int64, folderID int64, vid int64) error {
+	// 1. 幂等，防止重复收藏
+	keyFolder := fmt.Sprintf(keyCollectFolder, folderID)
+	isMember, err := r.data.rdb.SIsMember(ctx, keyFolder, vid).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("Redis SIsMember error for folder=%d, vid=%d: %v", folderID, vid, err)
+		// 出错时仍继续走 DB 检查，保证正确性
+	}
+	if isMember {
+		return nil
+	}
+
+	// 数据库事务
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txQuery := query.Use(tx)
+		ci := txQuery.CollectItem
+
+		if err := lockUserCollects(ctx, txQuery, uid); err != nil {
+			return err
+		}
+		// 用户是否已在其他收藏夹中收藏过，同一用户只计一次收藏数
+		collected, err := ci.WithContext(ctx).Where(ci.UserID.Eq(uid), ci.VideoID.Eq(vid)).Count()
+		if err != nil {
+			return err
+		}
+
+		// 已在该收藏夹中时不插入，幂等
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CollectItem{FolderID: folderID, UserID: uid, VideoID: vid})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		if _, err := txQuery.CollectFolder.WithContext(ctx).
+			Where(txQuery.CollectFolder.ID.Eq(folderID)).
+			UpdateSimple(txQuery.CollectFolder.VideoCnt.Add(1)); err != nil {
+			return err
+		}
+		// 收藏数由 video-service 消费事件更新
+		if collected == 0 {
+			return r.addVideoCollectEvent(tx, biz.EventVideoCollected, uid, vid)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 写入redis
+	if err := r.data.rdb.SAdd(ctx, keyFolder, vid).Err(); err != nil {
+		r.log.WithContext(ctx).Errorf("Redis SAdd error for folder=%d, vid=%d: %v", folderID, vid, err)
+		return err
+	}
+	if err := r.data.rdb.SAdd(ctx, fmt.Sprintf(keyCollectUser, uid), vid).Err(); err != nil {
+		r.log.WithContext(ctx).Errorf("Redis SAdd error for uid=%d, vid=%d: %v", uid, vid, err)
+		return err
+	}
+	return nil
+}
+
+// RemoveCollect 取消收藏，folderID 为 0 时从用户全部收藏夹移除
+func (r *collectRepo) RemoveCollect(ctx context.Context, uid int64, folderID int64, vid int64) error {
+	var folderIDs []int64
+	var left int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txQuery := query.Use(tx)
+		ci := txQuery.CollectItem
+
+		if err := lockUserCollects(ctx, txQuery, uid); err != nil {
+			return err
+		}
+		db := ci.WithContext(ctx).Where(ci.UserID.Eq(uid), ci.VideoID.Eq(vid))
+		if folderID != 0 {
+			db = db.Where(ci.FolderID.Eq(folderID))
+		}
+		items, err := db.Find()
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil // 幂等
+		}
+
+		ids := make([]int64, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+			folderIDs = append(folderIDs, item.FolderID)
+		}
+		if _, err := ci.WithContext(ctx).Where(ci.ID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		if _, err := txQuery.CollectFolder.WithContext(ctx).
+			Where(txQuery.CollectFolder.ID.In(folderIDs...), txQuery.CollectFolder.VideoCnt.Gt(0)).
+			UpdateSimple(txQuery.CollectFolder.VideoCnt.Sub(1)); err != nil {
+			return err
+		}
+		left, err = r.uncollectIfLast(ctx, tx, uid, vid)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// 同步更新redis
+	for _, id := range folderIDs {
+		if err := r.data.rdb.SRem(ctx, fmt.Sprintf(keyCollectFolder, id), vid).Err(); err != nil {
+			r.log.WithContext(ctx).Errorf("Redis SRem error for folder=%d, vid=%d: %v", id, vid, err)
+			return err
+		}
+	}
+	if len(folderIDs) > 0 && left == 0 {
+		if err := r.data.rdb.SRem(ctx, fmt.Sprintf(keyCollectUser, uid), vid).Err(); err != nil {
+			r.log.WithContext(ctx).Errorf("Redis SRem error for uid=%d, vid=%d: %v", uid, vid, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// addVideoCollectEvent 在收藏事务中写入事件，以视频 id 作为聚合键
+func (r *collectRepo) addVideoCollectEvent(tx *gorm.DB, eventType string, uid, vid int64) error {
+	return outbox.Add(tx, outbox.Event{
+		Topic: r.data.videoEventTopic,
+		Key:   strconv.FormatInt(vid, 10),
+		Type:  eventType,
+		Data:  biz.VideoCollectEvent{VideoID: vid, UserID: uid},
+	})
+}
+
+// CheckVideoExists 视频是否存在
+func (r *collectRepo) CheckVideoExists(ctx context.Context, vid int64) (bool, error) {
+	resp, err := r.data.VideoClient.CheckVideoExists(ctx, &pbVideo.CheckVideoExistsRequest{VideoId: vid})
+	if err != nil {
+		return false, err
+	}
+	return resp.Exist, nil
+}
+
+// ListFolderVideoIDs 收藏夹中的视频id，按收藏时间倒序分页
+func (r *collectRepo) ListFolderVideoIDs(ctx context.Context, folderID int64, page, pageSize int) ([]int64, error) {
+	ci := r.data.query.CollectItem
+	items, err := ci.WithContext(ctx).
+		Where(ci.FolderID.Eq(folderID)).
+		Order(ci.CreatedAt.Desc(), ci.ID.Desc()).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.VideoID)
+	}
+	return ids, nil
+}
+
+// BatchIsCollected 用户收藏过的视频，同一视频在多个收藏夹中只返回一次
+func (r *collectRepo) BatchIsCollected(ctx context.Context, uid int64, vids []int64) ([]int64, error) {
+	ci := r.data.query.CollectItem
+	var ids []int64
+	err := ci.WithContext(ctx).
+		Distinct(ci.VideoID).
+		Where(ci.UserID.Eq(uid), ci.VideoID.In(vids...)).
+		Pluck(ci.VideoID, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// lockUserCollects 锁定用户的收藏夹行，串行化同一用户的收藏变更，避免并发收藏到不同收藏夹时重复计数。
+// 不锁收藏条目：首次收藏时条目为空，FOR UPDATE 只加间隙锁，并发插入会死锁
+func lockUserCollects(ctx context.Context, txQuery *query.Query, uid int64) error {
+	f := txQuery.CollectFolder
+	_, err := f.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select(f.ID).Where(f.UserID.Eq(uid)).Find()
+	return err
+}
+
+// uncollectIfLast 用户已不在任何收藏夹中收藏该视频时写入取消收藏事件；返回剩余收藏条数
+func (r *collectRepo) uncollectIfLast(ctx context.Context, tx *gorm.DB, uid int64, vid int64) (int64, error) {
+	ci := query.Use(tx).CollectItem
+	left, err := ci.WithContext(ctx).Where(ci.UserID.Eq(uid), ci.VideoID.Eq(vid)).Count()
+	if err != nil {
+		return 0, err
+	}
+	if left > 0 {
+		return left, nil
+	}
+	return 0, r.addVideoCollectEvent(tx, biz.EventVideoUncollected, uid, vid)
+}
+
+func toBizFolder(m *model.CollectFolder) *biz.CollectFolder {
+	return &biz.CollectFolder{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		IsPublic:  m.IsPublic,
+		IsDefault: m.IsDefault,
+		VideoCnt:  m.VideoCnt,
+		CreatedAt: m.CreatedAt,
+	}
+}
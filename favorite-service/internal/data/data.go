@@ -1,8 +1,11 @@
 package data
 
 import (
+	"common/counter"
+	"common/outbox"
 	"context"
 	"errors"
+	pbRelation "favorite-service/api/relation/v1"
 	pbUser "favorite-service/api/user/v1"
 	pbVideo "favorite-service/api/video/v1"
 	"favorite-service/internal/conf"
@@ -23,7 +26,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewFavoriteRepo, NewDB, NewRedisClient, NewDiscover, NewUserServiceClient, NewVideoServiceClient)
+var ProviderSet = wire.NewSet(NewData, NewFavoriteRepo, NewCollectRepo, NewLikerRepo, NewDB, NewRedisClient, NewDiscover, NewUserServiceClient, NewVideoServiceClient, NewRelationServiceClient, NewOutboxRelay, NewCounterChecker)
 
 // Data .
 type Data struct {
@@ -32,19 +35,67 @@ type Data struct {
 	db    *gorm.DB
 	rdb   *redis.Client
 	query *query.Query
+	likes *counter.Counter // 视频点赞数缓存
 
-	UserClient  pbUser.UserServiceClient
-	VideoClient pbVideo.VideoServiceClient
+	videoEventTopic string
+
+	UserClient     pbUser.UserServiceClient
+	VideoClient    pbVideo.VideoServiceClient
+	RelationClient pbRelation.RelationServiceClient
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, cu pbUser.UserServiceClient, cv pbVideo.VideoServiceClient) (*Data, func(), error) {
+func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, cu pbUser.UserServiceClient, cv pbVideo.VideoServiceClient, cr pbRelation.RelationServiceClient) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 	query.SetDefault(db)
 
-	return &Data{log: log.NewHelper(logger), db: db, rdb: rdb, UserClient: cu, query: query.Q, VideoClient: cv}, cleanup, nil
+	likes := counter.New(rdb, counter.VideoLike, likeLoader(db), c.GetCounter().GetTtl().AsDuration())
+	return &Data{log: log.NewHelper(logger), db: db, rdb: rdb, likes: likes, UserClient: cu, query: query.Q, VideoClient: cv, RelationClient: cr, videoEventTopic: c.GetKafka().GetVideoEventTopic()}, cleanup, nil
+}
+
+// likeLoader 按点赞表统计点赞数
+func likeLoader(db *gorm.DB) counter.Loader {
+	return func(ctx context.Context, ids []int64) (map[int64]int64, error) {
+		var rows []struct {
+			VideoID int64
+			Cnt     int64
+		}
+		err := db.WithContext(ctx).
+			Table("favorite").
+			Select("video_id, COUNT(*) AS cnt").
+			Where("video_id IN ?", ids).
+			Group("video_id").
+			Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[int64]int64, len(rows))
+		for _, r := range rows {
+			res[r.VideoID] = r.Cnt
+		}
+		return res, nil
+	}
+}
+
+// NewCounterChecker 定期检查点赞数缓存与点赞表是否一致
+func NewCounterChecker(c *conf.Data, d *Data, logger log.Logger) *counter.Checker {
+	return counter.NewChecker(counter.CheckerConfig{
+		Interval:  c.GetCounter().GetCheckInterval().AsDuration(),
+		BatchSize: int(c.GetCounter().GetCheckBatchSize()),
+		Fix:       c.GetCounter().GetCheckFix(),
+	}, logger, d.likes)
+}
+
+// NewOutboxRelay 发布 outbox 中的事件
+func NewOutboxRelay(c *conf.Data, db *gorm.DB, logger log.Logger) *outbox.Relay {
+	return outbox.NewRelay(db, outbox.Config{
+		Brokers:   c.GetKafka().GetBrokers(),
+		Interval:  c.GetOutbox().GetInterval().AsDuration(),
+		BatchSize: int(c.GetOutbox().GetBatchSize()),
+		Retention: c.GetOutbox().GetRetention().AsDuration(),
+	}, logger)
 }
 
 // NewDB 数据库连接
@@ -101,3 +152,15 @@ func NewVideoServiceClient(c *conf.Data, rr registry.Discovery) pbVideo.VideoSer
 	}
 	return pbVideo.NewVideoServiceClient(conn)
 }
+
+func NewRelationServiceClient(c *conf.Data, rr registry.Discovery) pbRelation.RelationServiceClient {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(c.GetRelationService().GetEndpoint()),
+		grpc.WithDiscovery(rr),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return pbRelation.NewRelationServiceClient(conn)
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"common/outbox"
 	"context"
 	"errors"
 	pbUSer "favorite-service/api/user/v1"
@@ -8,8 +9,9 @@ import (
 	"favorite-service/internal/data/model"
 	"favorite-service/internal/data/query"
 	"fmt"
-	"github.com/redis/go-redis/v9"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
+	"strconv"
 	"time"
 
 	"favorite-service/internal/biz"
@@ -57,6 +59,7 @@ func (r *favoriteRepo) AddFavorite(ctx context.Context, uid int64, vid int64) er
 	}
 
 	// 数据库事务
+	var like *biz.Like
 	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txQuery := query.Use(tx)
 
@@ -68,53 +71,44 @@ func (r *favoriteRepo) AddFavorite(ctx context.Context, uid int64, vid int64) er
 		if isFav {
 			return nil
 		}
+		// created_at 精确到秒，与最近点赞窗口中的时间保持一致
 		favorite := &model.Favorite{
-			UserID:  uid,
-			VideoID: vid,
+			UserID:    uid,
+			VideoID:   vid,
+			CreatedAt: time.Now().Truncate(time.Second),
 		}
 
 		// 点赞跟新favorite
 		if err := txQuery.Favorite.WithContext(ctx).Create(favorite); err != nil {
 			return err
 		}
+		like = &biz.Like{ID: favorite.ID, UserID: uid, VideoID: vid, CreatedAt: favorite.CreatedAt}
 
-		// 更新video
-		_, err = txQuery.Video.WithContext(ctx).
-			Where(txQuery.Video.ID.Eq(vid)).
-			UpdateSimple(txQuery.Video.FavoriteCnt.Add(1))
-		if err != nil {
-			return err
-		}
-		return nil
+		// 点赞数由 video-service 消费事件更新
+		return r.addVideoLikeEvent(tx, biz.EventVideoLiked, uid, vid)
 	})
 	if err != nil {
 		return err
 	}
 
-	// 写入redis
-	// 将 vid 加入用户点赞集合
+	// 写入redis，点赞与事件已经提交，缓存写入失败时只记录日志不返回错误
+	// 将 vid 加入用户点赞集合，集合只用于命中，写入失败时回源数据库
 	if err := r.data.rdb.SAdd(ctx, keyUserFavorite, vid).Err(); err != nil {
 		r.log.WithContext(ctx).Errorf("Redis SAdd error for uid=%d, vid=%d: %v", uid, vid, err)
-		return err
-	}
-
-	// 视频点赞数自增
-	keyVideoFavorite := fmt.Sprintf("video:favorite:%d", vid)
-
-	// 检查是否存在，若不存在则从 DB 回填
-	err = r.checkVidoeFavoriteInCache(ctx, keyVideoFavorite, vid)
-	if err != nil {
-		r.log.WithContext(ctx).Errorf("checkVideoFavoriteInCache error for vid=%d: %v", vid, err)
-		return err
-	}
-	if err := r.data.rdb.Incr(ctx, keyVideoFavorite).Err(); err != nil {
-		r.log.WithContext(ctx).Errorf("Redis Incr error for vid=%d: %v", vid, err)
-		return err
 	}
+	// 本次没有写入点赞（已点赞过）时不更新计数
+	if like != nil {
+		// 点赞已经提交，窗口写入失败时不返回错误
+		if err := r.addRecentFavorite(ctx, uid, like); err != nil {
+			r.log.WithContext(ctx).Errorf("add recent favorite error for uid=%d, vid=%d: %v", uid, vid, err)
+			r.dropRecentFavorites(ctx, uid)
+		}
 
-	err = r.UpdateVideoScoreAfterLike(ctx, vid)
-	if err != nil {
-		return err
+		// 视频点赞数自增
+		if err := r.data.likes.Incr(ctx, vid, 1); err != nil {
+			r.log.WithContext(ctx).Errorf("incr like counter error for vid=%d: %v", vid, err)
+			r.dropLikeCounter(ctx, vid)
+		}
 	}
 
 	return nil
@@ -124,89 +118,80 @@ func (r *favoriteRepo) AddFavorite(ctx context.Context, uid int64, vid int64) er
 func (r *favoriteRepo) RemoveFavorite(ctx context.Context, uid int64, vid int64) error {
 
 	// 数据库事务操作
+	var like *biz.Like
 	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txQuery := query.Use(tx)
 
-		// 取消点赞 favorite
-		result, err := txQuery.Favorite.
+		favorite, err := txQuery.Favorite.
 			WithContext(ctx).
 			Where(txQuery.Favorite.UserID.Eq(uid), txQuery.Favorite.VideoID.Eq(vid)).
-			Delete()
+			First()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil // 幂等
+		}
 		if err != nil {
 			return err
 		}
-		if result.RowsAffected == 0 {
-			return nil // 幂等
-		}
 
-		// video
-		result, err = txQuery.Video.
+		// 取消点赞 favorite
+		result, err := txQuery.Favorite.
 			WithContext(ctx).
-			Where(txQuery.Video.ID.Eq(vid)).
-			UpdateSimple(txQuery.Video.FavoriteCnt.Sub(1))
+			Where(txQuery.Favorite.ID.Eq(favorite.ID)).
+			Delete()
 		if err != nil {
 			return err
 		}
 		if result.RowsAffected == 0 {
-			return errors.New("video not found or favorite count is 0")
+			return nil // 幂等
 		}
-		return nil
+		like = &biz.Like{ID: favorite.ID, UserID: uid, VideoID: vid, CreatedAt: favorite.CreatedAt}
+
+		return r.addVideoLikeEvent(tx, biz.EventVideoUnliked, uid, vid)
 	})
 	if err != nil {
 		return err
 	}
-
-	// 同步更新redis
+	// 同步更新redis，取消点赞已经提交，缓存写入失败时只记录日志不返回错误
+	// 集合中残留的 vid 会被当作已点赞，移除失败时删除整个集合，之后回源数据库
 	keyUserFavorite := fmt.Sprintf("favorite:user:%d", uid)
 	if err := r.data.rdb.SRem(ctx, keyUserFavorite, vid).Err(); err != nil {
 		r.log.WithContext(ctx).Errorf("Redis SRem error for uid=%d, vid=%d: %v", uid, vid, err)
-		return err
+		if err := r.data.rdb.Del(ctx, keyUserFavorite).Err(); err != nil {
+			r.log.WithContext(ctx).Errorf("Redis Del error for uid=%d: %v", uid, err)
+		}
 	}
 
-	keyVideoFavorite := fmt.Sprintf("video:favorite:%d", vid)
-	err = r.checkVidoeFavoriteInCache(ctx, keyVideoFavorite, vid)
-	if err != nil {
-		r.log.WithContext(ctx).Errorf("Redis SIsMember error: %v", err)
-		return err
-	}
-	if err := r.data.rdb.Decr(ctx, keyVideoFavorite).Err(); err != nil {
-		r.log.WithContext(ctx).Errorf("Redis Decr error: %v", err)
-		return err
-	}
+	// 本次没有删除点赞（未点赞过）时不更新计数
+	if like != nil {
+		if err := r.removeRecentFavorite(ctx, uid, like); err != nil {
+			r.log.WithContext(ctx).Errorf("remove recent favorite error for uid=%d, vid=%d: %v", uid, vid, err)
+			r.dropRecentFavorites(ctx, uid)
+		}
 
-	err = r.UpdateVideoScoreAfterLike(ctx, vid)
-	if err != nil {
-		return err
+		if err := r.data.likes.Incr(ctx, vid, -1); err != nil {
+			r.log.WithContext(ctx).Errorf("decr like counter error for vid=%d: %v", vid, err)
+			r.dropLikeCounter(ctx, vid)
+		}
 	}
 
 	return nil
 }
 
-// UpdateVideoScoreAfterLike 更新分数
-func (r *favoriteRepo) UpdateVideoScoreAfterLike(ctx context.Context, videoID int64) error {
-	video, err := r.data.VideoClient.GetVideoFavoriteAndCommentCount(ctx, &pbVideo.GetVideoFavoriteAndCommentCountRequest{VideoId: videoID}) // 获取点赞数、评论数、上传时间
-	if err != nil {
-		r.log.Errorf("GetVideo error: %v", err)
-		return err
+// dropLikeCounter 点赞数更新失败时删除缓存，下次读取时回源；删除也失败时由漂移检查修正
+func (r *favoriteRepo) dropLikeCounter(ctx context.Context, vid int64) {
+	if err := r.data.likes.Delete(ctx, vid); err != nil {
+		r.log.WithContext(ctx).Errorf("drop like counter error for vid=%d: %v", vid, err)
 	}
-	score, err := r.data.VideoClient.CalcVideoScore(ctx, &pbVideo.CalcVideoScoreRequest{
-		FavoriteCount: video.FavoriteCount,
-		CommentCount:  video.CommentCount,
-		UploadTime:    video.UploadTime,
+}
+
+// addVideoLikeEvent 在点赞事务中写入事件，以视频 id 作为聚合键
+func (r *favoriteRepo) addVideoLikeEvent(tx *gorm.DB, eventType string, uid, vid int64) error {
+	return outbox.Add(tx, outbox.Event{
+		Topic: r.data.videoEventTopic,
+		Key:   strconv.FormatInt(vid, 10),
+		Type:  eventType,
+		Data:  biz.VideoLikeEvent{VideoID: vid, UserID: uid},
 	})
-	if err != nil {
-		r.log.Errorf("CalcVideoScore error: %v", err)
-		return err
-	}
-	err = r.data.rdb.ZAdd(ctx, "video:score", redis.Z{
-		Score:  float64(score.Score),
-		Member: videoID,
-	}).Err()
-	if err != nil {
-		r.log.Errorf("ZAdd video:score error: %v", err)
-		return err
-	}
-	return nil
 }
 
 // checkUserHaveFavorite 是否已经点赞
@@ -214,28 +199,6 @@ func (r *favoriteRepo) checkUserHaveFavorite(ctx context.Context, keyUserFavorit
 	return r.data.rdb.SIsMember(ctx, keyUserFavorite, vid).Result()
 }
 
-// 是否有点赞在缓存中
-func (r *favoriteRepo) checkVidoeFavoriteInCache(ctx context.Context, keyVideoFavorite string, vid int64) error {
-	val, err := r.data.rdb.Exists(ctx, keyVideoFavorite).Result()
-	if err != nil {
-		r.log.WithContext(ctx).Errorf("Redis Exists error: %v", err)
-		return err
-	}
-	if val == 0 {
-		// Redis 不存在，回源 DB
-		var cnt int64
-		err = r.data.query.WithContext(ctx).Video.Where(query.Video.ID.Eq(vid)).Select(query.Video.FavoriteCnt).Scan(&cnt)
-		if err != nil {
-			return err
-		}
-		err = r.data.rdb.Set(ctx, keyVideoFavorite, cnt, 24*time.Hour).Err()
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 // IsFavorited 幂等，防止重复点赞
 func (r *favoriteRepo) IsFavorited(ctx context.Context, uid int64, vid int64) (bool, error) {
 	count, err := r.data.query.Favorite.
@@ -251,23 +214,69 @@ func (r *favoriteRepo) IsFavorited(ctx context.Context, uid int64, vid int64) (b
 	return count > 0, nil
 }
 
-// GetUserFavoriteVideoIDs 根据用户id获取用户点赞视频id列表
-func (r *favoriteRepo) GetUserFavoriteVideoIDs(ctx context.Context, uid int64) ([]int64, error) {
-	var ids []int64
-
-	favorites, err := r.data.query.Favorite.
-		WithContext(ctx).
-		Where(r.data.query.Favorite.UserID.Eq(uid)).
-		Find()
+// BatchIsFavorited 先查用户点赞集合，集合只包含缓存后的点赞，未命中的再查数据库
+func (r *favoriteRepo) BatchIsFavorited(ctx context.Context, uid int64, vids []int64) ([]int64, error) {
+	members := make([]interface{}, 0, len(vids))
+	for _, vid := range vids {
+		members = append(members, vid)
+	}
+	hits, err := r.data.rdb.SMIsMember(ctx, fmt.Sprintf("favorite:user:%d", uid), members...).Result()
 	if err != nil {
+		// 缓存出错时全部查数据库
+		r.log.WithContext(ctx).Errorf("Redis SMIsMember error for uid=%d: %v", uid, err)
+		hits = make([]bool, len(vids))
+	}
+
+	res := make([]int64, 0, len(vids))
+	var misses []int64
+	for i, vid := range vids {
+		if hits[i] {
+			res = append(res, vid)
+		} else {
+			misses = append(misses, vid)
+		}
+	}
+	if len(misses) == 0 {
+		return res, nil
+	}
+
+	f := r.data.query.Favorite
+	var ids []int64
+	if err := f.WithContext(ctx).Where(f.UserID.Eq(uid), f.VideoID.In(misses...)).Pluck(f.VideoID, &ids); err != nil {
 		return nil, err
 	}
+	return append(res, ids...), nil
+}
 
-	for _, v := range favorites {
-		ids = append(ids, v.VideoID)
+// ListUserFavorites 按 (created_at, id) 倒序读取用户游标之后的点赞，最近的点赞读 redis 窗口，窗口覆盖不到时查数据库
+func (r *favoriteRepo) ListUserFavorites(ctx context.Context, uid int64, cursor *biz.FavoriteCursor, limit int) ([]*biz.Like, error) {
+	likes, ok, err := r.listRecentFavorites(ctx, uid, cursor, limit)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("list recent favorites error for uid=%d: %v", uid, err)
 	}
+	if err == nil && ok {
+		return likes, nil
+	}
+	return r.listUserFavoritesFromDB(ctx, uid, cursor, limit)
+}
 
-	return ids, nil
+// listUserFavoritesFromDB 走 (user_id, created_at, id) 索引
+func (r *favoriteRepo) listUserFavoritesFromDB(ctx context.Context, uid int64, cursor *biz.FavoriteCursor, limit int) ([]*biz.Like, error) {
+	f := r.data.query.Favorite
+	do := f.WithContext(ctx).Where(f.UserID.Eq(uid))
+	if cursor != nil {
+		t := cursor.Time()
+		do = do.Where(field.Or(f.CreatedAt.Lt(t), field.And(f.CreatedAt.Eq(t), f.ID.Lt(cursor.ID))))
+	}
+	rows, err := do.Order(f.CreatedAt.Desc(), f.ID.Desc()).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*biz.Like, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &biz.Like{ID: row.ID, UserID: row.UserID, VideoID: row.VideoID, CreatedAt: row.CreatedAt})
+	}
+	return res, nil
 }
 
 // CheckUserExists 用户是否存在
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	pbRelation "favorite-service/api/relation/v1"
+	pbUser "favorite-service/api/user/v1"
+	pbVideo "favorite-service/api/video/v1"
+	"favorite-service/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gen/field"
+)
+
+type likerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLikerRepo .
+func NewLikerRepo(data *Data, logger log.Logger) biz.LikerRepo {
+	return &likerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListVideoLikes 按 (created_at, id) 倒序读取游标之后的点赞记录，走 (video_id, created_at, id) 索引
+func (r *likerRepo) ListVideoLikes(ctx context.Context, vid int64, cursor *biz.FavoriteCursor, limit int) ([]*biz.Like, error) {
+	f := r.data.query.Favorite
+	do := f.WithContext(ctx).Where(f.VideoID.Eq(vid))
+	if cursor != nil {
+		t := cursor.Time()
+		do = do.Where(field.Or(f.CreatedAt.Lt(t), field.And(f.CreatedAt.Eq(t), f.ID.Lt(cursor.ID))))
+	}
+	rows, err := do.Order(f.CreatedAt.Desc(), f.ID.Desc()).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*biz.Like, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &biz.Like{ID: row.ID, UserID: row.UserID, VideoID: row.VideoID, CreatedAt: row.CreatedAt})
+	}
+	return res, nil
+}
+
+// BatchGetUserInfo 批量获取用户资料
+func (r *likerRepo) BatchGetUserInfo(ctx context.Context, uids []int64) ([]*pbUser.Author, error) {
+	resp, err := r.data.UserClient.BatchGetUserInfo(ctx, &pbUser.BatchGetUserInfoRequest{AuthorIds: uids})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// BatchIsFollowing 当前用户关注了哪些用户
+func (r *likerRepo) BatchIsFollowing(ctx context.Context, uid int64, toUserIDs []int64) (map[int64]bool, error) {
+	resp, err := r.data.RelationClient.BatchIsFollowing(ctx, &pbRelation.BatchIsFollowingRequest{
+		UserId:    uid,
+		ToUserIds: toUserIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	following := make(map[int64]bool, len(resp.FollowingUserIds))
+	for _, id := range resp.FollowingUserIds {
+		following[id] = true
+	}
+	return following, nil
+}
+
+// CheckVideoVisible 由 video-service 按视频状态和作者判断
+func (r *likerRepo) CheckVideoVisible(ctx context.Context, vid, viewer int64) (bool, error) {
+	resp, err := r.data.VideoClient.CheckVideoExists(ctx, &pbVideo.CheckVideoExistsRequest{VideoId: vid, ViewerId: viewer})
+	if err != nil {
+		return false, err
+	}
+	return resp.Visible, nil
+}
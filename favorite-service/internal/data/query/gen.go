@@ -16,14 +16,18 @@ import (
 )
 
 var (
-	Q        = new(Query)
-	Favorite *favorite
-	User     *user
-	Video    *video
+	Q             = new(Query)
+	CollectFolder *collectFolder
+	CollectItem   *collectItem
+	Favorite      *favorite
+	User          *user
+	Video         *video
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	CollectFolder = &Q.CollectFolder
+	CollectItem = &Q.CollectItem
 	Favorite = &Q.Favorite
 	User = &Q.User
 	Video = &Q.Video
@@ -31,29 +35,35 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:       db,
-		Favorite: newFavorite(db, opts...),
-		User:     newUser(db, opts...),
-		Video:    newVideo(db, opts...),
+		db:            db,
+		CollectFolder: newCollectFolder(db, opts...),
+		CollectItem:   newCollectItem(db, opts...),
+		Favorite:      newFavorite(db, opts...),
+		User:          newUser(db, opts...),
+		Video:         newVideo(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Favorite favorite
-	User     user
-	Video    video
+	CollectFolder collectFolder
+	CollectItem   collectItem
+	Favorite      favorite
+	User          user
+	Video         video
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:       db,
-		Favorite: q.Favorite.clone(db),
-		User:     q.User.clone(db),
-		Video:    q.Video.clone(db),
+		db:            db,
+		CollectFolder: q.CollectFolder.clone(db),
+		CollectItem:   q.CollectItem.clone(db),
+		Favorite:      q.Favorite.clone(db),
+		User:          q.User.clone(db),
+		Video:         q.Video.clone(db),
 	}
 }
 
@@ -67,24 +77,30 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:       db,
-		Favorite: q.Favorite.replaceDB(db),
-		User:     q.User.replaceDB(db),
-		Video:    q.Video.replaceDB(db),
+		db:            db,
+		CollectFolder: q.CollectFolder.replaceDB(db),
+		CollectItem:   q.CollectItem.replaceDB(db),
+		Favorite:      q.Favorite.replaceDB(db),
+		User:          q.User.replaceDB(db),
+		Video:         q.Video.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Favorite IFavoriteDo
-	User     IUserDo
-	Video    IVideoDo
+	CollectFolder ICollectFolderDo
+	CollectItem   ICollectItemDo
+	Favorite      IFavoriteDo
+	User          IUserDo
+	Video         IVideoDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Favorite: q.Favorite.WithContext(ctx),
-		User:     q.User.WithContext(ctx),
-		Video:    q.Video.WithContext(ctx),
+		CollectFolder: q.CollectFolder.WithContext(ctx),
+		CollectItem:   q.CollectItem.WithContext(ctx),
+		Favorite:      q.Favorite.WithContext(ctx),
+		User:          q.User.WithContext(ctx),
+		Video:         q.Video.WithContext(ctx),
 	}
 }
 
@@ -0,0 +1,182 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"favorite-service/internal/biz"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 用户最近点赞窗口：zset 保存用户最近的 recentFavoriteWindow 条点赞，score 为点赞时间（毫秒），
+// member 为 "补零的点赞记录id:视频id"，同一毫秒内按 member 字典序即按记录 id 排序，与数据库 (created_at, id) 顺序一致。
+// 窗口从数据库重建时若已读到全部点赞，写入 score 为 0 的结束标记；窗口溢出时标记最先被裁掉，读到标记说明后面没有更早的点赞。
+// 窗口覆盖不到的页回源数据库。每次点赞、取消点赞都递增窗口版本号，重建时只在读数据库前后版本号不变时写入，避免覆盖重建期间的点赞变更
+
+const (
+	// 用户最近点赞窗口，%d 为用户id
+	keyFavoriteUserRecent = "favorite:user:recent:%d"
+	// 用户最近点赞窗口的版本号，%d 为用户id
+	keyFavoriteUserRecentVer = "favorite:user:recent:ver:%d"
+	recentFavoriteWindow     = 1000
+	recentFavoriteTTL        = 24 * time.Hour
+	recentFavoriteEnd        = "end"
+)
+
+// 递增版本号，只在窗口已存在时写入，未建立的窗口在下次读取时从数据库重建
+var recentAddScript = redis.NewScript(`
+redis.call('INCR', KEYS[2])
+redis.call('EXPIRE', KEYS[2], ARGV[4])
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[3]) - 1)
+return 1
+`)
+
+var recentRemoveScript = redis.NewScript(`
+redis.call('INCR', KEYS[2])
+redis.call('EXPIRE', KEYS[2], ARGV[2])
+return redis.call('ZREM', KEYS[1], ARGV[1])
+`)
+
+// 版本号与读数据库前一致时才替换窗口，ARGV[3] 之后为 score、member
+var recentRebuildScript = redis.NewScript(`
+if (redis.call('GET', KEYS[2]) or '0') ~= ARGV[1] then
+	return 0
+end
+redis.call('DEL', KEYS[1])
+for i = 3, #ARGV, 2 do
+	redis.call('ZADD', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+func recentFavoriteKeys(uid int64) []string {
+	return []string{fmt.Sprintf(keyFavoriteUserRecent, uid), fmt.Sprintf(keyFavoriteUserRecentVer, uid)}
+}
+
+func recentFavoriteMember(id, vid int64) string {
+	return fmt.Sprintf("%020d:%d", id, vid)
+}
+
+// addRecentFavorite 新的点赞写入窗口
+func (r *favoriteRepo) addRecentFavorite(ctx context.Context, uid int64, like *biz.Like) error {
+	return recentAddScript.Run(ctx, r.data.rdb, recentFavoriteKeys(uid),
+		like.CreatedAt.UnixMilli(), recentFavoriteMember(like.ID, like.VideoID), recentFavoriteWindow, int(recentFavoriteTTL.Seconds())).Err()
+}
+
+// removeRecentFavorite 取消的点赞移出窗口
+func (r *favoriteRepo) removeRecentFavorite(ctx context.Context, uid int64, like *biz.Like) error {
+	return recentRemoveScript.Run(ctx, r.data.rdb, recentFavoriteKeys(uid),
+		recentFavoriteMember(like.ID, like.VideoID), int(recentFavoriteTTL.Seconds())).Err()
+}
+
+// dropRecentFavorites 窗口写入失败时删除窗口，下次读取时重建
+func (r *favoriteRepo) dropRecentFavorites(ctx context.Context, uid int64) {
+	if err := r.data.rdb.Del(ctx, fmt.Sprintf(keyFavoriteUserRecent, uid)).Err(); err != nil {
+		r.log.WithContext(ctx).Errorf("drop recent favorites error for uid=%d: %v", uid, err)
+	}
+}
+
+// rebuildRecentFavorites 从数据库读取最近的点赞重建窗口，期间有点赞变更时放弃，本次读取回源数据库
+func (r *favoriteRepo) rebuildRecentFavorites(ctx context.Context, uid int64) error {
+	keys := recentFavoriteKeys(uid)
+	ver, err := r.data.rdb.Get(ctx, keys[1]).Result()
+	if errors.Is(err, redis.Nil) {
+		ver = "0"
+	} else if err != nil {
+		return err
+	}
+
+	likes, err := r.listUserFavoritesFromDB(ctx, uid, nil, recentFavoriteWindow)
+	if err != nil {
+		return err
+	}
+	args := make([]interface{}, 0, 2+2*(len(likes)+1))
+	args = append(args, ver, int(recentFavoriteTTL.Seconds()))
+	for _, l := range likes {
+		args = append(args, l.CreatedAt.UnixMilli(), recentFavoriteMember(l.ID, l.VideoID))
+	}
+	if len(likes) < recentFavoriteWindow {
+		args = append(args, 0, recentFavoriteEnd)
+	}
+	return recentRebuildScript.Run(ctx, r.data.rdb, keys, args...).Err()
+}
+
+// listRecentFavorites 从窗口读取游标之后的 limit 条点赞，窗口覆盖不到时 ok 为 false
+func (r *favoriteRepo) listRecentFavorites(ctx context.Context, uid int64, cursor *biz.FavoriteCursor, limit int) ([]*biz.Like, bool, error) {
+	key := fmt.Sprintf(keyFavoriteUserRecent, uid)
+	n, err := r.data.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if n == 0 {
+		if err := r.rebuildRecentFavorites(ctx, uid); err != nil {
+			return nil, false, err
+		}
+	}
+
+	var zs []redis.Z
+	if cursor == nil {
+		zs, err = r.data.rdb.ZRevRangeWithScores(ctx, key, 0, int64(limit-1)).Result()
+		if err != nil {
+			return nil, false, err
+		}
+	} else {
+		// 与游标同一毫秒的点赞单独取出，只保留记录 id 更小的
+		score := strconv.FormatInt(cursor.CreatedAt, 10)
+		pipe := r.data.rdb.Pipeline()
+		tiesCmd := pipe.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{Min: score, Max: score})
+		olderCmd := pipe.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{Max: "(" + score, Min: "-inf", Count: int64(limit)})
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, false, err
+		}
+		ties := tiesCmd.Val()
+		sort.Slice(ties, func(i, j int) bool { return ties[i].Member.(string) > ties[j].Member.(string) })
+		cur := recentFavoriteMember(cursor.ID, 0)
+		for _, z := range ties {
+			if z.Member.(string) < cur {
+				zs = append(zs, z)
+			}
+		}
+		zs = append(zs, olderCmd.Val()...)
+	}
+
+	res := make([]*biz.Like, 0, limit)
+	for _, z := range zs {
+		if len(res) == limit {
+			return res, true, nil
+		}
+		member := z.Member.(string)
+		if member == recentFavoriteEnd {
+			return res, true, nil
+		}
+		like, err := parseRecentFavorite(uid, member, z.Score)
+		if err != nil {
+			return nil, false, err
+		}
+		res = append(res, like)
+	}
+	return res, len(res) == limit, nil
+}
+
+func parseRecentFavorite(uid int64, member string, score float64) (*biz.Like, error) {
+	parts := strings.Split(member, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid recent favorite member: %s", member)
+	}
+	id, err1 := strconv.ParseInt(parts[0], 10, 64)
+	vid, err2 := strconv.ParseInt(parts[1], 10, 64)
+	if err1 != nil || err2 != nil {
+		return nil, fmt.Errorf("invalid recent favorite member: %s", member)
+	}
+	return &biz.Like{ID: id, UserID: uid, VideoID: vid, CreatedAt: time.UnixMilli(int64(score))}, nil
+}
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS `collect_folder` (
+                                                `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                                `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+                                                `name` VARCHAR(64) NOT NULL COMMENT '收藏夹名称',
+                                                `is_public` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否公开',
+                                                `is_default` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否默认收藏夹',
+                                                `video_cnt` INT NOT NULL DEFAULT 0 COMMENT '收藏视频数',
+                                                `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                                `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                                PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_user_name` (`user_id`, `name`),
+    INDEX `idx_user_created` (`user_id`, `created_at`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='收藏夹表';
+
+CREATE TABLE IF NOT EXISTS `collect_item` (
+                                              `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                              `folder_id` BIGINT UNSIGNED NOT NULL COMMENT '收藏夹ID',
+                                              `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+                                              `video_id` BIGINT UNSIGNED NOT NULL COMMENT '视频ID',
+                                              `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '收藏时间',
+                                              PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_folder_video` (`folder_id`, `video_id`),
+    INDEX `idx_user_video` (`user_id`, `video_id`),
+    INDEX `idx_folder_created` (`folder_id`, `created_at`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='收藏夹视频表';
@@ -0,0 +1,3 @@
+ALTER TABLE `favorite`
+    ADD INDEX `idx_video_created` (`video_id`, `created_at`, `id`),
+    DROP INDEX `idx_video_id`;
@@ -0,0 +1,2 @@
+ALTER TABLE `favorite`
+    ADD INDEX `idx_user_created` (`user_id`, `created_at`, `id`);
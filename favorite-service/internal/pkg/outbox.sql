@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `outbox_events` (
+                                               `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                               `event_id` VARCHAR(64) NOT NULL COMMENT '事件ID，消费方据此去重',
+                                               `topic` VARCHAR(128) NOT NULL COMMENT 'kafka topic',
+                                               `aggregate_key` VARCHAR(128) NOT NULL COMMENT '聚合键，作为消息key保证同一聚合有序',
+                                               `event_type` VARCHAR(64) NOT NULL COMMENT '事件类型',
+                                               `payload` TEXT NOT NULL COMMENT '消息体',
+                                               `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0待发布 1已发布',
+                                               `attempts` INT NOT NULL DEFAULT 0 COMMENT '发布失败次数',
+                                               `last_error` VARCHAR(512) DEFAULT NULL COMMENT '最近一次发布失败原因',
+                                               `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                               `sent_at` TIMESTAMP NULL DEFAULT NULL COMMENT '发布时间',
+                                               PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_event_id` (`event_id`),
+    INDEX `idx_status_id` (`status`, `id`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='事件发件箱';
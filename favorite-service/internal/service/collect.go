@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	v1 "favorite-service/api/favorite/v1"
+	"favorite-service/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// CollectAction 收藏、取消收藏视频
+func (s *FavoriteService) CollectAction(ctx context.Context, in *v1.CollectActionRequest) (*v1.CollectActionReply, error) {
+	// 1. 参数校验
+	if in.VideoId == 0 {
+		return nil, errors.BadRequest("INVALID_PARAM", "video_id is required")
+	}
+	if in.ActionType != 1 && in.ActionType != 2 {
+		return nil, errors.BadRequest("INVALID_PARAM", "invalid action type")
+	}
+	if in.Token == "" {
+		return nil, errors.Unauthorized("INVALID_PARAM", "请先登录！")
+	}
+	userId, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 收藏
+	if err := s.cc.CollectAction(ctx, userId, in.ActionType, in.VideoId, in.FolderId); err != nil {
+		return nil, err
+	}
+	return &v1.CollectActionReply{Message: "success"}, nil
+}
+
+// CreateCollectFolder 创建收藏夹
+func (s *FavoriteService) CreateCollectFolder(ctx context.Context, in *v1.CreateCollectFolderRequest) (*v1.CreateCollectFolderReply, error) {
+	userId, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	folder, err := s.cc.CreateFolder(ctx, userId, in.Name, in.IsPublic)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateCollectFolderReply{Folder: toFolderReply(folder)}, nil
+}
+
+// UpdateCollectFolder 修改收藏夹
+func (s *FavoriteService) UpdateCollectFolder(ctx context.Context, in *v1.UpdateCollectFolderRequest) (*v1.UpdateCollectFolderReply, error) {
+	if in.FolderId == 0 {
+		return nil, errors.BadRequest("INVALID_PARAM", "folder_id is required")
+	}
+	userId, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	folder, err := s.cc.UpdateFolder(ctx, userId, in.FolderId, in.Name, in.IsPublic)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateCollectFolderReply{Folder: toFolderReply(folder)}, nil
+}
+
+// DeleteCollectFolder 删除收藏夹
+func (s *FavoriteService) DeleteCollectFolder(ctx context.Context, in *v1.DeleteCollectFolderRequest) (*v1.DeleteCollectFolderReply, error) {
+	if in.FolderId == 0 {
+		return nil, errors.BadRequest("INVALID_PARAM", "folder_id is required")
+	}
+	userId, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cc.DeleteFolder(ctx, userId, in.FolderId); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCollectFolderReply{Message: "success"}, nil
+}
+
+// ListCollectFolders 获取用户收藏夹列表
+func (s *FavoriteService) ListCollectFolders(ctx context.Context, in *v1.ListCollectFoldersRequest) (*v1.ListCollectFoldersReply, error) {
+	if in.TargetUserId == 0 {
+		return nil, errors.BadRequest("INVALID_PARAM", "target_user_id is required")
+	}
+	userId, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.cc.ListFolders(ctx, userId, in.TargetUserId)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*v1.CollectFolder, 0, len(folders))
+	for _, folder := range folders {
+		res = append(res, toFolderReply(folder))
+	}
+	return &v1.ListCollectFoldersReply{Folders: res}, nil
+}
+
+// ListCollectFolderVideos 获取收藏夹中的视频
+func (s *FavoriteService) ListCollectFolderVideos(ctx context.Context, in *v1.ListCollectFolderVideosRequest) (*v1.ListCollectFolderVideosReply, error) {
+	if in.FolderId == 0 {
+		return nil, errors.BadRequest("INVALID_PARAM", "folder_id is required")
+	}
+	userId, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 分页
+	page := 1
+	pageSize := 10
+	if in.Page > 0 {
+		page = int(in.Page)
+	}
+	if in.Limit > 0 && in.Limit <= 50 {
+		pageSize = int(in.Limit)
+	}
+
+	videos, total, err := s.cc.ListFolderVideos(ctx, userId, in.FolderId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListCollectFolderVideosReply{Videos: videos, Total: total}, nil
+}
+
+func toFolderReply(folder *biz.CollectFolder) *v1.CollectFolder {
+	return &v1.CollectFolder{
+		Id:         folder.ID,
+		UserId:     folder.UserID,
+		Name:       folder.Name,
+		IsPublic:   folder.IsPublic,
+		IsDefault:  folder.IsDefault,
+		VideoCount: folder.VideoCnt,
+		CreatedAt:  folder.CreatedAt.Unix(),
+	}
+}
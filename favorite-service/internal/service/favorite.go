@@ -11,10 +11,11 @@ type FavoriteService struct {
 	v1.UnimplementedFavoriteServiceServer
 
 	uc *biz.FavoriteUsecase
+	cc *biz.CollectUsecase
 }
 
-func NewFavoriteService(uc *biz.FavoriteUsecase) *FavoriteService {
-	return &FavoriteService{uc: uc}
+func NewFavoriteService(uc *biz.FavoriteUsecase, cc *biz.CollectUsecase) *FavoriteService {
+	return &FavoriteService{uc: uc, cc: cc}
 }
 
 // FavoriteAction 视频点赞
@@ -64,19 +65,60 @@ func (s *FavoriteService) GetUserFavoriteVideoList(ctx context.Context, in *v1.G
 	}
 
 	// 1.3 分页
-	page := 1
-	pageSize := 10
-	if in.Page > 0 {
-		page = int(in.Page)
-	}
-	if in.Limit < 10 && in.Limit > 0 {
-		pageSize = int(in.Limit)
+	limit := biz.DefaultFavoritePageSize
+	if in.Limit > 0 {
+		limit = min(int(in.Limit), biz.MaxFavoritePageSize)
 	}
 
 	// 2. 基于被查询用户id获取视频信息列表
-	videoList, err := s.uc.GetUserFavoriteVideoList(ctx, in.TargetUserId, page, pageSize)
+	videoList, nextCursor, hasMore, err := s.uc.GetUserFavoriteVideoList(ctx, in.TargetUserId, in.Cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserFavoriteVideoListReply{Videos: videoList, NextCursor: nextCursor, HasMore: hasMore}, nil
+}
+
+// BatchIsFavorited 批量查询用户是否点赞、收藏了视频
+func (s *FavoriteService) BatchIsFavorited(ctx context.Context, in *v1.BatchIsFavoritedRequest) (*v1.BatchIsFavoritedReply, error) {
+	if len(in.VideoIds) > biz.MaxBatchFavoriteQuery {
+		return nil, errors.BadRequest("INVALID_PARAM", "too many video_ids")
+	}
+
+	favorited, err := s.uc.BatchIsFavorited(ctx, in.UserId, in.VideoIds)
+	if err != nil {
+		return nil, err
+	}
+	collected, err := s.cc.BatchIsCollected(ctx, in.UserId, in.VideoIds)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.BatchIsFavoritedReply{
+		FavoritedVideoIds: favorited,
+		CollectedVideoIds: collected,
+	}, nil
+}
+
+// ListVideoLikers 点赞了视频的用户，未登录时也可以查看公开视频的点赞用户
+func (s *FavoriteService) ListVideoLikers(ctx context.Context, in *v1.ListVideoLikersRequest) (*v1.ListVideoLikersReply, error) {
+	if in.VideoId == 0 {
+		return nil, errors.BadRequest("INVALID_PARAM", "video_id is required")
+	}
+	var viewer int64
+	if in.Token != "" {
+		uid, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		viewer = uid
+	}
+	limit := biz.DefaultLikerPageSize
+	if in.Limit > 0 {
+		limit = min(int(in.Limit), biz.MaxLikerPageSize)
+	}
+
+	likers, nextCursor, hasMore, err := s.uc.ListVideoLikers(ctx, viewer, in.VideoId, in.Cursor, limit)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.GetUserFavoriteVideoListReply{Videos: videoList}, nil
+	return &v1.ListVideoLikersReply{Likers: likers, NextCursor: nextCursor, HasMore: hasMore}, nil
 }
@@ -22,11 +22,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FeedType int32
+
+const (
+	FeedType_FEED_TYPE_RECOMMEND FeedType = 0 // 推荐，按热度排行
+	FeedType_FEED_TYPE_FOLLOWING FeedType = 1 // 关注，按发布时间倒序，需要登录
+	FeedType_FEED_TYPE_NEARBY    FeedType = 2 // 附近，通过 GetNearbyFeed 获取，只用于上报点击
+)
+
+// Enum value maps for FeedType.
+var (
+	FeedType_name = map[int32]string{
+		0: "FEED_TYPE_RECOMMEND",
+		1: "FEED_TYPE_FOLLOWING",
+		2: "FEED_TYPE_NEARBY",
+	}
+	FeedType_value = map[string]int32{
+		"FEED_TYPE_RECOMMEND": 0,
+		"FEED_TYPE_FOLLOWING": 1,
+		"FEED_TYPE_NEARBY":    2,
+	}
+)
+
+func (x FeedType) Enum() *FeedType {
+	p := new(FeedType)
+	*p = x
+	return p
+}
+
+func (x FeedType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedType) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_v1_feed_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedType) Type() protoreflect.EnumType {
+	return &file_feed_v1_feed_proto_enumTypes[0]
+}
+
+func (x FeedType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedType.Descriptor instead.
+func (FeedType) EnumDescriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{0}
+}
+
 type FeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
-	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // 时间游标，秒级时间戳
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // 推荐流起始位置，未传 cursor 时使用
+	Type          FeedType               `protobuf:"varint,4,opt,name=type,proto3,enum=feed.FeedType" json:"type,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"` // 分页游标，首页为空，之后传上一页返回的 next_cursor
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,17 +133,127 @@ func (x *FeedRequest) GetOffset() int64 {
 	return 0
 }
 
+func (x *FeedRequest) GetType() FeedType {
+	if x != nil {
+		return x.Type
+	}
+	return FeedType_FEED_TYPE_RECOMMEND
+}
+
+func (x *FeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// 坐标与城市代码至少传一个，同时传时按坐标查询
+type NearbyFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,5,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"` // 搜索半径，为 0 时使用默认值
+	CityCode      string                 `protobuf:"bytes,6,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`
+	Offset        int64                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyFeedRequest) Reset() {
+	*x = NearbyFeedRequest{}
+	mi := &file_feed_v1_feed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyFeedRequest) ProtoMessage() {}
+
+func (x *NearbyFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyFeedRequest.ProtoReflect.Descriptor instead.
+func (*NearbyFeedRequest) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NearbyFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NearbyFeedRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *NearbyFeedRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *NearbyFeedRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *NearbyFeedRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *NearbyFeedRequest) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *NearbyFeedRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type FeedReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	NextOffset    int64                  `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	NextOffset    int64                  `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"` // 推荐流已下发的数量，游客即为热榜快照中的排名
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`  // 下一页游标
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	ExperimentId  string                 `protobuf:"bytes,5,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // 本次排序所在的实验，上报点击时原样带回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FeedReply) Reset() {
 	*x = FeedReply{}
-	mi := &file_feed_v1_feed_proto_msgTypes[1]
+	mi := &file_feed_v1_feed_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +265,7 @@ func (x *FeedReply) String() string {
 func (*FeedReply) ProtoMessage() {}
 
 func (x *FeedReply) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_v1_feed_proto_msgTypes[1]
+	mi := &file_feed_v1_feed_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +278,7 @@ func (x *FeedReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeedReply.ProtoReflect.Descriptor instead.
 func (*FeedReply) Descriptor() ([]byte, []int) {
-	return file_feed_v1_feed_proto_rawDescGZIP(), []int{1}
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FeedReply) GetVideos() []*Video {
@@ -134,6 +295,147 @@ func (x *FeedReply) GetNextOffset() int64 {
 	return 0
 }
 
+func (x *FeedReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *FeedReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *FeedReply) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+type FeedClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	VideoId       int64                  `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ExperimentId  string                 `protobuf:"bytes,4,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // 视频所在响应的 experiment_id
+	Type          FeedType               `protobuf:"varint,5,opt,name=type,proto3,enum=feed.FeedType" json:"type,omitempty"`
+	Position      int64                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"` // 视频在流中的位置，从 0 开始
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedClickRequest) Reset() {
+	*x = FeedClickRequest{}
+	mi := &file_feed_v1_feed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedClickRequest) ProtoMessage() {}
+
+func (x *FeedClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedClickRequest.ProtoReflect.Descriptor instead.
+func (*FeedClickRequest) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeedClickRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FeedClickRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *FeedClickRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *FeedClickRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *FeedClickRequest) GetType() FeedType {
+	if x != nil {
+		return x.Type
+	}
+	return FeedType_FEED_TYPE_RECOMMEND
+}
+
+func (x *FeedClickRequest) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type FeedClickReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedClickReply) Reset() {
+	*x = FeedClickReply{}
+	mi := &file_feed_v1_feed_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedClickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedClickReply) ProtoMessage() {}
+
+func (x *FeedClickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedClickReply.ProtoReflect.Descriptor instead.
+func (*FeedClickReply) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{4}
+}
+
 type Video struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
@@ -152,7 +454,7 @@ type Video struct {
 
 func (x *Video) Reset() {
 	*x = Video{}
-	mi := &file_feed_v1_feed_proto_msgTypes[2]
+	mi := &file_feed_v1_feed_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +466,7 @@ func (x *Video) String() string {
 func (*Video) ProtoMessage() {}
 
 func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_v1_feed_proto_msgTypes[2]
+	mi := &file_feed_v1_feed_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +479,7 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Video.ProtoReflect.Descriptor instead.
 func (*Video) Descriptor() ([]byte, []int) {
-	return file_feed_v1_feed_proto_rawDescGZIP(), []int{2}
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Video) GetVideoId() int64 {
@@ -255,13 +557,14 @@ type Author struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	IsFollow      bool                   `protobuf:"varint,4,opt,name=is_follow,json=isFollow,proto3" json:"is_follow,omitempty"` // 当前用户是否已关注
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Author) Reset() {
 	*x = Author{}
-	mi := &file_feed_v1_feed_proto_msgTypes[3]
+	mi := &file_feed_v1_feed_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -273,7 +576,7 @@ func (x *Author) String() string {
 func (*Author) ProtoMessage() {}
 
 func (x *Author) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_v1_feed_proto_msgTypes[3]
+	mi := &file_feed_v1_feed_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +589,7 @@ func (x *Author) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Author.ProtoReflect.Descriptor instead.
 func (*Author) Descriptor() ([]byte, []int) {
-	return file_feed_v1_feed_proto_rawDescGZIP(), []int{3}
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Author) GetId() int64 {
@@ -310,19 +613,48 @@ func (x *Author) GetAvatarUrl() string {
 	return ""
 }
 
+func (x *Author) GetIsFollow() bool {
+	if x != nil {
+		return x.IsFollow
+	}
+	return false
+}
+
 var File_feed_v1_feed_proto protoreflect.FileDescriptor
 
 const file_feed_v1_feed_proto_rawDesc = "" +
 	"\n" +
-	"\x12feed/v1/feed.proto\x12\x04feed\x1a\x1cgoogle/api/annotations.proto\"_\n" +
+	"\x12feed/v1/feed.proto\x12\x04feed\x1a\x1cgoogle/api/annotations.proto\"\x9b\x01\n" +
 	"\vFeedRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
 	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x03R\x06offset\"Q\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\"\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x0e.feed.FeedTypeR\x04type\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\xd9\x01\n" +
+	"\x11NearbyFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\tradius_km\x18\x05 \x01(\x01R\bradiusKm\x12\x1b\n" +
+	"\tcity_code\x18\x06 \x01(\tR\bcityCode\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x03R\x06offset\"\xb2\x01\n" +
 	"\tFeedReply\x12#\n" +
 	"\x06videos\x18\x01 \x03(\v2\v.feed.VideoR\x06videos\x12\x1f\n" +
 	"\vnext_offset\x18\x02 \x01(\x03R\n" +
-	"nextOffset\"\xbb\x02\n" +
+	"nextOffset\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12#\n" +
+	"\rexperiment_id\x18\x05 \x01(\tR\fexperimentId\"\xcc\x01\n" +
+	"\x10FeedClickRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\x03R\avideoId\x12#\n" +
+	"\rexperiment_id\x18\x04 \x01(\tR\fexperimentId\x12\"\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x0e.feed.FeedTypeR\x04type\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x03R\bposition\"\x10\n" +
+	"\x0eFeedClickReply\"\xbb\x02\n" +
 	"\x05Video\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
@@ -336,14 +668,21 @@ const file_feed_v1_feed_proto_rawDesc = "" +
 	"\vis_favorite\x18\n" +
 	" \x01(\bR\n" +
 	"isFavorite\x12$\n" +
-	"\x06author\x18\v \x01(\v2\f.feed.AuthorR\x06author\"K\n" +
+	"\x06author\x18\v \x01(\v2\f.feed.AuthorR\x06author\"h\n" +
 	"\x06Author\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"avatar_url\x18\x03 \x01(\tR\tavatarUrl2O\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x1b\n" +
+	"\tis_follow\x18\x04 \x01(\bR\bisFollow*R\n" +
+	"\bFeedType\x12\x17\n" +
+	"\x13FEED_TYPE_RECOMMEND\x10\x00\x12\x17\n" +
+	"\x13FEED_TYPE_FOLLOWING\x10\x01\x12\x14\n" +
+	"\x10FEED_TYPE_NEARBY\x10\x022\x81\x02\n" +
 	"\vFeedService\x12@\n" +
-	"\aGetFeed\x12\x11.feed.FeedRequest\x1a\x0f.feed.FeedReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/feedB\x10Z\x0efeed/api/v1;v1b\x06proto3"
+	"\aGetFeed\x12\x11.feed.FeedRequest\x1a\x0f.feed.FeedReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/feed\x12S\n" +
+	"\rGetNearbyFeed\x12\x17.feed.NearbyFeedRequest\x1a\x0f.feed.FeedReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/feed/nearby\x12[\n" +
+	"\x0fReportFeedClick\x12\x16.feed.FeedClickRequest\x1a\x14.feed.FeedClickReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/feed/clickB\x10Z\x0efeed/api/v1;v1b\x06proto3"
 
 var (
 	file_feed_v1_feed_proto_rawDescOnce sync.Once
@@ -357,23 +696,34 @@ func file_feed_v1_feed_proto_rawDescGZIP() []byte {
 	return file_feed_v1_feed_proto_rawDescData
 }
 
-var file_feed_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_feed_v1_feed_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_feed_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_feed_v1_feed_proto_goTypes = []any{
-	(*FeedRequest)(nil), // 0: feed.FeedRequest
-	(*FeedReply)(nil),   // 1: feed.FeedReply
-	(*Video)(nil),       // 2: feed.Video
-	(*Author)(nil),      // 3: feed.Author
+	(FeedType)(0),             // 0: feed.FeedType
+	(*FeedRequest)(nil),       // 1: feed.FeedRequest
+	(*NearbyFeedRequest)(nil), // 2: feed.NearbyFeedRequest
+	(*FeedReply)(nil),         // 3: feed.FeedReply
+	(*FeedClickRequest)(nil),  // 4: feed.FeedClickRequest
+	(*FeedClickReply)(nil),    // 5: feed.FeedClickReply
+	(*Video)(nil),             // 6: feed.Video
+	(*Author)(nil),            // 7: feed.Author
 }
 var file_feed_v1_feed_proto_depIdxs = []int32{
-	2, // 0: feed.FeedReply.videos:type_name -> feed.Video
-	3, // 1: feed.Video.author:type_name -> feed.Author
-	0, // 2: feed.FeedService.GetFeed:input_type -> feed.FeedRequest
-	1, // 3: feed.FeedService.GetFeed:output_type -> feed.FeedReply
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: feed.FeedRequest.type:type_name -> feed.FeedType
+	6, // 1: feed.FeedReply.videos:type_name -> feed.Video
+	0, // 2: feed.FeedClickRequest.type:type_name -> feed.FeedType
+	7, // 3: feed.Video.author:type_name -> feed.Author
+	1, // 4: feed.FeedService.GetFeed:input_type -> feed.FeedRequest
+	2, // 5: feed.FeedService.GetNearbyFeed:input_type -> feed.NearbyFeedRequest
+	4, // 6: feed.FeedService.ReportFeedClick:input_type -> feed.FeedClickRequest
+	3, // 7: feed.FeedService.GetFeed:output_type -> feed.FeedReply
+	3, // 8: feed.FeedService.GetNearbyFeed:output_type -> feed.FeedReply
+	5, // 9: feed.FeedService.ReportFeedClick:output_type -> feed.FeedClickReply
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_feed_v1_feed_proto_init() }
@@ -386,13 +736,14 @@ func file_feed_v1_feed_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_feed_v1_feed_proto_rawDesc), len(file_feed_v1_feed_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_feed_v1_feed_proto_goTypes,
 		DependencyIndexes: file_feed_v1_feed_proto_depIdxs,
+		EnumInfos:         file_feed_v1_feed_proto_enumTypes,
 		MessageInfos:      file_feed_v1_feed_proto_msgTypes,
 	}.Build()
 	File_feed_v1_feed_proto = out.File
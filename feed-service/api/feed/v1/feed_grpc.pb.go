@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FeedService_GetFeed_FullMethodName = "/feed.FeedService/GetFeed"
+	FeedService_GetFeed_FullMethodName         = "/feed.FeedService/GetFeed"
+	FeedService_GetNearbyFeed_FullMethodName   = "/feed.FeedService/GetNearbyFeed"
+	FeedService_ReportFeedClick_FullMethodName = "/feed.FeedService/ReportFeedClick"
 )
 
 // FeedServiceClient is the client API for FeedService service.
@@ -27,6 +29,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FeedServiceClient interface {
 	GetFeed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*FeedReply, error)
+	// 附近、同城视频，按热度分排序
+	GetNearbyFeed(ctx context.Context, in *NearbyFeedRequest, opts ...grpc.CallOption) (*FeedReply, error)
+	// 上报点击，与曝光一起用于离线对比排序实验
+	ReportFeedClick(ctx context.Context, in *FeedClickRequest, opts ...grpc.CallOption) (*FeedClickReply, error)
 }
 
 type feedServiceClient struct {
@@ -47,11 +53,35 @@ func (c *feedServiceClient) GetFeed(ctx context.Context, in *FeedRequest, opts .
 	return out, nil
 }
 
+func (c *feedServiceClient) GetNearbyFeed(ctx context.Context, in *NearbyFeedRequest, opts ...grpc.CallOption) (*FeedReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedReply)
+	err := c.cc.Invoke(ctx, FeedService_GetNearbyFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedServiceClient) ReportFeedClick(ctx context.Context, in *FeedClickRequest, opts ...grpc.CallOption) (*FeedClickReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedClickReply)
+	err := c.cc.Invoke(ctx, FeedService_ReportFeedClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeedServiceServer is the server API for FeedService service.
 // All implementations must embed UnimplementedFeedServiceServer
 // for forward compatibility.
 type FeedServiceServer interface {
 	GetFeed(context.Context, *FeedRequest) (*FeedReply, error)
+	// 附近、同城视频，按热度分排序
+	GetNearbyFeed(context.Context, *NearbyFeedRequest) (*FeedReply, error)
+	// 上报点击，与曝光一起用于离线对比排序实验
+	ReportFeedClick(context.Context, *FeedClickRequest) (*FeedClickReply, error)
 	mustEmbedUnimplementedFeedServiceServer()
 }
 
@@ -65,6 +95,12 @@ type UnimplementedFeedServiceServer struct{}
 func (UnimplementedFeedServiceServer) GetFeed(context.Context, *FeedRequest) (*FeedReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
 }
+func (UnimplementedFeedServiceServer) GetNearbyFeed(context.Context, *NearbyFeedRequest) (*FeedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearbyFeed not implemented")
+}
+func (UnimplementedFeedServiceServer) ReportFeedClick(context.Context, *FeedClickRequest) (*FeedClickReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFeedClick not implemented")
+}
 func (UnimplementedFeedServiceServer) mustEmbedUnimplementedFeedServiceServer() {}
 func (UnimplementedFeedServiceServer) testEmbeddedByValue()                     {}
 
@@ -104,6 +140,42 @@ func _FeedService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeedService_GetNearbyFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).GetNearbyFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_GetNearbyFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).GetNearbyFeed(ctx, req.(*NearbyFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedService_ReportFeedClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedServiceServer).ReportFeedClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedService_ReportFeedClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedServiceServer).ReportFeedClick(ctx, req.(*FeedClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FeedService_ServiceDesc is the grpc.ServiceDesc for FeedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +187,14 @@ var FeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFeed",
 			Handler:    _FeedService_GetFeed_Handler,
 		},
+		{
+			MethodName: "GetNearbyFeed",
+			Handler:    _FeedService_GetNearbyFeed_Handler,
+		},
+		{
+			MethodName: "ReportFeedClick",
+			Handler:    _FeedService_ReportFeedClick_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feed/v1/feed.proto",
@@ -20,14 +20,22 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationFeedServiceGetFeed = "/feed.FeedService/GetFeed"
+const OperationFeedServiceGetNearbyFeed = "/feed.FeedService/GetNearbyFeed"
+const OperationFeedServiceReportFeedClick = "/feed.FeedService/ReportFeedClick"
 
 type FeedServiceHTTPServer interface {
 	GetFeed(context.Context, *FeedRequest) (*FeedReply, error)
+	// GetNearbyFeed 附近、同城视频，按热度分排序
+	GetNearbyFeed(context.Context, *NearbyFeedRequest) (*FeedReply, error)
+	// ReportFeedClick 上报点击，与曝光一起用于离线对比排序实验
+	ReportFeedClick(context.Context, *FeedClickRequest) (*FeedClickReply, error)
 }
 
 func RegisterFeedServiceHTTPServer(s *http.Server, srv FeedServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/api/feed", _FeedService_GetFeed0_HTTP_Handler(srv))
+	r.GET("/api/feed/nearby", _FeedService_GetNearbyFeed0_HTTP_Handler(srv))
+	r.POST("/api/feed/click", _FeedService_ReportFeedClick0_HTTP_Handler(srv))
 }
 
 func _FeedService_GetFeed0_HTTP_Handler(srv FeedServiceHTTPServer) func(ctx http.Context) error {
@@ -49,8 +57,51 @@ func _FeedService_GetFeed0_HTTP_Handler(srv FeedServiceHTTPServer) func(ctx http
 	}
 }
 
+func _FeedService_GetNearbyFeed0_HTTP_Handler(srv FeedServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NearbyFeedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFeedServiceGetNearbyFeed)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNearbyFeed(ctx, req.(*NearbyFeedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FeedReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FeedService_ReportFeedClick0_HTTP_Handler(srv FeedServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FeedClickRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFeedServiceReportFeedClick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportFeedClick(ctx, req.(*FeedClickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FeedClickReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type FeedServiceHTTPClient interface {
 	GetFeed(ctx context.Context, req *FeedRequest, opts ...http.CallOption) (rsp *FeedReply, err error)
+	GetNearbyFeed(ctx context.Context, req *NearbyFeedRequest, opts ...http.CallOption) (rsp *FeedReply, err error)
+	ReportFeedClick(ctx context.Context, req *FeedClickRequest, opts ...http.CallOption) (rsp *FeedClickReply, err error)
 }
 
 type FeedServiceHTTPClientImpl struct {
@@ -73,3 +124,29 @@ func (c *FeedServiceHTTPClientImpl) GetFeed(ctx context.Context, in *FeedRequest
 	}
 	return &out, nil
 }
+
+func (c *FeedServiceHTTPClientImpl) GetNearbyFeed(ctx context.Context, in *NearbyFeedRequest, opts ...http.CallOption) (*FeedReply, error) {
+	var out FeedReply
+	pattern := "/api/feed/nearby"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFeedServiceGetNearbyFeed))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FeedServiceHTTPClientImpl) ReportFeedClick(ctx context.Context, in *FeedClickRequest, opts ...http.CallOption) (*FeedClickReply, error) {
+	var out FeedClickReply
+	pattern := "/api/feed/click"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFeedServiceReportFeedClick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
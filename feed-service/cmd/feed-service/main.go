@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"feed-service/internal/conf"
+	"feed-service/internal/pkg/localcache"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -35,7 +36,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../configs", "config path, eg: -conf config_doc.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, r registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, r registry.Registrar, sub *localcache.Subscriber) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +46,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, r registry.Regi
 		kratos.Server(
 			gs,
 			hs,
+			sub,
 		),
 		kratos.Registrar(r),
 	)
@@ -81,7 +83,7 @@ func main() {
 	Version = bc.Service.Version
 	id = fmt.Sprintf("%s-%s", Name, bc.Server.Http.Addr)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger, bc.Registry)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, logger, bc.Registry, bc.Feed, c)
 	if err != nil {
 		panic(err)
 	}
@@ -13,6 +13,7 @@ import (
 	"feed-service/internal/server"
 	"feed-service/internal/service"
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -23,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, registry *conf.Registry) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, registry *conf.Registry, feed *conf.Feed, configConfig config.Config) (*kratos.App, func(), error) {
 	db, err := data.NewDB(confData)
 	if err != nil {
 		return nil, nil, err
@@ -32,17 +33,23 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, re
 	discovery := data.NewDiscover(registry)
 	userServiceClient := data.NewUserServiceClient(confData, discovery)
 	videoServiceClient := data.NewVideoServiceClient(confData, discovery)
-	dataData, cleanup, err := data.NewData(confData, logger, db, client, userServiceClient, videoServiceClient)
+	favoriteServiceClient := data.NewFavoriteServiceClient(confData, discovery)
+	relationServiceClient := data.NewRelationServiceClient(confData, discovery)
+	writer := data.NewFeedEventWriter(confData)
+	dataData, cleanup, err := data.NewData(confData, logger, db, client, userServiceClient, videoServiceClient, favoriteServiceClient, relationServiceClient, writer)
 	if err != nil {
 		return nil, nil, err
 	}
-	feedRepo := data.NewFeedRepo(dataData, logger)
-	feedUsecase := biz.NewFeedUsecase(feedRepo, logger)
+	feedRepo := data.NewFeedRepo(dataData, feed, logger)
+	eventRepo := data.NewEventRepo(dataData, logger)
+	experiments := biz.NewExperiments(configConfig, feed, logger)
+	feedUsecase := biz.NewFeedUsecase(feedRepo, eventRepo, experiments, feed, logger)
 	feedService := service.NewFeedService(feedUsecase)
 	grpcServer := server.NewGRPCServer(confServer, feedService, logger)
 	httpServer := server.NewHTTPServer(confServer, feedService, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, httpServer, registrar)
+	subscriber := data.NewCacheSubscriber(dataData, logger)
+	app := newApp(logger, grpcServer, httpServer, registrar, subscriber)
 	return app, func() {
 		cleanup()
 	}, nil
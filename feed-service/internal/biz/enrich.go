@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"context"
+	v1 "feed-service/api/feed/v1"
+	"feed-service/internal/pkg/constants"
+	"sync"
+)
+
+// fillVideos 并发填充作者信息、点赞评论数，以及当前用户的点赞、收藏、关注状态
+// 作者失败时返回错误；计数失败时保留视频表的计数，用户状态超时或失败时保持未点赞、未关注
+func (uc *FeedUsecase) fillVideos(ctx context.Context, uid int64, videos []*v1.Video) error {
+	if len(videos) == 0 {
+		return nil
+	}
+
+	var (
+		wg                  sync.WaitGroup
+		authorErr           error
+		liked, collected    map[int64]bool
+		following           map[int64]bool
+		videoIDs, authorIDs = collectVideoIDs(videos)
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		authorErr = uc.batchFillAuthors(ctx, videos)
+	}()
+	go func() {
+		defer wg.Done()
+		uc.batchFillVideos(ctx, videos)
+	}()
+
+	if uid != 0 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, constants.ViewerStateTimeout)
+			defer cancel()
+			var err error
+			if liked, collected, err = uc.repo.BatchIsFavorited(ctx, uid, videoIDs); err != nil {
+				uc.log.WithContext(ctx).Warnf("BatchIsFavorited for %d failed: %v", uid, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, constants.ViewerStateTimeout)
+			defer cancel()
+			var err error
+			if following, err = uc.repo.BatchIsFollowing(ctx, uid, authorIDs); err != nil {
+				uc.log.WithContext(ctx).Warnf("BatchIsFollowing for %d failed: %v", uid, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if authorErr != nil {
+		return authorErr
+	}
+	for _, v := range videos {
+		v.IsLiked = liked[v.VideoId]
+		v.IsFavorite = collected[v.VideoId]
+		if v.Author != nil {
+			v.Author.IsFollow = following[v.AuthorId]
+		}
+	}
+	return nil
+}
+
+// collectVideoIDs 视频id与去重后的作者id
+func collectVideoIDs(videos []*v1.Video) ([]int64, []int64) {
+	videoIDs := make([]int64, 0, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	seen := make(map[int64]bool, len(videos))
+	for _, v := range videos {
+		videoIDs = append(videoIDs, v.VideoId)
+		if !seen[v.AuthorId] {
+			seen[v.AuthorId] = true
+			authorIDs = append(authorIDs, v.AuthorId)
+		}
+	}
+	return videoIDs, authorIDs
+}
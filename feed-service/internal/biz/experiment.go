@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	v1 "feed-service/api/feed/v1"
+	"feed-service/internal/conf"
+	"feed-service/internal/pkg/constants"
+	"hash/fnv"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// FeedEvent 曝光、点击事件，写入 kafka 后离线对比各实验的点击率
+type FeedEvent struct {
+	Type         string  `json:"type"` // impression 或 click
+	ExperimentID string  `json:"experiment_id"`
+	UserID       int64   `json:"user_id"`
+	Source       string  `json:"source"`    // recommend、following、nearby
+	VideoIDs     []int64 `json:"video_ids"` // 曝光的一页视频，点击时只有一个
+	Position     int64   `json:"position"`  // 第一个视频在流中的位置
+	Ts           int64   `json:"ts"`        // 毫秒时间戳
+}
+
+// EventRepo 曝光、点击事件
+type EventRepo interface {
+	SendFeedEvent(ctx context.Context, event *FeedEvent) error
+}
+
+// Strategy 一个分桶使用的推荐策略
+type Strategy struct {
+	ExperimentID string
+	recommend    recommendConfig
+}
+
+// experimentSet 一份实验配置，buckets[i] 为第 i 个桶的策略
+type experimentSet struct {
+	salt    string
+	control *Strategy
+	buckets []*Strategy
+}
+
+// Experiments 排序实验，登录用户按 uid 哈希分桶，未分配给实验的桶与游客使用对照组
+type Experiments struct {
+	recommend *conf.Feed_Recommend
+	current   atomic.Pointer[experimentSet]
+	log       *log.Helper
+}
+
+// NewExperiments 加载实验配置并监听 feed.experiment 的变更
+func NewExperiments(cfg config.Config, c *conf.Feed, logger log.Logger) *Experiments {
+	e := &Experiments{recommend: c.GetRecommend(), log: log.NewHelper(logger)}
+	e.current.Store(e.build(c.GetExperiment()))
+	if err := cfg.Watch("feed.experiment", e.reload); err != nil {
+		e.log.Warnf("watch experiment config failed: %v", err)
+	}
+	return e
+}
+
+// reload 配置文件中 feed.experiment 变更时重新分桶，已分配的用户只要 salt 与桶数不变就留在原来的桶
+func (e *Experiments) reload(key string, value config.Value) {
+	ec := new(conf.Feed_Experiment)
+	if err := value.Scan(ec); err != nil {
+		e.log.Errorf("reload experiment config failed: %v", err)
+		return
+	}
+	e.current.Store(e.build(ec))
+	e.log.Infof("experiment config reloaded: %d variants", len(ec.GetVariants()))
+}
+
+// build 按配置顺序把桶依次分给各实验，桶数不够时后面的实验只分到剩余的桶
+func (e *Experiments) build(ec *conf.Feed_Experiment) *experimentSet {
+	total := int(ec.GetTotalBuckets())
+	if total <= 0 {
+		total = constants.DefaultExperimentBuckets
+	}
+	set := &experimentSet{
+		salt:    ec.GetSalt(),
+		control: &Strategy{ExperimentID: constants.ExperimentControl, recommend: newRecommendConfig(e.recommend)},
+		buckets: make([]*Strategy, total),
+	}
+	next := 0
+	for _, v := range ec.GetVariants() {
+		if v.GetId() == "" || v.GetBuckets() <= 0 {
+			continue
+		}
+		s := &Strategy{ExperimentID: v.GetId(), recommend: newRecommendConfig(mergeRecommend(e.recommend, v.GetRecommend()))}
+		for n := int(v.GetBuckets()); n > 0 && next < total; n-- {
+			set.buckets[next] = s
+			next++
+		}
+	}
+	if next < total && len(ec.GetVariants()) > 0 {
+		e.log.Infof("experiment buckets %d/%d assigned, the rest use control", next, total)
+	}
+	for i := next; i < total; i++ {
+		set.buckets[i] = set.control
+	}
+	return set
+}
+
+// mergeRecommend 实验配置覆盖基础配置中的非零项，weights 整体替换以便把某项权重设为 0
+func mergeRecommend(base, override *conf.Feed_Recommend) *conf.Feed_Recommend {
+	if override == nil {
+		return base
+	}
+	res := new(conf.Feed_Recommend)
+	if base != nil {
+		res = proto.Clone(base).(*conf.Feed_Recommend)
+	}
+	proto.Merge(res, override)
+	if override.GetWeights() != nil {
+		res.Weights = override.GetWeights()
+	}
+	return res
+}
+
+// Assign 用户所在的策略，同一配置下结果固定
+func (e *Experiments) Assign(uid int64) *Strategy {
+	set := e.current.Load()
+	if uid == 0 {
+		return set.control
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(set.salt + ":" + strconv.FormatInt(uid, 10)))
+	return set.buckets[h.Sum32()%uint32(len(set.buckets))]
+}
+
+// impress 记录一页曝光，写入失败只影响离线统计
+func (uc *FeedUsecase) impress(ctx context.Context, uid int64, experimentID, source string, position int64, videos []*v1.Video) {
+	if len(videos) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		ids = append(ids, v.VideoId)
+	}
+	err := uc.events.SendFeedEvent(ctx, &FeedEvent{
+		Type:         constants.FeedEventImpression,
+		ExperimentID: experimentID,
+		UserID:       uid,
+		Source:       source,
+		VideoIDs:     ids,
+		Position:     position,
+		Ts:           time.Now().UnixMilli(),
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("send impression event failed: %v", err)
+	}
+}
+
+// ReportClick 记录点击，实验id由客户端从响应中带回，保证与曝光时的一致
+func (uc *FeedUsecase) ReportClick(ctx context.Context, uid int64, experimentID, source string, videoID, position int64) error {
+	if videoID <= 0 || position < 0 {
+		return errors.BadRequest("INVALID_ARGUMENT", "视频id或位置不合法")
+	}
+	err := uc.events.SendFeedEvent(ctx, &FeedEvent{
+		Type:         constants.FeedEventClick,
+		ExperimentID: experimentID,
+		UserID:       uid,
+		Source:       source,
+		VideoIDs:     []int64{videoID},
+		Position:     position,
+		Ts:           time.Now().UnixMilli(),
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("send click event failed: %v", err)
+		return errors.InternalServer("REPORT_CLICK_FAILED", "上报点击失败")
+	}
+	return nil
+}
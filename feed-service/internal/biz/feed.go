@@ -2,130 +2,285 @@ package biz
 
 import (
 	"context"
+	"encoding/base64"
 	v1 "feed-service/api/feed/v1"
 	pbUser "feed-service/api/user/v1"
-	pbVideo "feed-service/api/video/v1"
+	"feed-service/internal/conf"
+	"feed-service/internal/pkg/constants"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // Greeter is a Greeter model.// GreeterRepo is a Greater repo.
 type FeedRepo interface {
-	GetFeedVideoList(context.Context, int64, int) ([]*v1.Video, error)
+	// GetFeedVideoList 按发布时间倒序获取游标之后的视频，热榜缺失时兜底
+	GetFeedVideoList(ctx context.Context, cursor *FeedCursor, limit int) ([]*v1.Video, error)
 	ParesToken(context.Context, string, string) (int64, error)
 	BatchGetUserInfo(context.Context, []int64) ([]*pbUser.Author, error)
-	BatchGetVideoInfo(ctx context.Context, vid []int64) ([]*pbVideo.Video, error)
-	BatchGetVideoCountsFromCache(context.Context, []int64) (map[int64]int64, map[int64]int64, error)
-	SetVideoCountsToCache(ctx context.Context, videoID, likeCount, commentCount int64) error
+	// BatchGetVideoCounts 批量获取缓存中的点赞数和评论数，未缓存的视频不在结果中
+	BatchGetVideoCounts(ctx context.Context, ids []int64) (likes, comments map[int64]int64, err error)
 	GetRecommendedVideoIDs(ctx context.Context, offset, limit int64) ([]int64, error)
+	// SnapshotHotVideos 取当前时间段的热榜快照，不存在时复制热榜前 N 名，返回快照所在的时间段
+	SnapshotHotVideos(ctx context.Context) (bucket string, err error)
+	// ListHotSnapshot 按排名读取时间段的快照，快照不存在时 ok 为 false
+	ListHotSnapshot(ctx context.Context, bucket string, offset, limit int64) (ids []int64, ok bool, err error)
+	// HotRankingExists 热榜是否存在
+	HotRankingExists(ctx context.Context) (bool, error)
+	// RequestHotRebuild 请求 job-service 重算热榜
+	RequestHotRebuild(ctx context.Context) error
+	// GetFeedVideoListByIDS 按 ids 顺序查询已发布的视频
 	GetFeedVideoListByIDS(ctx context.Context, ids []int64) ([]*v1.Video, error)
+	// BatchIsFavorited 当前用户点赞、收藏过的视频
+	BatchIsFavorited(ctx context.Context, uid int64, vids []int64) (liked, collected map[int64]bool, err error)
+	// BatchIsFollowing 当前用户关注了哪些作者
+	BatchIsFollowing(ctx context.Context, uid int64, authorIDs []int64) (map[int64]bool, error)
+	// ListInbox 读取关注流收件箱，收件箱不存在时从数据库重建
+	ListInbox(ctx context.Context, uid int64, cursor *FeedCursor, limit int) ([]FeedItem, error)
+	// ListBigFolloweeVideos 拉取关注的大 V 在游标之后发布的视频
+	ListBigFolloweeVideos(ctx context.Context, uid int64, cursor *FeedCursor, limit int) ([]FeedItem, error)
+	// GetUserProfile 读取用户偏好最高的话题和作者
+	GetUserProfile(ctx context.Context, uid int64, topTags, topAuthors int) (*UserProfile, error)
+	// ListTagVideoIDs 每个话题下最近发布的视频
+	ListTagVideoIDs(ctx context.Context, tags []string, limitPerTag int) ([]int64, error)
+	// ListRecentFolloweeIDs 最近关注的作者
+	ListRecentFolloweeIDs(ctx context.Context, uid int64, limit int) ([]int64, error)
+	// ListAuthorVideoIDs 作者们最近发布的视频
+	ListAuthorVideoIDs(ctx context.Context, authorIDs []int64, limit int) ([]int64, error)
+	// ListFreshVideoIDs since 之后发布的公开视频
+	ListFreshVideoIDs(ctx context.Context, since time.Time, limit int) ([]int64, error)
+	// GetCandidates 查询候选视频的作者、话题、发布时间与热度分，未发布、私密或已删除的视频不返回
+	GetCandidates(ctx context.Context, ids []int64) ([]*Candidate, error)
+	// FilterSeen 过滤用户已看过的视频，保持原有顺序
+	FilterSeen(ctx context.Context, uid int64, ids []int64) ([]int64, error)
+	// MarkSeen 记录用户已看过的视频
+	MarkSeen(ctx context.Context, uid int64, ids []int64) error
+	// ListNearbyVideoIDs 半径内的视频，按距离由近到远
+	ListNearbyVideoIDs(ctx context.Context, latitude, longitude, radiusKm float64, limit int) ([]int64, error)
+	// ListCityVideoIDs 同城最近发布的视频
+	ListCityVideoIDs(ctx context.Context, cityCode string, limit int) ([]int64, error)
 }
 
 // GreeterUsecase is a Greeter usecase.
 type FeedUsecase struct {
-	repo FeedRepo
-	log  *log.Helper
+	repo       FeedRepo
+	events     EventRepo
+	exp        *Experiments
+	nearbyConf nearbyConfig
+	log        *log.Helper
 }
 
 // NewGreeterUsecase new a Greeter usecase.
-func NewFeedUsecase(repo FeedRepo, logger log.Logger) *FeedUsecase {
-	return &FeedUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewFeedUsecase(repo FeedRepo, events EventRepo, exp *Experiments, c *conf.Feed, logger log.Logger) *FeedUsecase {
+	return &FeedUsecase{
+		repo:       repo,
+		events:     events,
+		exp:        exp,
+		nearbyConf: newNearbyConfig(c.GetNearby()),
+		log:        log.NewHelper(logger),
+	}
 }
 
-// GetFeed 获取视频流
-func (uc *FeedUsecase) GetFeed(ctx context.Context, uid, offset, limit int64) ([]*v1.Video, error) {
-	uc.log.WithContext(ctx).Infof("GetFeed: %d, %d, %d", uid, offset, limit)
+// FeedPage 一页视频流
+type FeedPage struct {
+	Videos       []*v1.Video
+	NextCursor   string
+	NextOffset   int64 // 已下发的数量，游客即为热榜快照中的排名
+	HasMore      bool
+	ExperimentID string // 本次排序所在的实验
+}
 
-	// 1. 从缓存热榜中查询
-	videoIDs, err := uc.repo.GetRecommendedVideoIDs(ctx, offset, limit)
+// GetFeed 获取视频流：登录用户按兴趣画像推荐并过滤已看，游客按热榜快照分页
+// 未传游标时从 offset 开始新的会话；热榜缺失时本次会话改为按发布时间倒序，同时在后台重建热榜
+func (uc *FeedUsecase) GetFeed(ctx context.Context, uid int64, cursor string, offset, limit int64) (*FeedPage, error) {
+	uc.log.WithContext(ctx).Infof("GetFeed: %d, %s, %d, %d", uid, cursor, offset, limit)
+	cur, err := decodeRecommendCursor(cursor)
 	if err != nil {
-		return nil, err
+		return nil, errors.BadRequest("INVALID_CURSOR", "游标不合法")
+	}
+	if cur == nil {
+		cur = &recommendCursor{Offset: max(offset, 0)}
 	}
 
-	// 1. 从数据库中获取信息
-	videos, err := uc.repo.GetFeedVideoListByIDS(ctx, videoIDs)
-	if err != nil {
+	// 1. 登录用户按所在实验的策略推荐，游客取热榜快照
+	strategy := uc.exp.Assign(uid)
+	var (
+		videoIDs []int64
+		videos   []*v1.Video
+		hasMore  bool
+	)
+	fallback := cur.Latest != nil
+	if !fallback {
+		if uid != 0 {
+			videoIDs, hasMore, err = uc.recommend(ctx, uid, limit, strategy.recommend)
+		} else {
+			videoIDs, err = uc.hotPage(ctx, cur, limit)
+			hasMore = int64(len(videoIDs)) == limit
+		}
+		if err != nil {
+			return nil, err
+		}
+		fallback = len(videoIDs) == 0 && uc.hotRankingMissing(ctx)
+	}
+
+	// 2. 从数据库中获取信息，兜底时直接按发布时间倒序查询
+	if fallback {
+		if videos, err = uc.repo.GetFeedVideoList(ctx, cur.Latest, int(limit)); err != nil {
+			return nil, err
+		}
+		hasMore = int64(len(videos)) == limit
+		for _, v := range videos {
+			videoIDs = append(videoIDs, v.VideoId)
+		}
+	} else if videos, err = uc.repo.GetFeedVideoListByIDS(ctx, videoIDs); err != nil {
 		uc.log.WithContext(ctx).Errorf("GetFeed: %d, %d", uid, videoIDs)
 		return nil, err
 	}
 
+	next := &recommendCursor{Bucket: cur.Bucket, Offset: cur.Offset + int64(len(videoIDs)), Latest: cur.Latest}
+	if fallback && len(videos) > 0 {
+		last := videos[len(videos)-1]
+		next.Latest = &FeedCursor{PublishTime: last.PublishTime * 1000, VideoID: last.VideoId}
+	}
+	page := &FeedPage{
+		Videos:       []*v1.Video{},
+		NextCursor:   next.encode(),
+		NextOffset:   next.Offset,
+		HasMore:      hasMore,
+		ExperimentID: strategy.ExperimentID,
+	}
+
+	// 下发即记为已看，记录失败只会导致重复推荐，不影响本次返回
+	if uid != 0 {
+		if err := uc.repo.MarkSeen(ctx, uid, videoIDs); err != nil {
+			uc.log.WithContext(ctx).Warnf("mark seen videos for %d failed: %v", uid, err)
+		}
+	}
+
 	if len(videos) == 0 {
-		return []*v1.Video{}, nil
+		return page, nil
 	}
 
-	// 2. 作者信息
-	err = uc.batchFillAuthors(ctx, videos)
-	if err != nil {
+	// 3. 作者信息、点赞评论数与当前用户的互动状态
+	if err := uc.fillVideos(ctx, uid, videos); err != nil {
 		uc.log.WithContext(ctx).Errorf("GetFeed: %d", uid)
 		return nil, err
 	}
 
-	// 3. 点赞信息，评论信息
-	err = uc.batchFillVideos(ctx, videos)
+	page.Videos = videos
+	uc.impress(ctx, uid, strategy.ExperimentID, constants.FeedSourceRecommend, cur.Offset, videos)
+	return page, nil
+}
+
+// hotPage 从游标所在时间段的快照中按排名取一页，新会话取当前时间段的快照；
+// 快照过期或游标中的时间段不存在时改用当前时间段的快照，从原位置继续
+func (uc *FeedUsecase) hotPage(ctx context.Context, cur *recommendCursor, limit int64) ([]int64, error) {
+	if cur.Bucket != "" {
+		ids, ok, err := uc.repo.ListHotSnapshot(ctx, cur.Bucket, cur.Offset, limit)
+		if err != nil || ok {
+			return ids, err
+		}
+		uc.log.WithContext(ctx).Infof("hot snapshot %s expired, switch to the current one", cur.Bucket)
+	}
+
+	bucket, err := uc.repo.SnapshotHotVideos(ctx)
 	if err != nil {
-		uc.log.WithContext(ctx).Errorf("GetFeed: %d", uid)
 		return nil, err
 	}
+	cur.Bucket = bucket
+	ids, _, err := uc.repo.ListHotSnapshot(ctx, cur.Bucket, cur.Offset, limit)
+	return ids, err
+}
 
-	return videos, nil
+// hotRankingMissing 热榜是否缺失，缺失时请求 job-service 重算；读取失败按未缺失处理
+func (uc *FeedUsecase) hotRankingMissing(ctx context.Context) bool {
+	ok, err := uc.repo.HotRankingExists(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("check hot ranking failed: %v", err)
+		return false
+	}
+	if ok {
+		return false
+	}
+	uc.log.WithContext(ctx).Warn("hot ranking is missing, fall back to latest videos")
+	if err := uc.repo.RequestHotRebuild(ctx); err != nil {
+		uc.log.WithContext(ctx).Errorf("request hot ranking rebuild failed: %v", err)
+	}
+	return true
 }
 
-// batchFillVideos 批量填充视频返回信息
-func (uc *FeedUsecase) batchFillVideos(ctx context.Context, videos []*v1.Video) error {
+// recommendCursor 推荐流游标，游客记录热榜快照所在的时间段与排名，登录用户只记录已下发的数量；
+// 热榜缺失进入兜底后额外记录最后一个视频的发布时间与id，之后按发布时间倒序翻页
+type recommendCursor struct {
+	Bucket string
+	Offset int64
+	Latest *FeedCursor
+}
 
-	if len(videos) == 0 {
-		return nil
+func (c *recommendCursor) encode() string {
+	s := fmt.Sprintf("%s:%d", c.Bucket, c.Offset)
+	if c.Latest != nil {
+		s += fmt.Sprintf(":%d:%d", c.Latest.PublishTime, c.Latest.VideoID)
 	}
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
 
-	// 1. video id
-	videoIDs := make([]int64, 0, len(videos))
-	for _, v := range videos {
-		videoIDs = append(videoIDs, v.VideoId)
+// decodeRecommendCursor 空游标表示新的会话
+func decodeRecommendCursor(s string) (*recommendCursor, error) {
+	if s == "" {
+		return nil, nil
 	}
-
-	// 2. 从缓存中直接获取评论数和点赞数
-	likeCount, commentCount, err := uc.repo.BatchGetVideoCountsFromCache(ctx, videoIDs)
+	b, err := base64.RawURLEncoding.DecodeString(s)
 	if err != nil {
-		uc.log.WithContext(ctx).Errorf("BatchGetVideoCountsFromCache error: %v", err)
+		return nil, err
 	}
-
-	// 3. 填充缓冲中命中的
-	missingIDs := make([]int64, 0)
-	for i, vid := range videoIDs {
-		v := videos[i]
-		if like, ok := likeCount[vid]; ok {
-			v.LikeCount = like
-		} else {
-			missingIDs = append(missingIDs, vid)
-		}
-		if comment, ok := commentCount[vid]; ok {
-			v.CommentCount = comment
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 2 && len(parts) != 4 {
+		return nil, fmt.Errorf("invalid feed cursor: %s", b)
+	}
+	c := &recommendCursor{Bucket: parts[0]}
+	if c.Offset, err = strconv.ParseInt(parts[1], 10, 64); err != nil || c.Offset < 0 {
+		return nil, fmt.Errorf("invalid feed cursor: %s", b)
+	}
+	if len(parts) == 4 {
+		c.Latest = new(FeedCursor)
+		var err1, err2 error
+		c.Latest.PublishTime, err1 = strconv.ParseInt(parts[2], 10, 64)
+		c.Latest.VideoID, err2 = strconv.ParseInt(parts[3], 10, 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("invalid feed cursor: %s", b)
 		}
 	}
+	return c, nil
+}
 
-	// 缓存未命中的，兜底查 DB（或由 video-service 查 DB）
+// batchFillVideos 批量填充点赞数和评论数，读取失败或未缓存时保留视频卡片中的计数
+func (uc *FeedUsecase) batchFillVideos(ctx context.Context, videos []*v1.Video) {
+	if len(videos) == 0 {
+		return
+	}
 
-	if len(missingIDs) > 0 {
-		resp, err := uc.repo.BatchGetVideoInfo(ctx, missingIDs)
-		if err != nil {
-			return err
+	videoIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		videoIDs = append(videoIDs, v.VideoId)
+	}
+	likeCount, commentCount, err := uc.repo.BatchGetVideoCounts(ctx, videoIDs)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("BatchGetVideoCounts error: %v", err)
+		return
+	}
+	for _, v := range videos {
+		if n, ok := likeCount[v.VideoId]; ok {
+			v.LikeCount = n
 		}
-		// 回填缓存和视频结构
-		for _, v := range resp {
-			for _, vv := range videos {
-				if vv.VideoId == v.Id {
-					vv.CommentCount = int64(v.CommentCnt)
-					vv.LikeCount = int64(v.FavoriteCnt)
-				}
-			}
-			err := uc.repo.SetVideoCountsToCache(ctx, v.Id, int64(v.FavoriteCnt), int64(v.CommentCnt))
-			if err != nil {
-				uc.log.WithContext(ctx).Errorf("SetVideoCountsToCache error: %v", err)
-				return err
-			}
+		if n, ok := commentCount[v.VideoId]; ok {
+			v.CommentCount = n
 		}
 	}
-	return nil
 }
 
 // batchFillAuthors 批量填充视频作者信息
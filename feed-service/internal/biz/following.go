@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	v1 "feed-service/api/feed/v1"
+	"feed-service/internal/pkg/constants"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// FeedItem 关注流中的一条视频，按发布时间、视频id倒序排列
+type FeedItem struct {
+	VideoID     int64
+	PublishTime int64 // 毫秒
+}
+
+// FeedCursor 关注流游标，返回排在该位置之后的视频
+type FeedCursor struct {
+	PublishTime int64
+	VideoID     int64
+}
+
+// newerThan 是否排在 o 之前
+func (it FeedItem) newerThan(o FeedItem) bool {
+	return it.PublishTime > o.PublishTime || (it.PublishTime == o.PublishTime && it.VideoID > o.VideoID)
+}
+
+// Before 视频是否排在游标之后，nil 游标表示第一页
+func (c *FeedCursor) Before(item FeedItem) bool {
+	return c == nil || (FeedItem{VideoID: c.VideoID, PublishTime: c.PublishTime}).newerThan(item)
+}
+
+// GetFollowingFeed 关注流：推送到收件箱的视频与读取时拉取的大 V 视频按发布时间合并
+func (uc *FeedUsecase) GetFollowingFeed(ctx context.Context, uid int64, cursor string, limit int) (*FeedPage, error) {
+	uc.log.WithContext(ctx).Infof("GetFollowingFeed: uid=%d cursor=%s limit=%d", uid, cursor, limit)
+	if uid == 0 {
+		return nil, errors.Unauthorized("LOGIN_REQUIRED", "关注流需要登录")
+	}
+	cur, err := decodeFeedCursor(cursor)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_CURSOR", "游标不合法")
+	}
+	page := &FeedPage{Videos: []*v1.Video{}, ExperimentID: uc.exp.Assign(uid).ExperimentID}
+
+	// 1. 收件箱与大 V 各取一页，合并后截断
+	pushed, err := uc.repo.ListInbox(ctx, uid, cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	pulled, err := uc.repo.ListBigFolloweeVideos(ctx, uid, cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	items := mergeFeedItems(pushed, pulled, cur, limit)
+	if len(items) == 0 {
+		return page, nil
+	}
+	last := items[len(items)-1]
+	page.NextCursor = encodeFeedCursor(&FeedCursor{PublishTime: last.PublishTime, VideoID: last.VideoID})
+	page.HasMore = len(items) == limit
+
+	// 2. 查询视频详情，已删除的视频在这里过滤
+	ids := make([]int64, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.VideoID)
+	}
+	videos, err := uc.repo.GetFeedVideoListByIDS(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillVideos(ctx, uid, videos); err != nil {
+		return nil, err
+	}
+	// 关注流按游标翻页，曝光不记录位置
+	if len(videos) > 0 {
+		page.Videos = videos
+	}
+	uc.impress(ctx, uid, page.ExperimentID, constants.FeedSourceFollowing, 0, videos)
+	return page, nil
+}
+
+// mergeFeedItems 合并两个倒序列表，去重并只保留游标之后的 limit 条
+func mergeFeedItems(a, b []FeedItem, cur *FeedCursor, limit int) []FeedItem {
+	res := make([]FeedItem, 0, limit)
+	seen := make(map[int64]bool, limit)
+	i, j := 0, 0
+	for len(res) < limit && (i < len(a) || j < len(b)) {
+		var it FeedItem
+		if j >= len(b) || (i < len(a) && !b[j].newerThan(a[i])) {
+			it = a[i]
+			i++
+		} else {
+			it = b[j]
+			j++
+		}
+		if seen[it.VideoID] || !cur.Before(it) {
+			continue
+		}
+		seen[it.VideoID] = true
+		res = append(res, it)
+	}
+	return res
+}
+
+func encodeFeedCursor(c *FeedCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.PublishTime, c.VideoID)))
+}
+
+// decodeFeedCursor 空游标表示第一页
+func decodeFeedCursor(s string) (*FeedCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	c := new(FeedCursor)
+	if _, err := fmt.Sscanf(string(b), "%d:%d", &c.PublishTime, &c.VideoID); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	v1 "feed-service/api/feed/v1"
+	"feed-service/internal/conf"
+	"feed-service/internal/pkg/constants"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// NearbyQuery 附近视频查询条件，有坐标时按半径查询，否则按城市代码查询
+type NearbyQuery struct {
+	Latitude  float64
+	Longitude float64
+	HasCoord  bool
+	RadiusKm  float64
+	CityCode  string
+}
+
+// nearbyConfig 附近视频配置，未配置的项使用默认值
+type nearbyConfig struct {
+	maxCandidates   int
+	defaultRadiusKm float64
+	maxRadiusKm     float64
+}
+
+func newNearbyConfig(c *conf.Feed_Nearby) nearbyConfig {
+	nc := nearbyConfig{
+		maxCandidates:   constants.DefaultNearbyMaxCandidates,
+		defaultRadiusKm: constants.DefaultNearbyRadiusKm,
+		maxRadiusKm:     constants.DefaultNearbyMaxRadiusKm,
+	}
+	if c.GetMaxCandidates() > 0 {
+		nc.maxCandidates = int(c.GetMaxCandidates())
+	}
+	if c.GetDefaultRadiusKm() > 0 {
+		nc.defaultRadiusKm = c.GetDefaultRadiusKm()
+	}
+	if c.GetMaxRadiusKm() > 0 {
+		nc.maxRadiusKm = c.GetMaxRadiusKm()
+	}
+	return nc
+}
+
+// GetNearbyFeed 获取附近或同城的视频：取最近的候选，过滤未发布、已删除的视频后按热度分排序，按 offset 分页
+func (uc *FeedUsecase) GetNearbyFeed(ctx context.Context, uid int64, q *NearbyQuery, offset, limit int64) (*FeedPage, error) {
+	var (
+		ids []int64
+		err error
+	)
+	switch {
+	case q.HasCoord:
+		if q.Latitude < -90 || q.Latitude > 90 || q.Longitude < -180 || q.Longitude > 180 || q.RadiusKm < 0 {
+			return nil, errors.BadRequest("INVALID_LOCATION", "坐标超出范围")
+		}
+		radius := q.RadiusKm
+		if radius == 0 {
+			radius = uc.nearbyConf.defaultRadiusKm
+		}
+		radius = min(radius, uc.nearbyConf.maxRadiusKm)
+		ids, err = uc.repo.ListNearbyVideoIDs(ctx, q.Latitude, q.Longitude, radius, uc.nearbyConf.maxCandidates)
+	case q.CityCode != "":
+		ids, err = uc.repo.ListCityVideoIDs(ctx, q.CityCode, uc.nearbyConf.maxCandidates)
+	default:
+		return nil, errors.BadRequest("INVALID_LOCATION", "缺少坐标或城市代码")
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list nearby videos failed: %v", err)
+		return nil, err
+	}
+
+	// 1. 按热度分排序，同分时新发布的在前
+	candidates, err := uc.repo.GetCandidates(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].HotScore != candidates[j].HotScore {
+			return candidates[i].HotScore > candidates[j].HotScore
+		}
+		if candidates[i].PublishTime != candidates[j].PublishTime {
+			return candidates[i].PublishTime > candidates[j].PublishTime
+		}
+		return candidates[i].VideoID > candidates[j].VideoID
+	})
+
+	// 2. 分页
+	offset = max(offset, 0)
+	end := min(offset+limit, int64(len(candidates)))
+	page := &FeedPage{
+		Videos:       []*v1.Video{},
+		NextOffset:   offset,
+		HasMore:      end < int64(len(candidates)),
+		ExperimentID: uc.exp.Assign(uid).ExperimentID,
+	}
+	if offset >= end {
+		return page, nil
+	}
+	pageIDs := make([]int64, 0, end-offset)
+	for _, c := range candidates[offset:end] {
+		pageIDs = append(pageIDs, c.VideoID)
+	}
+	page.NextOffset = end
+
+	// 3. 从数据库中获取信息，填充作者信息、点赞评论数与当前用户的互动状态
+	videos, err := uc.repo.GetFeedVideoListByIDS(ctx, pageIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillVideos(ctx, uid, videos); err != nil {
+		return nil, err
+	}
+	if len(videos) > 0 {
+		page.Videos = videos
+	}
+	uc.impress(ctx, uid, page.ExperimentID, constants.FeedSourceNearby, offset, videos)
+	return page, nil
+}
@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	"feed-service/internal/conf"
+	"math"
+	"sort"
+	"time"
+)
+
+// UserProfile 用户兴趣画像，偏好值按最大值归一化到 [0, 1]
+type UserProfile struct {
+	Tags       map[string]float64
+	Authors    map[int64]float64
+	TopTags    []string // 按偏好倒序
+	TopAuthors []int64
+}
+
+// Candidate 推荐候选视频
+type Candidate struct {
+	VideoID     int64
+	AuthorID    int64
+	Tags        []string
+	PublishTime int64 // 毫秒
+	HotScore    float64
+	Score       float64 // 个性化打分
+}
+
+// recommendConfig 推荐配置，未配置的项使用默认值
+type recommendConfig struct {
+	hotCandidates    int
+	tagCandidates    int
+	authorCandidates int
+	freshCandidates  int
+	freshWindow      time.Duration
+	topTags          int
+	topAuthors       int
+	maxPerAuthor     int
+	maxSize          int
+	freshHalfLife    time.Duration
+	overFetchRounds  int
+	weights          *conf.RecommendWeights
+}
+
+func newRecommendConfig(c *conf.Feed_Recommend) recommendConfig {
+	rc := recommendConfig{
+		hotCandidates:    200,
+		tagCandidates:    50,
+		authorCandidates: 100,
+		freshCandidates:  100,
+		freshWindow:      72 * time.Hour,
+		topTags:          5,
+		topAuthors:       20,
+		maxPerAuthor:     2,
+		maxSize:          500,
+		freshHalfLife:    24 * time.Hour,
+		overFetchRounds:  3,
+		weights:          c.GetWeights(),
+	}
+	if rc.weights == nil {
+		rc.weights = &conf.RecommendWeights{Hot: 1, Tag: 1.5, Author: 1, Fresh: 0.5}
+	}
+	setPositive := func(dst *int, v int32) {
+		if v > 0 {
+			*dst = int(v)
+		}
+	}
+	setPositive(&rc.hotCandidates, c.GetHotCandidates())
+	setPositive(&rc.tagCandidates, c.GetTagCandidates())
+	setPositive(&rc.authorCandidates, c.GetAuthorCandidates())
+	setPositive(&rc.freshCandidates, c.GetFreshCandidates())
+	setPositive(&rc.topTags, c.GetTopTags())
+	setPositive(&rc.topAuthors, c.GetTopAuthors())
+	setPositive(&rc.maxPerAuthor, c.GetMaxPerAuthor())
+	setPositive(&rc.maxSize, c.GetMaxSize())
+	setPositive(&rc.overFetchRounds, c.GetOverFetchRounds())
+	if c.GetFreshWindow().AsDuration() > 0 {
+		rc.freshWindow = c.GetFreshWindow().AsDuration()
+	}
+	if c.GetFreshHalfLife().AsDuration() > 0 {
+		rc.freshHalfLife = c.GetFreshHalfLife().AsDuration()
+	}
+	return rc
+}
+
+// recommend 登录用户的个性化推荐：多路召回并过滤已看、打分、按作者打散，返回排在最前的一页视频id
+// 下发的视频会记为已看，下一页重新召回即可，不需要按位置翻页
+func (uc *FeedUsecase) recommend(ctx context.Context, uid, limit int64, rc recommendConfig) ([]int64, bool, error) {
+	profile, err := uc.repo.GetUserProfile(ctx, uid, rc.topTags, rc.topAuthors)
+	if err != nil {
+		// 画像读取失败时退化为热榜与新视频
+		uc.log.WithContext(ctx).Warnf("get user profile %d failed: %v", uid, err)
+		profile = &UserProfile{}
+	}
+
+	ids, err := uc.recall(ctx, uid, profile, rc)
+	if err != nil {
+		return nil, false, err
+	}
+	candidates, err := uc.repo.GetCandidates(ctx, ids)
+	if err != nil {
+		return nil, false, err
+	}
+
+	scoreCandidates(candidates, profile, rc, time.Now())
+	if len(candidates) > rc.maxSize {
+		candidates = candidates[:rc.maxSize]
+	}
+	ranked := diversify(candidates, int(limit), rc.maxPerAuthor)
+
+	n := min(int(limit), len(ranked))
+	res := make([]int64, 0, n)
+	for _, c := range ranked[:n] {
+		res = append(res, c.VideoID)
+	}
+	return res, len(ranked) > n, nil
+}
+
+// recall 多路召回：热榜、偏好话题、偏好与关注的作者、新发布，去重并过滤已看；热榜以外的召回失败时跳过
+func (uc *FeedUsecase) recall(ctx context.Context, uid int64, profile *UserProfile, rc recommendConfig) ([]int64, error) {
+	hot, err := uc.recallHot(ctx, uid, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagged, authored, fresh []int64
+	if len(profile.TopTags) > 0 {
+		if tagged, err = uc.repo.ListTagVideoIDs(ctx, profile.TopTags, rc.tagCandidates); err != nil {
+			uc.log.WithContext(ctx).Warnf("recall tag videos for %d failed: %v", uid, err)
+		}
+	}
+	authorIDs := profile.TopAuthors
+	if followees, err := uc.repo.ListRecentFolloweeIDs(ctx, uid, rc.topAuthors); err != nil {
+		uc.log.WithContext(ctx).Warnf("list followees of %d failed: %v", uid, err)
+	} else {
+		authorIDs = append(authorIDs[:len(authorIDs):len(authorIDs)], followees...)
+	}
+	if len(authorIDs) > 0 {
+		if authored, err = uc.repo.ListAuthorVideoIDs(ctx, authorIDs, rc.authorCandidates); err != nil {
+			uc.log.WithContext(ctx).Warnf("recall author videos for %d failed: %v", uid, err)
+		}
+	}
+	if fresh, err = uc.repo.ListFreshVideoIDs(ctx, time.Now().Add(-rc.freshWindow), rc.freshCandidates); err != nil {
+		uc.log.WithContext(ctx).Warnf("recall fresh videos failed: %v", err)
+	}
+
+	seen := make(map[int64]bool)
+	for _, id := range hot {
+		seen[id] = true
+	}
+	var others []int64
+	for _, source := range [][]int64{tagged, authored, fresh} {
+		for _, id := range source {
+			if !seen[id] {
+				seen[id] = true
+				others = append(others, id)
+			}
+		}
+	}
+	return append(hot, uc.filterSeen(ctx, uid, others)...), nil
+}
+
+// recallHot 按排名从热榜取候选并过滤已看，不足时继续往后多取几轮
+func (uc *FeedUsecase) recallHot(ctx context.Context, uid int64, rc recommendConfig) ([]int64, error) {
+	want := int64(rc.hotCandidates)
+	var (
+		res    []int64
+		offset int64
+	)
+	for round := 0; round <= rc.overFetchRounds && int64(len(res)) < want; round++ {
+		ids, err := uc.repo.GetRecommendedVideoIDs(ctx, offset, want)
+		if err != nil {
+			return nil, err
+		}
+		// 热榜缺失时其他召回仍可能有结果，在这里触发后台重建
+		if round == 0 && len(ids) == 0 {
+			uc.hotRankingMissing(ctx)
+		}
+		offset += int64(len(ids))
+		res = append(res, uc.filterSeen(ctx, uid, ids)...)
+		if int64(len(ids)) < want {
+			break
+		}
+	}
+	return res, nil
+}
+
+// filterSeen 过滤已看，读取失败时不过滤
+func (uc *FeedUsecase) filterSeen(ctx context.Context, uid int64, ids []int64) []int64 {
+	unseen, err := uc.repo.FilterSeen(ctx, uid, ids)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("filter seen videos for %d failed: %v", uid, err)
+		return ids
+	}
+	return unseen
+}
+
+// scoreCandidates 热度、话题偏好、作者偏好、新鲜度归一化后加权求和，按分数倒序排列
+func scoreCandidates(candidates []*Candidate, profile *UserProfile, rc recommendConfig, now time.Time) {
+	var maxHot float64
+	for _, c := range candidates {
+		maxHot = max(maxHot, c.HotScore)
+	}
+	w := rc.weights
+	for _, c := range candidates {
+		// 热度分跨度很大，取对数后再归一化
+		var hot float64
+		if maxHot > 0 && c.HotScore > 0 {
+			hot = math.Log1p(c.HotScore) / math.Log1p(maxHot)
+		}
+		var tag float64
+		for _, t := range c.Tags {
+			tag = max(tag, profile.Tags[t])
+		}
+		author := profile.Authors[c.AuthorID]
+		age := max(now.UnixMilli()-c.PublishTime, 0)
+		fresh := math.Exp2(-float64(age) / float64(rc.freshHalfLife.Milliseconds()))
+
+		c.Score = w.GetHot()*hot + w.GetTag()*tag + w.GetAuthor()*author + w.GetFresh()*fresh
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].VideoID > candidates[j].VideoID
+	})
+}
+
+// diversify 按页打散，每页同一作者最多 maxPerAuthor 个，超出的顺延到后面的页；
+// 剩余视频都来自已满额的作者时放宽限制补满当前页，保证除最后一页外每页都是 pageSize 个
+func diversify(candidates []*Candidate, pageSize, maxPerAuthor int) []*Candidate {
+	if pageSize <= 0 || maxPerAuthor <= 0 {
+		return candidates
+	}
+	res := make([]*Candidate, 0, len(candidates))
+	rest := candidates
+	for len(rest) > 0 {
+		start := len(res)
+		count := make(map[int64]int)
+		deferred := make([]*Candidate, 0, len(rest))
+		for _, c := range rest {
+			if len(res)-start < pageSize && count[c.AuthorID] < maxPerAuthor {
+				count[c.AuthorID]++
+				res = append(res, c)
+			} else {
+				deferred = append(deferred, c)
+			}
+		}
+		n := min(pageSize-(len(res)-start), len(deferred))
+		res = append(res, deferred[:n]...)
+		rest = deferred[n:]
+	}
+	return res
+}
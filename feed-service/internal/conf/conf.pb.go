@@ -28,6 +28,7 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Registry      *Registry              `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
 	Service       *Service               `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Feed          *Feed                  `protobuf:"bytes,5,opt,name=feed,proto3" json:"feed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +91,13 @@ func (x *Bootstrap) GetService() *Service {
 	return nil
 }
 
+func (x *Bootstrap) GetFeed() *Feed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -143,13 +151,17 @@ func (x *Server) GetGrpc() *Server_GRPC {
 }
 
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	UserService   *Data_UserService      `protobuf:"bytes,3,opt,name=user_service,json=userService,proto3" json:"user_service,omitempty"`
-	VideoService  *Data_VideoService     `protobuf:"bytes,4,opt,name=video_service,json=videoService,proto3" json:"video_service,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Database        *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis           *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	UserService     *Data_UserService      `protobuf:"bytes,3,opt,name=user_service,json=userService,proto3" json:"user_service,omitempty"`
+	VideoService    *Data_VideoService     `protobuf:"bytes,4,opt,name=video_service,json=videoService,proto3" json:"video_service,omitempty"`
+	FavoriteService *Data_FavoriteService  `protobuf:"bytes,5,opt,name=favorite_service,json=favoriteService,proto3" json:"favorite_service,omitempty"`
+	RelationService *Data_RelationService  `protobuf:"bytes,6,opt,name=relation_service,json=relationService,proto3" json:"relation_service,omitempty"`
+	Kafka           *Data_Kafka            `protobuf:"bytes,8,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	LocalCache      *Data_LocalCache       `protobuf:"bytes,9,opt,name=local_cache,json=localCache,proto3" json:"local_cache,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Data) Reset() {
@@ -210,6 +222,34 @@ func (x *Data) GetVideoService() *Data_VideoService {
 	return nil
 }
 
+func (x *Data) GetFavoriteService() *Data_FavoriteService {
+	if x != nil {
+		return x.FavoriteService
+	}
+	return nil
+}
+
+func (x *Data) GetRelationService() *Data_RelationService {
+	if x != nil {
+		return x.RelationService
+	}
+	return nil
+}
+
+func (x *Data) GetKafka() *Data_Kafka {
+	if x != nil {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (x *Data) GetLocalCache() *Data_LocalCache {
+	if x != nil {
+		return x.LocalCache
+	}
+	return nil
+}
+
 type Registry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Consul        *Registry_Consul       `protobuf:"bytes,1,opt,name=consul,proto3" json:"consul,omitempty"`
@@ -306,6 +346,159 @@ func (x *Service) GetVersion() string {
 	return ""
 }
 
+type Feed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Following     *Feed_Following        `protobuf:"bytes,1,opt,name=following,proto3" json:"following,omitempty"`
+	Recommend     *Feed_Recommend        `protobuf:"bytes,2,opt,name=recommend,proto3" json:"recommend,omitempty"`
+	Seen          *Feed_Seen             `protobuf:"bytes,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	Hot           *Feed_Hot              `protobuf:"bytes,4,opt,name=hot,proto3" json:"hot,omitempty"`
+	Nearby        *Feed_Nearby           `protobuf:"bytes,5,opt,name=nearby,proto3" json:"nearby,omitempty"`
+	Experiment    *Feed_Experiment       `protobuf:"bytes,6,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Feed) GetFollowing() *Feed_Following {
+	if x != nil {
+		return x.Following
+	}
+	return nil
+}
+
+func (x *Feed) GetRecommend() *Feed_Recommend {
+	if x != nil {
+		return x.Recommend
+	}
+	return nil
+}
+
+func (x *Feed) GetSeen() *Feed_Seen {
+	if x != nil {
+		return x.Seen
+	}
+	return nil
+}
+
+func (x *Feed) GetHot() *Feed_Hot {
+	if x != nil {
+		return x.Hot
+	}
+	return nil
+}
+
+func (x *Feed) GetNearby() *Feed_Nearby {
+	if x != nil {
+		return x.Nearby
+	}
+	return nil
+}
+
+func (x *Feed) GetExperiment() *Feed_Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+// 推荐打分权重，各项特征归一化到 [0, 1] 后加权求和
+type RecommendWeights struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hot           float64                `protobuf:"fixed64,1,opt,name=hot,proto3" json:"hot,omitempty"`
+	Tag           float64                `protobuf:"fixed64,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Author        float64                `protobuf:"fixed64,3,opt,name=author,proto3" json:"author,omitempty"`
+	Fresh         float64                `protobuf:"fixed64,4,opt,name=fresh,proto3" json:"fresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendWeights) Reset() {
+	*x = RecommendWeights{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendWeights) ProtoMessage() {}
+
+func (x *RecommendWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendWeights.ProtoReflect.Descriptor instead.
+func (*RecommendWeights) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecommendWeights) GetHot() float64 {
+	if x != nil {
+		return x.Hot
+	}
+	return 0
+}
+
+func (x *RecommendWeights) GetTag() float64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *RecommendWeights) GetAuthor() float64 {
+	if x != nil {
+		return x.Author
+	}
+	return 0
+}
+
+func (x *RecommendWeights) GetFresh() float64 {
+	if x != nil {
+		return x.Fresh
+	}
+	return 0
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -317,7 +510,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -329,7 +522,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +570,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +582,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +629,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +641,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +683,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +695,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +748,7 @@ type Data_UserService struct {
 
 func (x *Data_UserService) Reset() {
 	*x = Data_UserService{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +760,7 @@ func (x *Data_UserService) String() string {
 func (*Data_UserService) ProtoMessage() {}
 
 func (x *Data_UserService) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +792,7 @@ type Data_VideoService struct {
 
 func (x *Data_VideoService) Reset() {
 	*x = Data_VideoService{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +804,7 @@ func (x *Data_VideoService) String() string {
 func (*Data_VideoService) ProtoMessage() {}
 
 func (x *Data_VideoService) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,29 +827,28 @@ func (x *Data_VideoService) GetEndpoint() string {
 	return ""
 }
 
-type Registry_Consul struct {
+type Data_FavoriteService struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Scheme        string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Registry_Consul) Reset() {
-	*x = Registry_Consul{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+func (x *Data_FavoriteService) Reset() {
+	*x = Data_FavoriteService{}
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Registry_Consul) String() string {
+func (x *Data_FavoriteService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Registry_Consul) ProtoMessage() {}
+func (*Data_FavoriteService) ProtoMessage() {}
 
-func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+func (x *Data_FavoriteService) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,72 +859,896 @@ func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Registry_Consul.ProtoReflect.Descriptor instead.
-func (*Registry_Consul) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use Data_FavoriteService.ProtoReflect.Descriptor instead.
+func (*Data_FavoriteService) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
 }
 
-func (x *Registry_Consul) GetAddr() string {
+func (x *Data_FavoriteService) GetEndpoint() string {
 	if x != nil {
-		return x.Addr
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Registry_Consul) GetScheme() string {
+type Data_RelationService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_RelationService) Reset() {
+	*x = Data_RelationService{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_RelationService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_RelationService) ProtoMessage() {}
+
+func (x *Data_RelationService) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
-		return x.Scheme
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_RelationService.ProtoReflect.Descriptor instead.
+func (*Data_RelationService) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_RelationService) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
 	}
 	return ""
 }
 
-var File_conf_conf_proto protoreflect.FileDescriptor
+// 曝光、点击事件，用于离线对比排序实验
+type Data_Kafka struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Brokers        []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	FeedEventTopic string                 `protobuf:"bytes,2,opt,name=feed_event_topic,json=feedEventTopic,proto3" json:"feed_event_topic,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xbe\x01\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x120\n" +
-	"\bregistry\x18\x03 \x01(\v2\x14.kratos.api.RegistryR\bregistry\x12-\n" +
-	"\aservice\x18\x04 \x01(\v2\x13.kratos.api.ServiceR\aservice\"\xb8\x02\n" +
-	"\x06Server\x12+\n" +
-	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
-	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
-	"\x04GRPC\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb9\x04\n" +
-	"\x04Data\x125\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12?\n" +
-	"\fuser_service\x18\x03 \x01(\v2\x1c.kratos.api.Data.UserServiceR\vuserService\x12B\n" +
-	"\rvideo_service\x18\x04 \x01(\v2\x1d.kratos.api.Data.VideoServiceR\fvideoService\x1a:\n" +
-	"\bDatabase\x12\x16\n" +
-	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
-	"\x05Redis\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
-	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a)\n" +
-	"\vUserService\x12\x1a\n" +
-	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a*\n" +
-	"\fVideoService\x12\x1a\n" +
-	"\bendpoint\x18\x01 \x01(\tR\bendpoint\"u\n" +
-	"\bRegistry\x123\n" +
-	"\x06consul\x18\x01 \x01(\v2\x1b.kratos.api.Registry.ConsulR\x06consul\x1a4\n" +
-	"\x06Consul\x12\x12\n" +
-	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x16\n" +
-	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"7\n" +
-	"\aService\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversionB!Z\x1ffeed-service/internal/conf;confb\x06proto3"
+func (x *Data_Kafka) Reset() {
+	*x = Data_Kafka{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Kafka) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Kafka) ProtoMessage() {}
+
+func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
+func (*Data_Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_Kafka) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Data_Kafka) GetFeedEventTopic() string {
+	if x != nil {
+		return x.FeedEventTopic
+	}
+	return ""
+}
+
+// 进程内缓存，视频卡片与作者信息，修改后通过 redis pub/sub 失效
+type Data_LocalCache struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoSize     int32                  `protobuf:"varint,1,opt,name=video_size,json=videoSize,proto3" json:"video_size,omitempty"`
+	VideoTtl      *durationpb.Duration   `protobuf:"bytes,2,opt,name=video_ttl,json=videoTtl,proto3" json:"video_ttl,omitempty"`
+	AuthorSize    int32                  `protobuf:"varint,3,opt,name=author_size,json=authorSize,proto3" json:"author_size,omitempty"`
+	AuthorTtl     *durationpb.Duration   `protobuf:"bytes,4,opt,name=author_ttl,json=authorTtl,proto3" json:"author_ttl,omitempty"`
+	HotThreshold  int32                  `protobuf:"varint,5,opt,name=hot_threshold,json=hotThreshold,proto3" json:"hot_threshold,omitempty"` // 窗口内访问次数达到该值的视频视为热点，常驻本地，为 0 时不识别
+	HotWindow     *durationpb.Duration   `protobuf:"bytes,6,opt,name=hot_window,json=hotWindow,proto3" json:"hot_window,omitempty"`
+	PinTtl        *durationpb.Duration   `protobuf:"bytes,7,opt,name=pin_ttl,json=pinTtl,proto3" json:"pin_ttl,omitempty"`           // 热点常驻的时间
+	MaxPinned     int32                  `protobuf:"varint,8,opt,name=max_pinned,json=maxPinned,proto3" json:"max_pinned,omitempty"` // 最多常驻的热点数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_LocalCache) Reset() {
+	*x = Data_LocalCache{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_LocalCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_LocalCache) ProtoMessage() {}
+
+func (x *Data_LocalCache) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_LocalCache.ProtoReflect.Descriptor instead.
+func (*Data_LocalCache) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Data_LocalCache) GetVideoSize() int32 {
+	if x != nil {
+		return x.VideoSize
+	}
+	return 0
+}
+
+func (x *Data_LocalCache) GetVideoTtl() *durationpb.Duration {
+	if x != nil {
+		return x.VideoTtl
+	}
+	return nil
+}
+
+func (x *Data_LocalCache) GetAuthorSize() int32 {
+	if x != nil {
+		return x.AuthorSize
+	}
+	return 0
+}
+
+func (x *Data_LocalCache) GetAuthorTtl() *durationpb.Duration {
+	if x != nil {
+		return x.AuthorTtl
+	}
+	return nil
+}
+
+func (x *Data_LocalCache) GetHotThreshold() int32 {
+	if x != nil {
+		return x.HotThreshold
+	}
+	return 0
+}
+
+func (x *Data_LocalCache) GetHotWindow() *durationpb.Duration {
+	if x != nil {
+		return x.HotWindow
+	}
+	return nil
+}
+
+func (x *Data_LocalCache) GetPinTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PinTtl
+	}
+	return nil
+}
+
+func (x *Data_LocalCache) GetMaxPinned() int32 {
+	if x != nil {
+		return x.MaxPinned
+	}
+	return 0
+}
+
+type Registry_Consul struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Scheme        string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry_Consul) Reset() {
+	*x = Registry_Consul{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Consul) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Consul) ProtoMessage() {}
+
+func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Consul.ProtoReflect.Descriptor instead.
+func (*Registry_Consul) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Registry_Consul) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Registry_Consul) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+type Feed_Following struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BigAuthorThreshold int32                  `protobuf:"varint,1,opt,name=big_author_threshold,json=bigAuthorThreshold,proto3" json:"big_author_threshold,omitempty"` // 粉丝数不低于该值的作者不推送，读取时拉取
+	InboxSize          int32                  `protobuf:"varint,2,opt,name=inbox_size,json=inboxSize,proto3" json:"inbox_size,omitempty"`                              // 收件箱最多保留的视频数
+	InboxTtl           *durationpb.Duration   `protobuf:"bytes,3,opt,name=inbox_ttl,json=inboxTtl,proto3" json:"inbox_ttl,omitempty"`                                  // 收件箱过期时间，过期后从数据库重建
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Feed_Following) Reset() {
+	*x = Feed_Following{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Following) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Following) ProtoMessage() {}
+
+func (x *Feed_Following) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Following.ProtoReflect.Descriptor instead.
+func (*Feed_Following) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Feed_Following) GetBigAuthorThreshold() int32 {
+	if x != nil {
+		return x.BigAuthorThreshold
+	}
+	return 0
+}
+
+func (x *Feed_Following) GetInboxSize() int32 {
+	if x != nil {
+		return x.InboxSize
+	}
+	return 0
+}
+
+func (x *Feed_Following) GetInboxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.InboxTtl
+	}
+	return nil
+}
+
+// 个性化推荐，登录用户按兴趣画像从多路候选中打分排序
+type Feed_Recommend struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HotCandidates    int32                  `protobuf:"varint,1,opt,name=hot_candidates,json=hotCandidates,proto3" json:"hot_candidates,omitempty"`          // 热榜候选数
+	TagCandidates    int32                  `protobuf:"varint,2,opt,name=tag_candidates,json=tagCandidates,proto3" json:"tag_candidates,omitempty"`          // 每个偏好话题的候选数
+	AuthorCandidates int32                  `protobuf:"varint,3,opt,name=author_candidates,json=authorCandidates,proto3" json:"author_candidates,omitempty"` // 偏好作者与关注作者的候选总数
+	FreshCandidates  int32                  `protobuf:"varint,4,opt,name=fresh_candidates,json=freshCandidates,proto3" json:"fresh_candidates,omitempty"`    // 新发布视频的候选数
+	FreshWindow      *durationpb.Duration   `protobuf:"bytes,5,opt,name=fresh_window,json=freshWindow,proto3" json:"fresh_window,omitempty"`                 // 新发布视频的时间窗口
+	TopTags          int32                  `protobuf:"varint,6,opt,name=top_tags,json=topTags,proto3" json:"top_tags,omitempty"`                            // 参与召回的偏好话题数
+	TopAuthors       int32                  `protobuf:"varint,7,opt,name=top_authors,json=topAuthors,proto3" json:"top_authors,omitempty"`                   // 参与召回的偏好作者数
+	MaxPerAuthor     int32                  `protobuf:"varint,8,opt,name=max_per_author,json=maxPerAuthor,proto3" json:"max_per_author,omitempty"`           // 每页同一作者最多出现的次数
+	MaxSize          int32                  `protobuf:"varint,9,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`                            // 推荐列表最多保留的视频数
+	Weights          *RecommendWeights      `protobuf:"bytes,10,opt,name=weights,proto3" json:"weights,omitempty"`
+	FreshHalfLife    *durationpb.Duration   `protobuf:"bytes,11,opt,name=fresh_half_life,json=freshHalfLife,proto3" json:"fresh_half_life,omitempty"`        // 新鲜度半衰期
+	OverFetchRounds  int32                  `protobuf:"varint,12,opt,name=over_fetch_rounds,json=overFetchRounds,proto3" json:"over_fetch_rounds,omitempty"` // 热榜候选被已看过滤后不足时最多多取的轮数
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Feed_Recommend) Reset() {
+	*x = Feed_Recommend{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Recommend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Recommend) ProtoMessage() {}
+
+func (x *Feed_Recommend) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Recommend.ProtoReflect.Descriptor instead.
+func (*Feed_Recommend) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *Feed_Recommend) GetHotCandidates() int32 {
+	if x != nil {
+		return x.HotCandidates
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetTagCandidates() int32 {
+	if x != nil {
+		return x.TagCandidates
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetAuthorCandidates() int32 {
+	if x != nil {
+		return x.AuthorCandidates
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetFreshCandidates() int32 {
+	if x != nil {
+		return x.FreshCandidates
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetFreshWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FreshWindow
+	}
+	return nil
+}
+
+func (x *Feed_Recommend) GetTopTags() int32 {
+	if x != nil {
+		return x.TopTags
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetTopAuthors() int32 {
+	if x != nil {
+		return x.TopAuthors
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetMaxPerAuthor() int32 {
+	if x != nil {
+		return x.MaxPerAuthor
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetMaxSize() int32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *Feed_Recommend) GetWeights() *RecommendWeights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Feed_Recommend) GetFreshHalfLife() *durationpb.Duration {
+	if x != nil {
+		return x.FreshHalfLife
+	}
+	return nil
+}
+
+func (x *Feed_Recommend) GetOverFetchRounds() int32 {
+	if x != nil {
+		return x.OverFetchRounds
+	}
+	return 0
+}
+
+// 已看过滤，按时间窗口分代的布隆过滤器，看过的视频在一到两个窗口内不再推荐
+type Feed_Seen struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Bits          int64                  `protobuf:"varint,2,opt,name=bits,proto3" json:"bits,omitempty"`     // 每代的位数，容量约为 bits / 10 时误判率约 1%
+	Hashes        int32                  `protobuf:"varint,3,opt,name=hashes,proto3" json:"hashes,omitempty"` // 哈希函数个数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed_Seen) Reset() {
+	*x = Feed_Seen{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Seen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Seen) ProtoMessage() {}
+
+func (x *Feed_Seen) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Seen.ProtoReflect.Descriptor instead.
+func (*Feed_Seen) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *Feed_Seen) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Feed_Seen) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *Feed_Seen) GetHashes() int32 {
+	if x != nil {
+		return x.Hashes
+	}
+	return 0
+}
+
+// 热榜分页，会话开始时复制一份热榜快照，翻页期间排名不变
+type Feed_Hot struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotSize     int64                  `protobuf:"varint,1,opt,name=snapshot_size,json=snapshotSize,proto3" json:"snapshot_size,omitempty"`            // 快照保留的视频数
+	SnapshotTtl      *durationpb.Duration   `protobuf:"bytes,2,opt,name=snapshot_ttl,json=snapshotTtl,proto3" json:"snapshot_ttl,omitempty"`                // 快照过期时间，过期后从当前时间段的快照继续
+	SnapshotInterval *durationpb.Duration   `protobuf:"bytes,5,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"` // 快照分桶间隔，同一时间段的游客共用一份快照
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Feed_Hot) Reset() {
+	*x = Feed_Hot{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Hot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Hot) ProtoMessage() {}
+
+func (x *Feed_Hot) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Hot.ProtoReflect.Descriptor instead.
+func (*Feed_Hot) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *Feed_Hot) GetSnapshotSize() int64 {
+	if x != nil {
+		return x.SnapshotSize
+	}
+	return 0
+}
+
+func (x *Feed_Hot) GetSnapshotTtl() *durationpb.Duration {
+	if x != nil {
+		return x.SnapshotTtl
+	}
+	return nil
+}
+
+func (x *Feed_Hot) GetSnapshotInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return nil
+}
+
+// 附近、同城视频，候选按热度分排序
+type Feed_Nearby struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxCandidates   int32                  `protobuf:"varint,1,opt,name=max_candidates,json=maxCandidates,proto3" json:"max_candidates,omitempty"`          // 每次请求最多取的候选数
+	DefaultRadiusKm float64                `protobuf:"fixed64,2,opt,name=default_radius_km,json=defaultRadiusKm,proto3" json:"default_radius_km,omitempty"` // 未传半径时的默认半径
+	MaxRadiusKm     float64                `protobuf:"fixed64,3,opt,name=max_radius_km,json=maxRadiusKm,proto3" json:"max_radius_km,omitempty"`             // 半径上限
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Feed_Nearby) Reset() {
+	*x = Feed_Nearby{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Nearby) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Nearby) ProtoMessage() {}
+
+func (x *Feed_Nearby) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Nearby.ProtoReflect.Descriptor instead.
+func (*Feed_Nearby) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 4}
+}
+
+func (x *Feed_Nearby) GetMaxCandidates() int32 {
+	if x != nil {
+		return x.MaxCandidates
+	}
+	return 0
+}
+
+func (x *Feed_Nearby) GetDefaultRadiusKm() float64 {
+	if x != nil {
+		return x.DefaultRadiusKm
+	}
+	return 0
+}
+
+func (x *Feed_Nearby) GetMaxRadiusKm() float64 {
+	if x != nil {
+		return x.MaxRadiusKm
+	}
+	return 0
+}
+
+// 排序实验，登录用户按 uid 哈希分桶选择推荐策略，修改后热加载
+type Feed_Experiment struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Salt          string                     `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`                                      // 分桶盐，更换后重新分桶
+	TotalBuckets  int32                      `protobuf:"varint,2,opt,name=total_buckets,json=totalBuckets,proto3" json:"total_buckets,omitempty"` // 总桶数，未分配给实验的桶为对照组
+	Variants      []*Feed_Experiment_Variant `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed_Experiment) Reset() {
+	*x = Feed_Experiment{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Experiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Experiment) ProtoMessage() {}
+
+func (x *Feed_Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Experiment.ProtoReflect.Descriptor instead.
+func (*Feed_Experiment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 5}
+}
+
+func (x *Feed_Experiment) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *Feed_Experiment) GetTotalBuckets() int32 {
+	if x != nil {
+		return x.TotalBuckets
+	}
+	return 0
+}
+
+func (x *Feed_Experiment) GetVariants() []*Feed_Experiment_Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type Feed_Experiment_Variant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`               // 实验id，写入响应与曝光、点击事件
+	Buckets       int32                  `protobuf:"varint,2,opt,name=buckets,proto3" json:"buckets,omitempty"`    // 占用的桶数，按配置顺序依次分配
+	Recommend     *Feed_Recommend        `protobuf:"bytes,3,opt,name=recommend,proto3" json:"recommend,omitempty"` // 覆盖 recommend 中的非零项，weights 整体替换
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed_Experiment_Variant) Reset() {
+	*x = Feed_Experiment_Variant{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed_Experiment_Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed_Experiment_Variant) ProtoMessage() {}
+
+func (x *Feed_Experiment_Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed_Experiment_Variant.ProtoReflect.Descriptor instead.
+func (*Feed_Experiment_Variant) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 5, 0}
+}
+
+func (x *Feed_Experiment_Variant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Feed_Experiment_Variant) GetBuckets() int32 {
+	if x != nil {
+		return x.Buckets
+	}
+	return 0
+}
+
+func (x *Feed_Experiment_Variant) GetRecommend() *Feed_Recommend {
+	if x != nil {
+		return x.Recommend
+	}
+	return nil
+}
+
+var File_conf_conf_proto protoreflect.FileDescriptor
+
+const file_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x0fconf/conf.proto\x12\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xe4\x01\n" +
+	"\tBootstrap\x12*\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x120\n" +
+	"\bregistry\x18\x03 \x01(\v2\x14.kratos.api.RegistryR\bregistry\x12-\n" +
+	"\aservice\x18\x04 \x01(\v2\x13.kratos.api.ServiceR\aservice\x12$\n" +
+	"\x04feed\x18\x05 \x01(\v2\x10.kratos.api.FeedR\x04feed\"\xb8\x02\n" +
+	"\x06Server\x12+\n" +
+	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe3\n" +
+	"\n" +
+	"\x04Data\x125\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12?\n" +
+	"\fuser_service\x18\x03 \x01(\v2\x1c.kratos.api.Data.UserServiceR\vuserService\x12B\n" +
+	"\rvideo_service\x18\x04 \x01(\v2\x1d.kratos.api.Data.VideoServiceR\fvideoService\x12K\n" +
+	"\x10favorite_service\x18\x05 \x01(\v2 .kratos.api.Data.FavoriteServiceR\x0ffavoriteService\x12K\n" +
+	"\x10relation_service\x18\x06 \x01(\v2 .kratos.api.Data.RelationServiceR\x0frelationService\x12,\n" +
+	"\x05kafka\x18\b \x01(\v2\x16.kratos.api.Data.KafkaR\x05kafka\x12<\n" +
+	"\vlocal_cache\x18\t \x01(\v2\x1b.kratos.api.Data.LocalCacheR\n" +
+	"localCache\x1a:\n" +
+	"\bDatabase\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
+	"\x05Redis\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
+	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a)\n" +
+	"\vUserService\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a*\n" +
+	"\fVideoService\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a-\n" +
+	"\x0fFavoriteService\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a-\n" +
+	"\x0fRelationService\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1aK\n" +
+	"\x05Kafka\x12\x18\n" +
+	"\abrokers\x18\x01 \x03(\tR\abrokers\x12(\n" +
+	"\x10feed_event_topic\x18\x02 \x01(\tR\x0efeedEventTopic\x1a\xf0\x02\n" +
+	"\n" +
+	"LocalCache\x12\x1d\n" +
+	"\n" +
+	"video_size\x18\x01 \x01(\x05R\tvideoSize\x126\n" +
+	"\tvideo_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bvideoTtl\x12\x1f\n" +
+	"\vauthor_size\x18\x03 \x01(\x05R\n" +
+	"authorSize\x128\n" +
+	"\n" +
+	"author_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tauthorTtl\x12#\n" +
+	"\rhot_threshold\x18\x05 \x01(\x05R\fhotThreshold\x128\n" +
+	"\n" +
+	"hot_window\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\thotWindow\x122\n" +
+	"\apin_ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\x06pinTtl\x12\x1d\n" +
+	"\n" +
+	"max_pinned\x18\b \x01(\x05R\tmaxPinnedJ\x04\b\a\x10\b\"u\n" +
+	"\bRegistry\x123\n" +
+	"\x06consul\x18\x01 \x01(\v2\x1b.kratos.api.Registry.ConsulR\x06consul\x1a4\n" +
+	"\x06Consul\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"7\n" +
+	"\aService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\x87\r\n" +
+	"\x04Feed\x128\n" +
+	"\tfollowing\x18\x01 \x01(\v2\x1a.kratos.api.Feed.FollowingR\tfollowing\x128\n" +
+	"\trecommend\x18\x02 \x01(\v2\x1a.kratos.api.Feed.RecommendR\trecommend\x12)\n" +
+	"\x04seen\x18\x03 \x01(\v2\x15.kratos.api.Feed.SeenR\x04seen\x12&\n" +
+	"\x03hot\x18\x04 \x01(\v2\x14.kratos.api.Feed.HotR\x03hot\x12/\n" +
+	"\x06nearby\x18\x05 \x01(\v2\x17.kratos.api.Feed.NearbyR\x06nearby\x12;\n" +
+	"\n" +
+	"experiment\x18\x06 \x01(\v2\x1b.kratos.api.Feed.ExperimentR\n" +
+	"experiment\x1a\x94\x01\n" +
+	"\tFollowing\x120\n" +
+	"\x14big_author_threshold\x18\x01 \x01(\x05R\x12bigAuthorThreshold\x12\x1d\n" +
+	"\n" +
+	"inbox_size\x18\x02 \x01(\x05R\tinboxSize\x126\n" +
+	"\tinbox_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binboxTtl\x1a\x93\x04\n" +
+	"\tRecommend\x12%\n" +
+	"\x0ehot_candidates\x18\x01 \x01(\x05R\rhotCandidates\x12%\n" +
+	"\x0etag_candidates\x18\x02 \x01(\x05R\rtagCandidates\x12+\n" +
+	"\x11author_candidates\x18\x03 \x01(\x05R\x10authorCandidates\x12)\n" +
+	"\x10fresh_candidates\x18\x04 \x01(\x05R\x0ffreshCandidates\x12<\n" +
+	"\ffresh_window\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vfreshWindow\x12\x19\n" +
+	"\btop_tags\x18\x06 \x01(\x05R\atopTags\x12\x1f\n" +
+	"\vtop_authors\x18\a \x01(\x05R\n" +
+	"topAuthors\x12$\n" +
+	"\x0emax_per_author\x18\b \x01(\x05R\fmaxPerAuthor\x12\x19\n" +
+	"\bmax_size\x18\t \x01(\x05R\amaxSize\x126\n" +
+	"\aweights\x18\n" +
+	" \x01(\v2\x1c.kratos.api.RecommendWeightsR\aweights\x12A\n" +
+	"\x0ffresh_half_life\x18\v \x01(\v2\x19.google.protobuf.DurationR\rfreshHalfLife\x12*\n" +
+	"\x11over_fetch_rounds\x18\f \x01(\x05R\x0foverFetchRounds\x1ae\n" +
+	"\x04Seen\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12\x12\n" +
+	"\x04bits\x18\x02 \x01(\x03R\x04bits\x12\x16\n" +
+	"\x06hashes\x18\x03 \x01(\x05R\x06hashes\x1a\xbc\x01\n" +
+	"\x03Hot\x12#\n" +
+	"\rsnapshot_size\x18\x01 \x01(\x03R\fsnapshotSize\x12<\n" +
+	"\fsnapshot_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vsnapshotTtl\x12F\n" +
+	"\x11snapshot_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x10snapshotIntervalJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\x1a\x7f\n" +
+	"\x06Nearby\x12%\n" +
+	"\x0emax_candidates\x18\x01 \x01(\x05R\rmaxCandidates\x12*\n" +
+	"\x11default_radius_km\x18\x02 \x01(\x01R\x0fdefaultRadiusKm\x12\"\n" +
+	"\rmax_radius_km\x18\x03 \x01(\x01R\vmaxRadiusKm\x1a\xf5\x01\n" +
+	"\n" +
+	"Experiment\x12\x12\n" +
+	"\x04salt\x18\x01 \x01(\tR\x04salt\x12#\n" +
+	"\rtotal_buckets\x18\x02 \x01(\x05R\ftotalBuckets\x12?\n" +
+	"\bvariants\x18\x03 \x03(\v2#.kratos.api.Feed.Experiment.VariantR\bvariants\x1am\n" +
+	"\aVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\abuckets\x18\x02 \x01(\x05R\abuckets\x128\n" +
+	"\trecommend\x18\x03 \x01(\v2\x1a.kratos.api.Feed.RecommendR\trecommend\"d\n" +
+	"\x10RecommendWeights\x12\x10\n" +
+	"\x03hot\x18\x01 \x01(\x01R\x03hot\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\x01R\x03tag\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\x01R\x06author\x12\x14\n" +
+	"\x05fresh\x18\x04 \x01(\x01R\x05freshB!Z\x1ffeed-service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -746,43 +1762,80 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Registry)(nil),            // 3: kratos.api.Registry
-	(*Service)(nil),             // 4: kratos.api.Service
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*Data_UserService)(nil),    // 9: kratos.api.Data.UserService
-	(*Data_VideoService)(nil),   // 10: kratos.api.Data.VideoService
-	(*Registry_Consul)(nil),     // 11: kratos.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Bootstrap)(nil),               // 0: kratos.api.Bootstrap
+	(*Server)(nil),                  // 1: kratos.api.Server
+	(*Data)(nil),                    // 2: kratos.api.Data
+	(*Registry)(nil),                // 3: kratos.api.Registry
+	(*Service)(nil),                 // 4: kratos.api.Service
+	(*Feed)(nil),                    // 5: kratos.api.Feed
+	(*RecommendWeights)(nil),        // 6: kratos.api.RecommendWeights
+	(*Server_HTTP)(nil),             // 7: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),             // 8: kratos.api.Server.GRPC
+	(*Data_Database)(nil),           // 9: kratos.api.Data.Database
+	(*Data_Redis)(nil),              // 10: kratos.api.Data.Redis
+	(*Data_UserService)(nil),        // 11: kratos.api.Data.UserService
+	(*Data_VideoService)(nil),       // 12: kratos.api.Data.VideoService
+	(*Data_FavoriteService)(nil),    // 13: kratos.api.Data.FavoriteService
+	(*Data_RelationService)(nil),    // 14: kratos.api.Data.RelationService
+	(*Data_Kafka)(nil),              // 15: kratos.api.Data.Kafka
+	(*Data_LocalCache)(nil),         // 16: kratos.api.Data.LocalCache
+	(*Registry_Consul)(nil),         // 17: kratos.api.Registry.Consul
+	(*Feed_Following)(nil),          // 18: kratos.api.Feed.Following
+	(*Feed_Recommend)(nil),          // 19: kratos.api.Feed.Recommend
+	(*Feed_Seen)(nil),               // 20: kratos.api.Feed.Seen
+	(*Feed_Hot)(nil),                // 21: kratos.api.Feed.Hot
+	(*Feed_Nearby)(nil),             // 22: kratos.api.Feed.Nearby
+	(*Feed_Experiment)(nil),         // 23: kratos.api.Feed.Experiment
+	(*Feed_Experiment_Variant)(nil), // 24: kratos.api.Feed.Experiment.Variant
+	(*durationpb.Duration)(nil),     // 25: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.registry:type_name -> kratos.api.Registry
 	4,  // 3: kratos.api.Bootstrap.service:type_name -> kratos.api.Service
-	5,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	9,  // 8: kratos.api.Data.user_service:type_name -> kratos.api.Data.UserService
-	10, // 9: kratos.api.Data.video_service:type_name -> kratos.api.Data.VideoService
-	11, // 10: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	12, // 11: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 12: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 13: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	12, // 14: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	5,  // 4: kratos.api.Bootstrap.feed:type_name -> kratos.api.Feed
+	7,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	8,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	9,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	10, // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	11, // 9: kratos.api.Data.user_service:type_name -> kratos.api.Data.UserService
+	12, // 10: kratos.api.Data.video_service:type_name -> kratos.api.Data.VideoService
+	13, // 11: kratos.api.Data.favorite_service:type_name -> kratos.api.Data.FavoriteService
+	14, // 12: kratos.api.Data.relation_service:type_name -> kratos.api.Data.RelationService
+	15, // 13: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
+	16, // 14: kratos.api.Data.local_cache:type_name -> kratos.api.Data.LocalCache
+	17, // 15: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
+	18, // 16: kratos.api.Feed.following:type_name -> kratos.api.Feed.Following
+	19, // 17: kratos.api.Feed.recommend:type_name -> kratos.api.Feed.Recommend
+	20, // 18: kratos.api.Feed.seen:type_name -> kratos.api.Feed.Seen
+	21, // 19: kratos.api.Feed.hot:type_name -> kratos.api.Feed.Hot
+	22, // 20: kratos.api.Feed.nearby:type_name -> kratos.api.Feed.Nearby
+	23, // 21: kratos.api.Feed.experiment:type_name -> kratos.api.Feed.Experiment
+	25, // 22: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	25, // 23: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	25, // 24: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	25, // 25: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	25, // 26: kratos.api.Data.LocalCache.video_ttl:type_name -> google.protobuf.Duration
+	25, // 27: kratos.api.Data.LocalCache.author_ttl:type_name -> google.protobuf.Duration
+	25, // 28: kratos.api.Data.LocalCache.hot_window:type_name -> google.protobuf.Duration
+	25, // 29: kratos.api.Data.LocalCache.pin_ttl:type_name -> google.protobuf.Duration
+	25, // 30: kratos.api.Feed.Following.inbox_ttl:type_name -> google.protobuf.Duration
+	25, // 31: kratos.api.Feed.Recommend.fresh_window:type_name -> google.protobuf.Duration
+	6,  // 32: kratos.api.Feed.Recommend.weights:type_name -> kratos.api.RecommendWeights
+	25, // 33: kratos.api.Feed.Recommend.fresh_half_life:type_name -> google.protobuf.Duration
+	25, // 34: kratos.api.Feed.Seen.window:type_name -> google.protobuf.Duration
+	25, // 35: kratos.api.Feed.Hot.snapshot_ttl:type_name -> google.protobuf.Duration
+	25, // 36: kratos.api.Feed.Hot.snapshot_interval:type_name -> google.protobuf.Duration
+	24, // 37: kratos.api.Feed.Experiment.variants:type_name -> kratos.api.Feed.Experiment.Variant
+	19, // 38: kratos.api.Feed.Experiment.Variant.recommend:type_name -> kratos.api.Feed.Recommend
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -796,7 +1849,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
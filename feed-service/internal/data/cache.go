@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	pbUser "feed-service/api/user/v1"
+	"feed-service/internal/conf"
+	"feed-service/internal/data/query"
+	"feed-service/internal/pkg/constants"
+	"feed-service/internal/pkg/localcache"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// videoCard 本地缓存的视频卡片，点赞数、评论数以计数缓存为准，这里只作为读取失败时的兜底
+type videoCard struct {
+	ID           int64
+	Title        string
+	CoverURL     string
+	AuthorID     int64
+	LikeCount    int64
+	CommentCount int64
+	PublishTime  time.Time
+}
+
+// authorCard 本地缓存的作者信息
+type authorCard struct {
+	ID        int64
+	Name      string
+	AvatarURL string
+}
+
+// videoCardLoader 回源已发布、公开且未删除的视频
+func videoCardLoader(q *query.Query) localcache.Loader[*videoCard] {
+	return func(ctx context.Context, ids []int64) (map[int64]*videoCard, error) {
+		v := q.Video
+		videos, err := v.WithContext(ctx).
+			Select(v.ID, v.Title, v.CoverURL, v.UserID, v.FavoriteCnt, v.CommentCnt, v.CreatedAt).
+			Where(v.ID.In(ids...), v.PublishStatus.Eq(constants.PublishStatusPublished), v.IsPublic.Is(true), v.DeleteAt.IsNull()).
+			Find()
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[int64]*videoCard, len(videos))
+		for _, video := range videos {
+			res[video.ID] = &videoCard{
+				ID:           video.ID,
+				Title:        video.Title,
+				CoverURL:     video.CoverURL,
+				AuthorID:     video.UserID,
+				LikeCount:    int64(video.FavoriteCnt),
+				CommentCount: int64(video.CommentCnt),
+				PublishTime:  video.CreatedAt,
+			}
+		}
+		return res, nil
+	}
+}
+
+// authorCardLoader 回源 user-service
+func authorCardLoader(cu pbUser.UserServiceClient) localcache.Loader[*authorCard] {
+	return func(ctx context.Context, ids []int64) (map[int64]*authorCard, error) {
+		resp, err := cu.BatchGetUserInfo(ctx, &pbUser.BatchGetUserInfoRequest{AuthorIds: ids})
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[int64]*authorCard, len(resp.Users))
+		for _, u := range resp.Users {
+			res[u.Id] = &authorCard{ID: u.Id, Name: u.Name, AvatarURL: u.AvatarUrl}
+		}
+		return res, nil
+	}
+}
+
+// localCacheConfigs 视频卡片与作者信息的缓存配置，热点识别共用一套
+func localCacheConfigs(c *conf.Data_LocalCache) (videos, authors localcache.Config) {
+	videos = localcache.Config{
+		Size:         int(c.GetVideoSize()),
+		TTL:          c.GetVideoTtl().AsDuration(),
+		HotThreshold: int(c.GetHotThreshold()),
+		HotWindow:    c.GetHotWindow().AsDuration(),
+		PinTTL:       c.GetPinTtl().AsDuration(),
+		MaxPinned:    int(c.GetMaxPinned()),
+	}
+	authors = videos
+	authors.Size, authors.TTL = int(c.GetAuthorSize()), c.GetAuthorTtl().AsDuration()
+	return videos, authors
+}
+
+// NewCacheSubscriber 订阅缓存失效消息，删除本地的视频卡片与作者信息
+func NewCacheSubscriber(d *Data, logger log.Logger) *localcache.Subscriber {
+	return localcache.NewSubscriber(d.rdb, logger, d.videos, d.authors)
+}
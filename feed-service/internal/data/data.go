@@ -1,6 +1,7 @@
 package data
 
 import (
+	"common/invalidate"
 	"context"
 	"errors"
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
@@ -8,22 +9,28 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/hashicorp/consul/api"
 	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 
+	"common/counter"
+	pbFavorite "feed-service/api/favorite/v1"
+	pbRelation "feed-service/api/relation/v1"
 	pbUser "feed-service/api/user/v1"
 	pbVideo "feed-service/api/video/v1"
 	"feed-service/internal/conf"
 	"feed-service/internal/data/query"
+	"feed-service/internal/pkg/localcache"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewFeedRepo, NewDB, NewRedisClient, NewDiscover, NewUserServiceClient, NewVideoServiceClient)
+var ProviderSet = wire.NewSet(NewData, NewFeedRepo, NewEventRepo, NewDB, NewRedisClient, NewDiscover, NewUserServiceClient, NewVideoServiceClient, NewFavoriteServiceClient, NewRelationServiceClient, NewFeedEventWriter, NewCacheSubscriber)
 
 // Data .
 type Data struct {
@@ -33,18 +40,49 @@ type Data struct {
 	rdb   *redis.Client
 	query *query.Query
 
-	UserClient  pbUser.UserServiceClient
-	VideoClient pbVideo.VideoServiceClient
+	// 视频点赞数、评论数缓存，只读，由 favorite-service、comment-service 回填
+	likes    *counter.Counter
+	comments *counter.Counter
+
+	// 进程内的视频卡片与作者信息缓存
+	videos  *localcache.Cache[*videoCard]
+	authors *localcache.Cache[*authorCard]
+
+	// 曝光、点击事件
+	eventWriter *kafka.Writer
+
+	UserClient     pbUser.UserServiceClient
+	VideoClient    pbVideo.VideoServiceClient
+	FavoriteClient pbFavorite.FavoriteServiceClient
+	RelationClient pbRelation.RelationServiceClient
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, cu pbUser.UserServiceClient, cv pbVideo.VideoServiceClient) (*Data, func(), error) {
+func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, cu pbUser.UserServiceClient, cv pbVideo.VideoServiceClient, cf pbFavorite.FavoriteServiceClient, cr pbRelation.RelationServiceClient, ew *kafka.Writer) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := ew.Close(); err != nil {
+			log.NewHelper(logger).Errorf("close feed event writer failed: %v", err)
+		}
 	}
 	query.SetDefault(db)
 
-	return &Data{log: log.NewHelper(logger), db: db, rdb: rdb, query: query.Q, UserClient: cu, VideoClient: cv}, cleanup, nil
+	videoCfg, authorCfg := localCacheConfigs(c.GetLocalCache())
+	return &Data{
+		log:            log.NewHelper(logger),
+		db:             db,
+		rdb:            rdb,
+		query:          query.Q,
+		likes:          counter.NewReader(rdb, counter.VideoLike),
+		comments:       counter.NewReader(rdb, counter.VideoComment),
+		videos:         localcache.New(invalidate.KindVideo, videoCfg, videoCardLoader(query.Q)),
+		authors:        localcache.New(invalidate.KindAuthor, authorCfg, authorCardLoader(cu)),
+		eventWriter:    ew,
+		UserClient:     cu,
+		VideoClient:    cv,
+		FavoriteClient: cf,
+		RelationClient: cr,
+	}, cleanup, nil
 }
 
 // NewDB 数据库连接
@@ -58,6 +96,17 @@ func NewDB(cfg *conf.Data) (*gorm.DB, error) {
 	return nil, errors.New("connect db failed unsuppoesd db driver")
 }
 
+// NewFeedEventWriter 曝光、点击事件 kafka 生产者，异步批量发送
+func NewFeedEventWriter(cfg *conf.Data) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(cfg.GetKafka().GetBrokers()...),
+		Topic:        cfg.GetKafka().GetFeedEventTopic(),
+		Balancer:     &kafka.Hash{},
+		BatchTimeout: 50 * time.Millisecond,
+		Async:        true,
+	}
+}
+
 // NewRedisClient 连接redis
 func NewRedisClient(cfg *conf.Data) *redis.Client {
 	return redis.NewClient(&redis.Options{
@@ -107,3 +156,27 @@ func NewVideoServiceClient(c *conf.Data, rr registry.Discovery) pbVideo.VideoSer
 	}
 	return pbVideo.NewVideoServiceClient(conn)
 }
+
+func NewFavoriteServiceClient(c *conf.Data, rr registry.Discovery) pbFavorite.FavoriteServiceClient {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(c.FavoriteService.Endpoint),
+		grpc.WithDiscovery(rr),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return pbFavorite.NewFavoriteServiceClient(conn)
+}
+
+func NewRelationServiceClient(c *conf.Data, rr registry.Discovery) pbRelation.RelationServiceClient {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(c.RelationService.Endpoint),
+		grpc.WithDiscovery(rr),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return pbRelation.NewRelationServiceClient(conn)
+}
@@ -0,0 +1,36 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"feed-service/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+)
+
+type eventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEventRepo .
+func NewEventRepo(data *Data, logger log.Logger) biz.EventRepo {
+	return &eventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SendFeedEvent 写入曝光、点击事件，按用户id分区保证同一用户的事件有序，游客的事件不指定 key 轮询分区
+func (r *eventRepo) SendFeedEvent(ctx context.Context, event *biz.FeedEvent) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	msg := kafka.Message{Value: value}
+	if event.UserID != 0 {
+		msg.Key = []byte(strconv.FormatInt(event.UserID, 10))
+	}
+	return r.data.eventWriter.WriteMessages(ctx, msg)
+}
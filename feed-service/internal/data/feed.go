@@ -1,16 +1,19 @@
 package data
 
 import (
+	"common/rediskey"
 	"context"
+	pbFavorite "feed-service/api/favorite/v1"
 	v1 "feed-service/api/feed/v1"
+	pbRelation "feed-service/api/relation/v1"
 	pbUser "feed-service/api/user/v1"
-	pbVideo "feed-service/api/video/v1"
-	"fmt"
-	"github.com/redis/go-redis/v9"
+	"gorm.io/gen/field"
 	"strconv"
 	"time"
 
 	"feed-service/internal/biz"
+	"feed-service/internal/conf"
+	"feed-service/internal/pkg/constants"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -18,31 +21,89 @@ import (
 type feedRepo struct {
 	data *Data
 	log  *log.Helper
+
+	// 关注流配置
+	bigAuthorThreshold int32
+	inboxSize          int
+	inboxTTL           time.Duration
+
+	// 已看过滤配置
+	seenWindow time.Duration
+	seenBits   uint64
+	seenHashes int
+
+	// 热榜快照配置
+	hotSnapshotSize     int64
+	hotSnapshotTTL      time.Duration
+	hotSnapshotInterval time.Duration
 }
 
 // NewGreeterRepo .
-func NewFeedRepo(data *Data, logger log.Logger) biz.FeedRepo {
-	return &feedRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+func NewFeedRepo(data *Data, c *conf.Feed, logger log.Logger) biz.FeedRepo {
+	r := &feedRepo{
+		data:                data,
+		log:                 log.NewHelper(logger),
+		bigAuthorThreshold:  constants.DefaultBigAuthorThreshold,
+		inboxSize:           constants.DefaultInboxSize,
+		inboxTTL:            constants.DefaultInboxTTL,
+		seenWindow:          constants.DefaultSeenWindow,
+		seenBits:            constants.DefaultSeenBits,
+		seenHashes:          constants.DefaultSeenHashes,
+		hotSnapshotSize:     constants.DefaultHotSnapshotSize,
+		hotSnapshotTTL:      constants.DefaultHotSnapshotTTL,
+		hotSnapshotInterval: constants.DefaultHotSnapshotInterval,
+	}
+	if fc := c.GetFollowing(); fc != nil {
+		if fc.GetBigAuthorThreshold() > 0 {
+			r.bigAuthorThreshold = fc.GetBigAuthorThreshold()
+		}
+		if fc.GetInboxSize() > 0 {
+			r.inboxSize = int(fc.GetInboxSize())
+		}
+		if fc.GetInboxTtl().AsDuration() > 0 {
+			r.inboxTTL = fc.GetInboxTtl().AsDuration()
+		}
+	}
+	if sc := c.GetSeen(); sc != nil {
+		if sc.GetWindow().AsDuration() > 0 {
+			r.seenWindow = sc.GetWindow().AsDuration()
+		}
+		if sc.GetBits() > 0 {
+			r.seenBits = uint64(sc.GetBits())
+		}
+		if sc.GetHashes() > 0 {
+			r.seenHashes = int(sc.GetHashes())
+		}
+	}
+	if hc := c.GetHot(); hc != nil {
+		if hc.GetSnapshotSize() > 0 {
+			r.hotSnapshotSize = hc.GetSnapshotSize()
+		}
+		if hc.GetSnapshotTtl().AsDuration() > 0 {
+			r.hotSnapshotTTL = hc.GetSnapshotTtl().AsDuration()
+		}
+		if hc.GetSnapshotInterval().AsDuration() > 0 {
+			r.hotSnapshotInterval = hc.GetSnapshotInterval().AsDuration()
+		}
 	}
+	return r
 }
 
-// GetFeedVideoList 获取视频流
-func (r *feedRepo) GetFeedVideoList(ctx context.Context, lastTime int64, limit int) ([]*v1.Video, error) {
-
-	queryTime := time.Unix(lastTime, 0)
-	videos, err := r.data.query.Video.
-		WithContext(ctx).
-		Where(r.data.query.Video.CreatedAt.Lte(queryTime)).
-		Order(r.data.query.Video.CreatedAt.Desc()).
-		Limit(limit).
-		Find()
+// GetFeedVideoList 按发布时间、id 倒序获取游标之后已发布的公开视频，热榜缺失时兜底
+func (r *feedRepo) GetFeedVideoList(ctx context.Context, cursor *biz.FeedCursor, limit int) ([]*v1.Video, error) {
+	v := r.data.query.Video
+	do := v.WithContext(ctx).
+		Where(v.PublishStatus.Eq(constants.PublishStatusPublished), v.IsPublic.Is(true), v.DeleteAt.IsNull())
+	if cursor != nil {
+		t := time.UnixMilli(cursor.PublishTime)
+		do = do.Where(field.Or(v.CreatedAt.Lt(t), field.And(v.CreatedAt.Eq(t), v.ID.Lt(cursor.VideoID))))
+	}
+	videos, err := do.Order(v.CreatedAt.Desc(), v.ID.Desc()).Limit(limit).Find()
 	if err != nil {
 		return nil, err
 	}
 
-	var results []*v1.Video
+	results := make([]*v1.Video, 0, len(videos))
 	for _, video := range videos {
 		results = append(results, &v1.Video{
 			VideoId:      video.ID,
@@ -52,7 +113,6 @@ func (r *feedRepo) GetFeedVideoList(ctx context.Context, lastTime int64, limit i
 			LikeCount:    int64(video.FavoriteCnt),
 			CommentCount: int64(video.CommentCnt),
 			PublishTime:  video.CreatedAt.Unix(),
-			// TODO 当前用户是否点赞
 		})
 	}
 
@@ -73,67 +133,71 @@ func (r *feedRepo) ParesToken(ctx context.Context, token string, refreshToken st
 
 // BatchGetUserInfo 批量获取作者信息
 func (r *feedRepo) BatchGetUserInfo(ctx context.Context, ids []int64) ([]*pbUser.Author, error) {
-	resp, err := r.data.UserClient.BatchGetUserInfo(ctx, &pbUser.BatchGetUserInfoRequest{
-		AuthorIds: ids,
-	})
+	authors, err := r.data.authors.MGet(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
-	return resp.Users, nil
+	res := make([]*pbUser.Author, 0, len(authors))
+	for _, id := range ids {
+		if a, ok := authors[id]; ok {
+			res = append(res, &pbUser.Author{Id: a.ID, Name: a.Name, AvatarUrl: a.AvatarURL})
+		}
+	}
+	return res, nil
 }
 
-// BatchGetVideoInfo 根据视频id批量获取视频信息
-func (r *feedRepo) BatchGetVideoInfo(ctx context.Context, ids []int64) ([]*pbVideo.Video, error) {
-	resp, err := r.data.VideoClient.BatchGetVideoInfo(ctx, &pbVideo.BatchGetVideoInfoRequest{
-		Ids: ids,
+// BatchIsFavorited 当前用户点赞、收藏过的视频
+func (r *feedRepo) BatchIsFavorited(ctx context.Context, uid int64, vids []int64) (map[int64]bool, map[int64]bool, error) {
+	resp, err := r.data.FavoriteClient.BatchIsFavorited(ctx, &pbFavorite.BatchIsFavoritedRequest{
+		UserId:   uid,
+		VideoIds: vids,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	liked := make(map[int64]bool, len(resp.FavoritedVideoIds))
+	for _, id := range resp.FavoritedVideoIds {
+		liked[id] = true
 	}
-	return resp.Videos, nil
+	collected := make(map[int64]bool, len(resp.CollectedVideoIds))
+	for _, id := range resp.CollectedVideoIds {
+		collected[id] = true
+	}
+	return liked, collected, nil
 }
 
-// BatchGetVideoCountsFromCache 批量从缓存中获取点赞和批量数量信息
-func (r *feedRepo) BatchGetVideoCountsFromCache(ctx context.Context, ids []int64) (map[int64]int64, map[int64]int64, error) {
-
-	likeCounts := make(map[int64]int64)
-	commentCounts := make(map[int64]int64)
-
-	pipe := r.data.rdb.Pipeline()
-	likeCmds := make([]*redis.StringCmd, len(ids))
-	commentCmds := make([]*redis.StringCmd, len(ids))
-
-	for i, id := range ids {
-		likeCmds[i] = pipe.Get(ctx, fmt.Sprintf("video:favorite:%d", id))
-		commentCmds[i] = pipe.Get(ctx, fmt.Sprintf("video:comment_count:%d", id))
+// BatchIsFollowing 当前用户关注了哪些作者
+func (r *feedRepo) BatchIsFollowing(ctx context.Context, uid int64, authorIDs []int64) (map[int64]bool, error) {
+	resp, err := r.data.RelationClient.BatchIsFollowing(ctx, &pbRelation.BatchIsFollowingRequest{
+		UserId:    uid,
+		ToUserIds: authorIDs,
+	})
+	if err != nil {
+		return nil, err
 	}
-	_, err := pipe.Exec(ctx)
-	if err != nil && err != redis.Nil {
-		return nil, nil, err
+	following := make(map[int64]bool, len(resp.FollowingUserIds))
+	for _, id := range resp.FollowingUserIds {
+		following[id] = true
 	}
-	for i, id := range ids {
-		if val, err := likeCmds[i].Int64(); err == nil {
-			likeCounts[id] = val
-		}
-		if val, err := commentCmds[i].Int64(); err == nil {
-			commentCounts[id] = val
-		}
-	}
-	return likeCounts, commentCounts, nil
+	return following, nil
 }
 
-// 未在缓存中的加入缓存
-func (r *feedRepo) SetVideoCountsToCache(ctx context.Context, videoID, likeCount, commentCount int64) error {
-	pipe := r.data.rdb.Pipeline()
-	pipe.Set(ctx, fmt.Sprintf("video:like_count:%d", videoID), likeCount, 24*time.Hour)
-	pipe.Set(ctx, fmt.Sprintf("video:comment_count:%d", videoID), commentCount, 24*time.Hour)
-	_, err := pipe.Exec(ctx)
-	return err
+// BatchGetVideoCounts 批量读取缓存中的点赞数和评论数，只读不回源，结果中没有未命中的视频
+func (r *feedRepo) BatchGetVideoCounts(ctx context.Context, ids []int64) (map[int64]int64, map[int64]int64, error) {
+	likes, err := r.data.likes.MGet(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	comments, err := r.data.comments.MGet(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	return likes, comments, nil
 }
 
 // GetRecommendedVideoIDs 从缓存中获取视频id的排行
 func (r *feedRepo) GetRecommendedVideoIDs(ctx context.Context, offset, limit int64) ([]int64, error) {
-	idsStr, err := r.data.rdb.ZRevRange(ctx, "video:score", offset, offset+limit-1).Result()
+	idsStr, err := r.data.rdb.ZRevRange(ctx, rediskey.VideoScore, offset, offset+limit-1).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -148,30 +212,26 @@ func (r *feedRepo) GetRecommendedVideoIDs(ctx context.Context, offset, limit int
 	return ids, nil
 }
 
+// GetFeedVideoListByIDS 按 ids 顺序查询视频卡片，先读本地缓存，未命中的合并为一次数据库查询
 func (r *feedRepo) GetFeedVideoListByIDS(ctx context.Context, ids []int64) ([]*v1.Video, error) {
-	videos, err := r.data.query.Video.WithContext(ctx).Where(r.data.query.Video.ID.In(ids...)).Find()
+	cards, err := r.data.videos.MGet(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
 
-	// 按 ids 顺序排回
-	idToVideo := map[int64]*v1.Video{}
-	for _, video := range videos {
-		idToVideo[video.ID] = &v1.Video{
-			VideoId:      video.ID,
-			Title:        video.Title,
-			CoverUrl:     video.CoverURL,
-			AuthorId:     video.UserID,
-			LikeCount:    int64(video.FavoriteCnt),
-			CommentCount: int64(video.CommentCnt),
-			PublishTime:  video.CreatedAt.Unix(),
-		}
-	}
-
+	// 按 ids 顺序排回，每次返回新的对象，调用方会填充作者与互动状态
 	var ordered []*v1.Video
-	for _, video := range ids {
-		if val, ok := idToVideo[video]; ok {
-			ordered = append(ordered, val)
+	for _, id := range ids {
+		if c, ok := cards[id]; ok {
+			ordered = append(ordered, &v1.Video{
+				VideoId:      c.ID,
+				Title:        c.Title,
+				CoverUrl:     c.CoverURL,
+				AuthorId:     c.AuthorID,
+				LikeCount:    c.LikeCount,
+				CommentCount: c.CommentCount,
+				PublishTime:  c.PublishTime.Unix(),
+			})
 		}
 	}
 
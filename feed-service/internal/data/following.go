@@ -0,0 +1,139 @@
+package data
+
+import (
+	"common/rediskey"
+	"context"
+	"feed-service/internal/biz"
+	"feed-service/internal/pkg/constants"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gen/field"
+	"strconv"
+	"time"
+)
+
+// ListInbox 按游标倒序读取收件箱
+func (r *feedRepo) ListInbox(ctx context.Context, uid int64, cursor *biz.FeedCursor, limit int) ([]biz.FeedItem, error) {
+	key := rediskey.FeedInbox(uid)
+	n, err := r.data.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		if err := r.rebuildInbox(ctx, uid, key); err != nil {
+			return nil, err
+		}
+	} else {
+		r.data.rdb.Expire(ctx, key, r.inboxTTL)
+	}
+
+	// 同一毫秒发布的视频可能跨页，多取一些再按游标过滤
+	max := "+inf"
+	if cursor != nil {
+		max = strconv.FormatInt(cursor.PublishTime, 10)
+	}
+	zs, err := r.data.rdb.ZRangeArgsWithScores(ctx, redis.ZRangeArgs{
+		Key:     key,
+		Start:   "1", // 排除占位成员
+		Stop:    max,
+		ByScore: true,
+		Rev:     true,
+		Count:   int64(limit * 2),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]biz.FeedItem, 0, len(zs))
+	for _, z := range zs {
+		id, err := strconv.ParseInt(z.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		item := biz.FeedItem{VideoID: id, PublishTime: int64(z.Score)}
+		if cursor.Before(item) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// rebuildInbox 从数据库重建收件箱：关注的非大 V 作者最近发布的视频
+func (r *feedRepo) rebuildInbox(ctx context.Context, uid int64, key string) error {
+	authorIDs, err := r.followeeIDs(ctx, uid, false)
+	if err != nil {
+		return err
+	}
+	var items []biz.FeedItem
+	if len(authorIDs) > 0 {
+		items, err = r.listAuthorVideos(ctx, authorIDs, nil, r.inboxSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	members := make([]redis.Z, 0, len(items)+1)
+	members = append(members, redis.Z{Score: 0, Member: constants.FeedInboxPlaceholder})
+	for _, it := range items {
+		members = append(members, redis.Z{Score: float64(it.PublishTime), Member: strconv.FormatInt(it.VideoID, 10)})
+	}
+	_, err = r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, r.inboxTTL)
+		return nil
+	})
+	return err
+}
+
+// ListBigFolloweeVideos 大 V 的视频不推送，读取时按游标从数据库拉取
+func (r *feedRepo) ListBigFolloweeVideos(ctx context.Context, uid int64, cursor *biz.FeedCursor, limit int) ([]biz.FeedItem, error) {
+	authorIDs, err := r.followeeIDs(ctx, uid, true)
+	if err != nil || len(authorIDs) == 0 {
+		return nil, err
+	}
+	return r.listAuthorVideos(ctx, authorIDs, cursor, limit)
+}
+
+// followeeIDs 关注的作者，big 为 true 时只返回大 V，否则只返回非大 V
+func (r *feedRepo) followeeIDs(ctx context.Context, uid int64, big bool) ([]int64, error) {
+	rel := r.data.query.Relation
+	var ids []int64
+	if err := rel.WithContext(ctx).Where(rel.UserID.Eq(uid)).Pluck(rel.ToUserID, &ids); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	u := r.data.query.User
+	do := u.WithContext(ctx).Where(u.ID.In(ids...))
+	if big {
+		do = do.Where(u.FollowerCount.Gte(r.bigAuthorThreshold))
+	} else {
+		do = do.Where(u.FollowerCount.Lt(r.bigAuthorThreshold))
+	}
+	var res []int64
+	if err := do.Pluck(u.ID, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// listAuthorVideos 作者已发布的公开视频，按发布时间、id 倒序
+func (r *feedRepo) listAuthorVideos(ctx context.Context, authorIDs []int64, cursor *biz.FeedCursor, limit int) ([]biz.FeedItem, error) {
+	v := r.data.query.Video
+	do := v.WithContext(ctx).
+		Select(v.ID, v.CreatedAt).
+		Where(v.UserID.In(authorIDs...), v.PublishStatus.Eq(constants.PublishStatusPublished), v.IsPublic.Is(true), v.DeleteAt.IsNull())
+	if cursor != nil {
+		t := time.UnixMilli(cursor.PublishTime)
+		do = do.Where(field.Or(v.CreatedAt.Lt(t), field.And(v.CreatedAt.Eq(t), v.ID.Lt(cursor.VideoID))))
+	}
+	videos, err := do.Order(v.CreatedAt.Desc(), v.ID.Desc()).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]biz.FeedItem, 0, len(videos))
+	for _, video := range videos {
+		items = append(items, biz.FeedItem{VideoID: video.ID, PublishTime: video.CreatedAt.UnixMilli()})
+	}
+	return items, nil
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"common/rediskey"
+	"context"
+	"feed-service/internal/pkg/constants"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// 快照不存在时才复制热榜，同一时间段的并发请求只复制一次
+var hotSnapshotScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('ZRANGESTORE', KEYS[1], KEYS[2], 0, ARGV[1], 'REV')
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+// SnapshotHotVideos 取当前时间段的热榜快照，不存在时复制热榜前 N 名，返回快照所在的时间段；
+// 快照只按服务端时间生成，同时存在的快照数不超过 snapshot_ttl / snapshot_interval；热榜为空时不会生成快照
+func (r *feedRepo) SnapshotHotVideos(ctx context.Context) (string, error) {
+	bucket := strconv.FormatInt(time.Now().Truncate(r.hotSnapshotInterval).Unix(), 10)
+	key := fmt.Sprintf(constants.FeedHotSnapshotKey, bucket)
+	err := hotSnapshotScript.Run(ctx, r.data.rdb, []string{key, rediskey.VideoScore},
+		r.hotSnapshotSize-1, int64(r.hotSnapshotTTL.Seconds())).Err()
+	return bucket, err
+}
+
+// ListHotSnapshot 按排名读取时间段的快照，快照不存在时 ok 为 false；不顺延过期时间，避免快照数量随会话增长
+func (r *feedRepo) ListHotSnapshot(ctx context.Context, bucket string, offset, limit int64) ([]int64, bool, error) {
+	key := fmt.Sprintf(constants.FeedHotSnapshotKey, bucket)
+	pipe := r.data.rdb.Pipeline()
+	existsCmd := pipe.Exists(ctx, key)
+	rangeCmd := pipe.ZRevRange(ctx, key, offset, offset+limit-1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, false, err
+	}
+	if existsCmd.Val() == 0 {
+		return nil, false, nil
+	}
+
+	ids := make([]int64, 0, len(rangeCmd.Val()))
+	for _, member := range rangeCmd.Val() {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, false, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, true, nil
+}
+
+// HotRankingExists 热榜是否存在，redis 清空或新部署时为 false
+func (r *feedRepo) HotRankingExists(ctx context.Context) (bool, error) {
+	n, err := r.data.rdb.Exists(ctx, rediskey.VideoScore).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RequestHotRebuild 请求 job-service 按当前的分数配置全量重算热榜，请求未处理前重复请求只保留一个
+func (r *feedRepo) RequestHotRebuild(ctx context.Context) error {
+	return r.data.rdb.SetNX(ctx, rediskey.VideoScoreRebuildRequest, time.Now().Unix(), constants.HotRebuildRequestTTL).Err()
+}
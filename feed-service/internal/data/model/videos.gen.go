@@ -32,8 +32,9 @@ type Video struct {
 	VideoWidth      int32     `gorm:"column:video_width" json:"video_width"`
 	VideoHeight     int32     `gorm:"column:video_height" json:"video_height"`
 	BizExt          string    `gorm:"column:biz_ext" json:"biz_ext"`
-	Reserved1       string    `gorm:"column:reserved_1;comment:1" json:"reserved_1"` // 1
-	Reserved2       string    `gorm:"column:reserved_2;comment:2" json:"reserved_2"` // 2
+	PublishStatus   int32     `gorm:"column:publish_status;not null;comment:0 1 2" json:"publish_status"` // 0 1 2
+	Reserved1       string    `gorm:"column:reserved_1;comment:1" json:"reserved_1"`                      // 1
+	Reserved2       string    `gorm:"column:reserved_2;comment:2" json:"reserved_2"`                      // 2
 	CreatedAt       time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdateTime      time.Time `gorm:"column:update_time;default:CURRENT_TIMESTAMP" json:"update_time"`
 	DeleteAt        time.Time `gorm:"column:delete_at" json:"delete_at"`
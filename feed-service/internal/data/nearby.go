@@ -0,0 +1,44 @@
+package data
+
+import (
+	"common/rediskey"
+	"context"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+)
+
+// ListNearbyVideoIDs 半径内的视频，按距离由近到远
+func (r *feedRepo) ListNearbyVideoIDs(ctx context.Context, latitude, longitude, radiusKm float64, limit int) ([]int64, error) {
+	members, err := r.data.rdb.GeoSearch(ctx, rediskey.VideoGeo, &redis.GeoSearchQuery{
+		Longitude:  longitude,
+		Latitude:   latitude,
+		Radius:     radiusKm,
+		RadiusUnit: "km",
+		Sort:       "ASC",
+		Count:      limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseVideoIDs(members), nil
+}
+
+// ListCityVideoIDs 同城最近发布的视频
+func (r *feedRepo) ListCityVideoIDs(ctx context.Context, cityCode string, limit int) ([]int64, error) {
+	key := rediskey.VideoCity(cityCode)
+	members, err := r.data.rdb.ZRevRange(ctx, key, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseVideoIDs(members), nil
+}
+
+func parseVideoIDs(members []string) []int64 {
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -16,39 +16,54 @@ import (
 )
 
 var (
-	Q     = new(Query)
-	User  *user
-	Video *video
+	Q        = new(Query)
+	Relation *relation
+	Tag      *tag
+	User     *user
+	Video    *video
+	VideoTag *videoTag
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	Relation = &Q.Relation
+	Tag = &Q.Tag
 	User = &Q.User
 	Video = &Q.Video
+	VideoTag = &Q.VideoTag
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:    db,
-		User:  newUser(db, opts...),
-		Video: newVideo(db, opts...),
+		db:       db,
+		Relation: newRelation(db, opts...),
+		Tag:      newTag(db, opts...),
+		User:     newUser(db, opts...),
+		Video:    newVideo(db, opts...),
+		VideoTag: newVideoTag(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User  user
-	Video video
+	Relation relation
+	Tag      tag
+	User     user
+	Video    video
+	VideoTag videoTag
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:    db,
-		User:  q.User.clone(db),
-		Video: q.Video.clone(db),
+		db:       db,
+		Relation: q.Relation.clone(db),
+		Tag:      q.Tag.clone(db),
+		User:     q.User.clone(db),
+		Video:    q.Video.clone(db),
+		VideoTag: q.VideoTag.clone(db),
 	}
 }
 
@@ -62,21 +77,30 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:    db,
-		User:  q.User.replaceDB(db),
-		Video: q.Video.replaceDB(db),
+		db:       db,
+		Relation: q.Relation.replaceDB(db),
+		Tag:      q.Tag.replaceDB(db),
+		User:     q.User.replaceDB(db),
+		Video:    q.Video.replaceDB(db),
+		VideoTag: q.VideoTag.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User  IUserDo
-	Video IVideoDo
+	Relation IRelationDo
+	Tag      ITagDo
+	User     IUserDo
+	Video    IVideoDo
+	VideoTag IVideoTagDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User:  q.User.WithContext(ctx),
-		Video: q.Video.WithContext(ctx),
+		Relation: q.Relation.WithContext(ctx),
+		Tag:      q.Tag.WithContext(ctx),
+		User:     q.User.WithContext(ctx),
+		Video:    q.Video.WithContext(ctx),
+		VideoTag: q.VideoTag.WithContext(ctx),
 	}
 }
 
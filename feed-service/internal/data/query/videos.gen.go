@@ -48,6 +48,7 @@ func newVideo(db *gorm.DB, opts ...gen.DOOption) video {
 	_video.VideoWidth = field.NewInt32(tableName, "video_width")
 	_video.VideoHeight = field.NewInt32(tableName, "video_height")
 	_video.BizExt = field.NewString(tableName, "biz_ext")
+	_video.PublishStatus = field.NewInt32(tableName, "publish_status")
 	_video.Reserved1 = field.NewString(tableName, "reserved_1")
 	_video.Reserved2 = field.NewString(tableName, "reserved_2")
 	_video.CreatedAt = field.NewTime(tableName, "created_at")
@@ -83,6 +84,7 @@ type video struct {
 	VideoWidth      field.Int32
 	VideoHeight     field.Int32
 	BizExt          field.String
+	PublishStatus   field.Int32  // 0 1 2
 	Reserved1       field.String // 1
 	Reserved2       field.String // 2
 	CreatedAt       field.Time
@@ -124,6 +126,7 @@ func (v *video) updateTableName(table string) *video {
 	v.VideoWidth = field.NewInt32(table, "video_width")
 	v.VideoHeight = field.NewInt32(table, "video_height")
 	v.BizExt = field.NewString(table, "biz_ext")
+	v.PublishStatus = field.NewInt32(table, "publish_status")
 	v.Reserved1 = field.NewString(table, "reserved_1")
 	v.Reserved2 = field.NewString(table, "reserved_2")
 	v.CreatedAt = field.NewTime(table, "created_at")
@@ -153,7 +156,7 @@ func (v *video) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (v *video) fillFieldMap() {
-	v.fieldMap = make(map[string]field.Expr, 25)
+	v.fieldMap = make(map[string]field.Expr, 26)
 	v.fieldMap["id"] = v.ID
 	v.fieldMap["user_id"] = v.UserID
 	v.fieldMap["play_url"] = v.PlayURL
@@ -174,6 +177,7 @@ func (v *video) fillFieldMap() {
 	v.fieldMap["video_width"] = v.VideoWidth
 	v.fieldMap["video_height"] = v.VideoHeight
 	v.fieldMap["biz_ext"] = v.BizExt
+	v.fieldMap["publish_status"] = v.PublishStatus
 	v.fieldMap["reserved_1"] = v.Reserved1
 	v.fieldMap["reserved_2"] = v.Reserved2
 	v.fieldMap["created_at"] = v.CreatedAt
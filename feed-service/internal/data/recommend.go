@@ -0,0 +1,155 @@
+package data
+
+import (
+	"common/rediskey"
+	"context"
+	"feed-service/internal/biz"
+	"feed-service/internal/pkg/constants"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetUserProfile 读取偏好最高的话题和作者，画像由 job-service 维护，不存在时返回空画像
+func (r *feedRepo) GetUserProfile(ctx context.Context, uid int64, topTags, topAuthors int) (*biz.UserProfile, error) {
+	pipe := r.data.rdb.Pipeline()
+	tagCmd := pipe.ZRevRangeWithScores(ctx, rediskey.UserProfileTag(uid), 0, int64(topTags-1))
+	authorCmd := pipe.ZRevRangeWithScores(ctx, rediskey.UserProfileAuthor(uid), 0, int64(topAuthors-1))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	profile := &biz.UserProfile{
+		Tags:    make(map[string]float64),
+		Authors: make(map[int64]float64),
+	}
+	// 按最大值归一化，第一个成员偏好最高
+	tags := tagCmd.Val()
+	for _, z := range tags {
+		name := z.Member.(string)
+		profile.Tags[name] = z.Score / tags[0].Score
+		profile.TopTags = append(profile.TopTags, name)
+	}
+	authors := authorCmd.Val()
+	for _, z := range authors {
+		id, err := strconv.ParseInt(z.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		profile.Authors[id] = z.Score / authors[0].Score
+		profile.TopAuthors = append(profile.TopAuthors, id)
+	}
+	return profile, nil
+}
+
+// ListTagVideoIDs 按话题名查出话题，再取每个话题下最近关联的视频
+func (r *feedRepo) ListTagVideoIDs(ctx context.Context, tags []string, limitPerTag int) ([]int64, error) {
+	t := r.data.query.Tag
+	var tagIDs []int64
+	if err := t.WithContext(ctx).Where(t.Name.In(tags...)).Pluck(t.ID, &tagIDs); err != nil {
+		return nil, err
+	}
+
+	vt := r.data.query.VideoTag
+	var res []int64
+	for _, tagID := range tagIDs {
+		var ids []int64
+		err := vt.WithContext(ctx).
+			Where(vt.TagID.Eq(tagID)).
+			Order(vt.CreatedAt.Desc()).
+			Limit(limitPerTag).
+			Pluck(vt.VideoID, &ids)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ids...)
+	}
+	return res, nil
+}
+
+// ListRecentFolloweeIDs 最近关注的作者
+func (r *feedRepo) ListRecentFolloweeIDs(ctx context.Context, uid int64, limit int) ([]int64, error) {
+	rel := r.data.query.Relation
+	var ids []int64
+	err := rel.WithContext(ctx).
+		Where(rel.UserID.Eq(uid)).
+		Order(rel.ID.Desc()).
+		Limit(limit).
+		Pluck(rel.ToUserID, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListAuthorVideoIDs 作者们最近发布的视频
+func (r *feedRepo) ListAuthorVideoIDs(ctx context.Context, authorIDs []int64, limit int) ([]int64, error) {
+	items, err := r.listAuthorVideos(ctx, authorIDs, nil, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.VideoID)
+	}
+	return ids, nil
+}
+
+// ListFreshVideoIDs since 之后发布的视频，按发布时间倒序
+func (r *feedRepo) ListFreshVideoIDs(ctx context.Context, since time.Time, limit int) ([]int64, error) {
+	v := r.data.query.Video
+	var ids []int64
+	err := v.WithContext(ctx).
+		Where(v.CreatedAt.Gte(since), v.PublishStatus.Eq(constants.PublishStatusPublished), v.IsPublic.Is(true), v.DeleteAt.IsNull()).
+		Order(v.CreatedAt.Desc(), v.ID.Desc()).
+		Limit(limit).
+		Pluck(v.ID, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetCandidates 查询候选视频的作者、话题、发布时间，热度分取自热榜，不在榜单中的为 0
+func (r *feedRepo) GetCandidates(ctx context.Context, ids []int64) ([]*biz.Candidate, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	v := r.data.query.Video
+	videos, err := v.WithContext(ctx).
+		Select(v.ID, v.UserID, v.Tags, v.CreatedAt).
+		Where(v.ID.In(ids...), v.PublishStatus.Eq(constants.PublishStatusPublished), v.IsPublic.Is(true), v.DeleteAt.IsNull()).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(videos) == 0 {
+		return nil, nil
+	}
+
+	members := make([]string, 0, len(videos))
+	for _, video := range videos {
+		members = append(members, strconv.FormatInt(video.ID, 10))
+	}
+	scores, err := r.data.rdb.ZMScore(ctx, rediskey.VideoScore, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*biz.Candidate, 0, len(videos))
+	for i, video := range videos {
+		c := &biz.Candidate{
+			VideoID:     video.ID,
+			AuthorID:    video.UserID,
+			PublishTime: video.CreatedAt.UnixMilli(),
+			HotScore:    scores[i],
+		}
+		for _, t := range strings.Split(video.Tags, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				c.Tags = append(c.Tags, t)
+			}
+		}
+		res = append(res, c)
+	}
+	return res, nil
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"encoding/binary"
+	"feed-service/internal/pkg/constants"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"hash/fnv"
+	"time"
+)
+
+// 已看过滤：redis 位图实现的布隆过滤器，不依赖 RedisBloom 模块
+// 按时间窗口分代，写入当前代、同时检查当前与上一代，过期时间为两个窗口，实现滚动过期
+
+// 视频在任意一代中全部位都为 1 时视为已看，返回每个视频的结果
+var seenCheckScript = redis.NewScript(`
+local k = tonumber(ARGV[1])
+local res = {}
+for i = 0, (#ARGV - 1) / k - 1 do
+	local hit = 0
+	for _, key in ipairs(KEYS) do
+		local all = 1
+		for j = 1, k do
+			if redis.call('GETBIT', key, ARGV[1 + i * k + j]) == 0 then
+				all = 0
+				break
+			end
+		end
+		if all == 1 then
+			hit = 1
+			break
+		end
+	end
+	res[i + 1] = hit
+end
+return res
+`)
+
+// FilterSeen 过滤已看过的视频，保持原有顺序
+func (r *feedRepo) FilterSeen(ctx context.Context, uid int64, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return ids, nil
+	}
+	gen := r.seenGeneration(time.Now())
+	keys := []string{r.seenKey(uid, gen), r.seenKey(uid, gen-1)}
+	args := make([]interface{}, 0, 1+len(ids)*r.seenHashes)
+	args = append(args, r.seenHashes)
+	for _, id := range ids {
+		for _, off := range r.seenOffsets(id) {
+			args = append(args, off)
+		}
+	}
+	hits, err := seenCheckScript.Run(ctx, r.data.rdb, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int64, 0, len(ids))
+	for i, id := range ids {
+		if i < len(hits) && hits[i] == 0 {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
+
+// MarkSeen 记录已下发的视频
+func (r *feedRepo) MarkSeen(ctx context.Context, uid int64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	key := r.seenKey(uid, r.seenGeneration(time.Now()))
+	pipe := r.data.rdb.Pipeline()
+	for _, id := range ids {
+		for _, off := range r.seenOffsets(id) {
+			pipe.SetBit(ctx, key, int64(off), 1)
+		}
+	}
+	pipe.Expire(ctx, key, 2*r.seenWindow)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *feedRepo) seenGeneration(t time.Time) int64 {
+	return t.UnixNano() / int64(r.seenWindow)
+}
+
+func (r *feedRepo) seenKey(uid, gen int64) string {
+	return fmt.Sprintf(constants.FeedSeenKey, uid, gen)
+}
+
+// seenOffsets 双重哈希生成 k 个位置：h1 + i*h2
+func (r *feedRepo) seenOffsets(id int64) []uint64 {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(id))
+	h := fnv.New64a()
+	h.Write(b[:])
+	sum := h.Sum64()
+	h1, h2 := sum&0xffffffff, sum>>32|1
+
+	offs := make([]uint64, r.seenHashes)
+	for i := range offs {
+		offs[i] = (h1 + uint64(i)*h2) % r.seenBits
+	}
+	return offs
+}
@@ -1,5 +1,63 @@
 package constants
 
+import "time"
+
 const (
 	FeedPageLimit = 20
+	// PublishStatusPublished 视频已发布，与 video-service 保持一致
+	PublishStatusPublished = 0
+)
+
+const (
+	// FeedInboxPlaceholder 收件箱占位成员，score 为 0，没有可推送视频时也能区分已构建的收件箱
+	FeedInboxPlaceholder = "0"
+	// 关注流默认配置
+	DefaultBigAuthorThreshold = 10000
+	DefaultInboxSize          = 1000
+	DefaultInboxTTL           = 7 * 24 * time.Hour
+)
+
+const (
+	// FeedSeenKey 已看过滤的布隆过滤器位图，按用户与时间窗口分代
+	FeedSeenKey = "feed:seen:%d:%d"
+	// 已看过滤默认配置
+	DefaultSeenWindow = 24 * time.Hour
+	DefaultSeenBits   = 1 << 17
+	DefaultSeenHashes = 7
+)
+
+const (
+	// FeedHotSnapshotKey 热榜快照 zset，复制 video:score 的前 N 名；%s 为时间段开始的秒级时间戳，同一时间段的游客共用
+	FeedHotSnapshotKey = "feed:hot:snapshot:%s"
+	// 热榜快照默认配置
+	DefaultHotSnapshotSize     = 1000
+	DefaultHotSnapshotTTL      = 30 * time.Minute
+	DefaultHotSnapshotInterval = time.Minute
+)
+
+// HotRebuildRequestTTL 热榜重算请求的过期时间，job-service 未运行时请求随之失效
+const HotRebuildRequestTTL = 10 * time.Minute
+
+// ViewerStateTimeout 查询当前用户点赞、收藏、关注状态的超时时间，超时后按未点赞、未关注返回
+const ViewerStateTimeout = 200 * time.Millisecond
+
+const (
+	// 附近视频默认配置
+	DefaultNearbyMaxCandidates = 500
+	DefaultNearbyRadiusKm      = 5
+	DefaultNearbyMaxRadiusKm   = 50
+)
+
+const (
+	// ExperimentControl 对照组的实验id，游客与未分配给实验的桶使用
+	ExperimentControl = "control"
+	// DefaultExperimentBuckets 默认总桶数
+	DefaultExperimentBuckets = 100
+	// 曝光、点击事件类型
+	FeedEventImpression = "impression"
+	FeedEventClick      = "click"
+	// 事件来源
+	FeedSourceRecommend = "recommend"
+	FeedSourceFollowing = "following"
+	FeedSourceNearby    = "nearby"
 )
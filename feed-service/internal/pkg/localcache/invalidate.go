@@ -0,0 +1,103 @@
+package localcache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"common/invalidate"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const subscribeRetry = time.Second
+
+// Invalidator 可按 id 失效的缓存
+type Invalidator interface {
+	Name() string
+	Delete(ids ...int64)
+	Purge()
+}
+
+// Subscriber 订阅失效消息并删除本地缓存；重新订阅时可能漏掉了断开期间的消息，清空全部缓存
+type Subscriber struct {
+	rdb    *redis.Client
+	caches map[string]Invalidator
+	log    *log.Helper
+
+	mu     sync.Mutex
+	pubsub *redis.PubSub
+}
+
+// NewSubscriber 创建订阅，caches 按 Name 匹配消息中的 kind，即 invalidate.Kind*
+func NewSubscriber(rdb *redis.Client, logger log.Logger, caches ...Invalidator) *Subscriber {
+	s := &Subscriber{rdb: rdb, caches: make(map[string]Invalidator, len(caches)), log: log.NewHelper(logger)}
+	for _, c := range caches {
+		s.caches[c.Name()] = c
+	}
+	return s
+}
+
+// Start 订阅失效频道直到 Stop
+func (s *Subscriber) Start(ctx context.Context) error {
+	pubsub := s.rdb.Subscribe(ctx, invalidate.Channel)
+	s.mu.Lock()
+	s.pubsub = pubsub
+	s.mu.Unlock()
+	s.log.WithContext(ctx).Infof("local cache subscriber start, channel: %s", invalidate.Channel)
+
+	subscribed := false
+	for {
+		msg, err := pubsub.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil || err == redis.ErrClosed {
+				return nil
+			}
+			s.log.Warnf("receive cache invalidation failed, retry in %s: %v", subscribeRetry, err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(subscribeRetry):
+			}
+			continue
+		}
+		switch m := msg.(type) {
+		case *redis.Subscription:
+			if m.Kind != "subscribe" {
+				continue
+			}
+			if subscribed {
+				s.log.Warn("cache invalidation resubscribed, purge local caches")
+				for _, c := range s.caches {
+					c.Purge()
+				}
+			}
+			subscribed = true
+		case *redis.Message:
+			s.handle(m.Payload)
+		}
+	}
+}
+
+// Stop 取消订阅
+func (s *Subscriber) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pubsub == nil {
+		return nil
+	}
+	return s.pubsub.Close()
+}
+
+func (s *Subscriber) handle(payload string) {
+	msg := new(invalidate.Message)
+	if err := json.Unmarshal([]byte(payload), msg); err != nil {
+		s.log.Errorf("unmarshal cache invalidation failed: %v, payload: %s", err, payload)
+		return
+	}
+	if c, ok := s.caches[msg.Kind]; ok && len(msg.IDs) > 0 {
+		c.Delete(msg.IDs...)
+	}
+}
@@ -0,0 +1,253 @@
+package localcache
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// 进程内缓存，按 id 缓存视频卡片、作者信息等很少修改的数据
+// 容量满时淘汰最久未访问的项，过期后回源；窗口内访问次数达到阈值的热点 id 常驻本地，不参与淘汰且过期时间更长
+
+const (
+	defaultSize      = 10000
+	defaultTTL       = time.Minute
+	defaultHotWindow = 10 * time.Second
+	defaultPinTTL    = 10 * time.Minute
+	defaultMaxPinned = 1000
+)
+
+// Config 缓存配置，未配置的项使用默认值；HotThreshold 为 0 时不识别热点
+type Config struct {
+	Size         int
+	TTL          time.Duration
+	HotThreshold int
+	HotWindow    time.Duration
+	PinTTL       time.Duration
+	MaxPinned    int
+}
+
+// Loader 批量回源，结果中没有的 id 不缓存
+type Loader[V any] func(ctx context.Context, ids []int64) (map[int64]V, error)
+
+type entry[V any] struct {
+	id       int64
+	value    V
+	expireAt time.Time
+}
+
+// Cache 一类数据的进程内缓存，并发安全
+type Cache[V any] struct {
+	name string
+	cfg  Config
+	load Loader[V]
+	sf   singleflight.Group
+
+	mu      sync.Mutex
+	lru     *list.List // 未常驻的项，最近访问的在前
+	items   map[int64]*list.Element
+	pinned  map[int64]*entry[V]
+	hits    map[int64]int // 当前窗口内的访问次数
+	resetAt time.Time
+	gen     uint64 // 每次删除后递增，回源期间有删除时不写入，避免写回旧数据
+}
+
+// New 创建缓存，name 用于区分 singleflight 的 key 与失效消息
+func New[V any](name string, cfg Config, load Loader[V]) *Cache[V] {
+	if cfg.Size <= 0 {
+		cfg.Size = defaultSize
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTTL
+	}
+	if cfg.HotWindow <= 0 {
+		cfg.HotWindow = defaultHotWindow
+	}
+	if cfg.PinTTL <= 0 {
+		cfg.PinTTL = defaultPinTTL
+	}
+	if cfg.MaxPinned <= 0 {
+		cfg.MaxPinned = defaultMaxPinned
+	}
+	return &Cache[V]{
+		name:    name,
+		cfg:     cfg,
+		load:    load,
+		lru:     list.New(),
+		items:   make(map[int64]*list.Element),
+		pinned:  make(map[int64]*entry[V]),
+		hits:    make(map[int64]int),
+		resetAt: time.Now().Add(cfg.HotWindow),
+	}
+}
+
+// Name 缓存名
+func (c *Cache[V]) Name() string {
+	return c.name
+}
+
+// MGet 批量读取，未命中的 id 合并为一次回源并写入缓存；回源失败时返回已命中的部分与错误
+func (c *Cache[V]) MGet(ctx context.Context, ids []int64) (map[int64]V, error) {
+	res := make(map[int64]V, len(ids))
+	var missing []int64
+	now := time.Now()
+	c.mu.Lock()
+	for _, id := range ids {
+		if v, ok := c.get(id, now); ok {
+			res[id] = v
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	c.mu.Unlock()
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	loaded, err := c.fill(ctx, missing)
+	if err != nil {
+		return res, err
+	}
+	for _, id := range missing {
+		if v, ok := loaded[id]; ok {
+			res[id] = v
+		}
+	}
+	return res, nil
+}
+
+// Delete 删除缓存，常驻的项也一并删除，之后再次成为热点时重新常驻
+func (c *Cache[V]) Delete(ids ...int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for _, id := range ids {
+		if el, ok := c.items[id]; ok {
+			c.lru.Remove(el)
+			delete(c.items, id)
+		}
+		delete(c.pinned, id)
+	}
+}
+
+// Purge 清空缓存，订阅断开期间可能漏掉失效消息时使用
+func (c *Cache[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.lru.Init()
+	c.items = make(map[int64]*list.Element)
+	c.pinned = make(map[int64]*entry[V])
+}
+
+// get 读取未过期的项并记录访问，调用方持有锁
+func (c *Cache[V]) get(id int64, now time.Time) (V, bool) {
+	hot := c.hit(id, now)
+	if e, ok := c.pinned[id]; ok {
+		if now.Before(e.expireAt) {
+			return e.value, true
+		}
+		delete(c.pinned, id)
+	}
+	el, ok := c.items[id]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	e := el.Value.(*entry[V])
+	if !now.Before(e.expireAt) {
+		c.lru.Remove(el)
+		delete(c.items, id)
+		var zero V
+		return zero, false
+	}
+	if hot && len(c.pinned) < c.cfg.MaxPinned {
+		// 热点移出 LRU 常驻本地
+		c.lru.Remove(el)
+		delete(c.items, id)
+		e.expireAt = now.Add(c.cfg.PinTTL)
+		c.pinned[id] = e
+	} else {
+		c.lru.MoveToFront(el)
+	}
+	return e.value, true
+}
+
+// hit 记录一次访问，返回是否为热点；窗口结束时清零重新统计
+func (c *Cache[V]) hit(id int64, now time.Time) bool {
+	if c.cfg.HotThreshold <= 0 {
+		return false
+	}
+	if !now.Before(c.resetAt) {
+		c.hits = make(map[int64]int, len(c.hits))
+		c.resetAt = now.Add(c.cfg.HotWindow)
+	}
+	c.hits[id]++
+	return c.hits[id] >= c.cfg.HotThreshold
+}
+
+// set 写入缓存，超出容量时淘汰最久未访问的项，调用方持有锁
+func (c *Cache[V]) set(id int64, v V, now time.Time) {
+	if e, ok := c.pinned[id]; ok {
+		e.value = v
+		e.expireAt = now.Add(c.cfg.PinTTL)
+		return
+	}
+	if el, ok := c.items[id]; ok {
+		e := el.Value.(*entry[V])
+		e.value, e.expireAt = v, now.Add(c.cfg.TTL)
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.items[id] = c.lru.PushFront(&entry[V]{id: id, value: v, expireAt: now.Add(c.cfg.TTL)})
+	for c.lru.Len() > c.cfg.Size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry[V]).id)
+	}
+}
+
+// fill 回源并写入缓存，同一批 id 的并发请求只回源一次
+func (c *Cache[V]) fill(ctx context.Context, ids []int64) (map[int64]V, error) {
+	v, err, _ := c.sf.Do(c.flightKey(ids), func() (interface{}, error) {
+		c.mu.Lock()
+		gen := c.gen
+		c.mu.Unlock()
+		loaded, err := c.load(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		c.mu.Lock()
+		if c.gen == gen {
+			for id, v := range loaded {
+				c.set(id, v, now)
+			}
+		}
+		c.mu.Unlock()
+		return loaded, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[int64]V), nil
+}
+
+// flightKey 同一批 id 不论顺序都合并为一次回源
+func (c *Cache[V]) flightKey(ids []int64) string {
+	sorted := append([]int64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var b strings.Builder
+	b.WriteString(c.name)
+	for _, id := range sorted {
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatInt(id, 10))
+	}
+	return b.String()
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"feed-service/internal/pkg/constants"
+	"strings"
 
 	v1 "feed-service/api/feed/v1"
 	"feed-service/internal/biz"
@@ -40,16 +41,84 @@ func (s *FeedService) GetFeed(ctx context.Context, in *v1.FeedRequest) (*v1.Feed
 	//	latestTime = time.Now().Unix()
 	//}
 
-	offset := in.Offset
 	limit := constants.FeedPageLimit
+	if in.Type == v1.FeedType_FEED_TYPE_FOLLOWING {
+		page, err := s.uc.GetFollowingFeed(ctx, userID, in.Cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		return &v1.FeedReply{
+			Videos:       page.Videos,
+			NextCursor:   page.NextCursor,
+			HasMore:      page.HasMore,
+			ExperimentId: page.ExperimentID,
+		}, nil
+	}
 
-	// videos, nextTime, err := s.uc.GetFeed(ctx, userID, latestTime)
-	videos, err := s.uc.GetFeed(ctx, userID, offset, int64(limit))
+	page, err := s.uc.GetFeed(ctx, userID, in.Cursor, in.Offset, int64(limit))
 	if err != nil {
 		return nil, err
 	}
 
 	return &v1.FeedReply{
-		Videos: videos,
+		Videos:       page.Videos,
+		NextOffset:   page.NextOffset,
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+		ExperimentId: page.ExperimentID,
 	}, nil
 }
+
+// GetNearbyFeed 附近、同城视频，坐标只用于查询，不记录日志
+func (s *FeedService) GetNearbyFeed(ctx context.Context, in *v1.NearbyFeedRequest) (*v1.FeedReply, error) {
+	var userID int64 = 0
+	if in.Token != "" {
+		uid, err := s.uc.ParesToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = uid
+	}
+
+	q := &biz.NearbyQuery{
+		Latitude:  in.Latitude,
+		Longitude: in.Longitude,
+		HasCoord:  in.Latitude != 0 || in.Longitude != 0,
+		RadiusKm:  in.RadiusKm,
+		CityCode:  strings.TrimSpace(in.CityCode),
+	}
+	page, err := s.uc.GetNearbyFeed(ctx, userID, q, in.Offset, int64(constants.FeedPageLimit))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FeedReply{
+		Videos:       page.Videos,
+		NextOffset:   page.NextOffset,
+		HasMore:      page.HasMore,
+		ExperimentId: page.ExperimentID,
+	}, nil
+}
+
+// ReportFeedClick 上报点击，游客也可以上报
+func (s *FeedService) ReportFeedClick(ctx context.Context, in *v1.FeedClickRequest) (*v1.FeedClickReply, error) {
+	var userID int64 = 0
+	if in.Token != "" {
+		uid, err := s.uc.ParesToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = uid
+	}
+
+	source := constants.FeedSourceRecommend
+	switch in.Type {
+	case v1.FeedType_FEED_TYPE_FOLLOWING:
+		source = constants.FeedSourceFollowing
+	case v1.FeedType_FEED_TYPE_NEARBY:
+		source = constants.FeedSourceNearby
+	}
+	if err := s.uc.ReportClick(ctx, userID, in.ExperimentId, source, in.VideoId, in.Position); err != nil {
+		return nil, err
+	}
+	return &v1.FeedClickReply{}, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"common/outbox"
 	"flag"
 	"job-service/internal/job"
 	"os"
@@ -34,7 +35,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWork) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWork, pw *job.PlayWork, pub *job.PublishWork, sw *job.ScoreWork, fw *job.FanoutWork, prw *job.ProfileWork, ob *outbox.Relay) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +46,12 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWork
 			//gs,
 			//hs,
 			js,
+			pw,
+			pub,
+			sw,
+			fw,
+			prw,
+			ob,
 		),
 	)
 }
@@ -76,7 +83,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Elasticsearch, bc.Kafka, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Elasticsearch, bc.Kafka, bc.Play, bc.Publish, bc.Score, bc.Fanout, bc.Profile, c, logger)
 	if err != nil {
 		panic(err)
 	}
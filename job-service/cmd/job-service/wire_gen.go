@@ -8,6 +8,7 @@ package main
 
 import (
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	"job-service/internal/biz"
 	"job-service/internal/conf"
@@ -24,7 +25,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, elasticsearch *conf.Elasticsearch, kafka *conf.Kafka, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, elasticsearch *conf.Elasticsearch, kafka *conf.Kafka, play *conf.Play, publish *conf.Publish, score *conf.Score, fanout *conf.Fanout, profile *conf.Profile, configConfig config.Config, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -41,7 +42,19 @@ func wireApp(confServer *conf.Server, confData *conf.Data, elasticsearch *conf.E
 		return nil, nil, err
 	}
 	jobWork := job.NewJobWrok(reader, esClient, elasticsearch, logger)
-	app := newApp(logger, grpcServer, httpServer, jobWork)
+	db, err := job.NewDB(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	client := job.NewRedisClient(confData)
+	playWork := job.NewPlayWork(kafka, play, db, client, logger)
+	publishWork := job.NewPublishWork(publish, db, client, logger)
+	scoreWork := job.NewScoreWork(configConfig, score, db, client, logger)
+	fanoutWork := job.NewFanoutWork(kafka, fanout, db, client, logger)
+	profileWork := job.NewProfileWork(kafka, profile, db, client, logger)
+	relay := job.NewOutboxRelay(kafka, publish, db, logger)
+	app := newApp(logger, grpcServer, httpServer, jobWork, playWork, publishWork, scoreWork, fanoutWork, profileWork, relay)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -28,6 +28,11 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Elasticsearch *Elasticsearch         `protobuf:"bytes,3,opt,name=elasticsearch,proto3" json:"elasticsearch,omitempty"`
 	Kafka         *Kafka                 `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Play          *Play                  `protobuf:"bytes,5,opt,name=play,proto3" json:"play,omitempty"`
+	Publish       *Publish               `protobuf:"bytes,6,opt,name=publish,proto3" json:"publish,omitempty"`
+	Score         *Score                 `protobuf:"bytes,7,opt,name=score,proto3" json:"score,omitempty"`
+	Fanout        *Fanout                `protobuf:"bytes,8,opt,name=fanout,proto3" json:"fanout,omitempty"`
+	Profile       *Profile               `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,29 +95,598 @@ func (x *Bootstrap) GetKafka() *Kafka {
 	return nil
 }
 
+func (x *Bootstrap) GetPlay() *Play {
+	if x != nil {
+		return x.Play
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetPublish() *Publish {
+	if x != nil {
+		return x.Publish
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetScore() *Score {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetFanout() *Fanout {
+	if x != nil {
+		return x.Fanout
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+type ElasticsearchIndex struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Topic string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Index string                 `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// 字段类型映射，支持 keyword、keywords（逗号分隔，写入时拆成数组）、text、long、double、boolean、date
+	FieldTypes map[string]string `protobuf:"bytes,3,rep,name=field_types,json=fieldTypes,proto3" json:"field_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 写入字段白名单，为空时写入全部字段；已写入的字段需重建索引才会清除
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// 写入条件，行中字段值全部相等时才写入，更新后不满足时删除文档；用于过滤草稿等未发布数据
+	IndexWhen     map[string]string `protobuf:"bytes,5,rep,name=index_when,json=indexWhen,proto3" json:"index_when,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElasticsearchIndex) Reset() {
+	*x = ElasticsearchIndex{}
+	mi := &file_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElasticsearchIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElasticsearchIndex) ProtoMessage() {}
+
+func (x *ElasticsearchIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElasticsearchIndex.ProtoReflect.Descriptor instead.
+func (*ElasticsearchIndex) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ElasticsearchIndex) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ElasticsearchIndex) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *ElasticsearchIndex) GetFieldTypes() map[string]string {
+	if x != nil {
+		return x.FieldTypes
+	}
+	return nil
+}
+
+func (x *ElasticsearchIndex) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ElasticsearchIndex) GetIndexWhen() map[string]string {
+	if x != nil {
+		return x.IndexWhen
+	}
+	return nil
+}
+
+// 搜索建议来源，将 topic 中某个字段写入建议索引
+type SuggestSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Topic string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Field string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`    // 建议类型，如 video、tag、user
+	Split bool                   `protobuf:"varint,4,opt,name=split,proto3" json:"split,omitempty"` // 是否按逗号拆分成多个建议词
+	// 变更行满足全部条件时才写入建议，不满足或行被删除时移除该行的建议；值为空字符串匹配 NULL
+	IndexWhen     map[string]string `protobuf:"bytes,5,rep,name=index_when,json=indexWhen,proto3" json:"index_when,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSource) Reset() {
+	*x = SuggestSource{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSource) ProtoMessage() {}
+
+func (x *SuggestSource) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSource.ProtoReflect.Descriptor instead.
+func (*SuggestSource) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SuggestSource) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SuggestSource) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SuggestSource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestSource) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+func (x *SuggestSource) GetIndexWhen() map[string]string {
+	if x != nil {
+		return x.IndexWhen
+	}
+	return nil
+}
+
+type Elasticsearch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Addresses      []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Indices        []*ElasticsearchIndex  `protobuf:"bytes,2,rep,name=indices,proto3" json:"indices,omitempty"`
+	SuggestIndex   string                 `protobuf:"bytes,3,opt,name=suggest_index,json=suggestIndex,proto3" json:"suggest_index,omitempty"`
+	SuggestSources []*SuggestSource       `protobuf:"bytes,4,rep,name=suggest_sources,json=suggestSources,proto3" json:"suggest_sources,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Elasticsearch) Reset() {
+	*x = Elasticsearch{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Elasticsearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Elasticsearch) ProtoMessage() {}
+
+func (x *Elasticsearch) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Elasticsearch.ProtoReflect.Descriptor instead.
+func (*Elasticsearch) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Elasticsearch) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Elasticsearch) GetIndices() []*ElasticsearchIndex {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *Elasticsearch) GetSuggestIndex() string {
+	if x != nil {
+		return x.SuggestIndex
+	}
+	return ""
+}
+
+func (x *Elasticsearch) GetSuggestSources() []*SuggestSource {
+	if x != nil {
+		return x.SuggestSources
+	}
+	return nil
+}
+
+type Kafka struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brokers       []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Topics        []string               `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Kafka) Reset() {
+	*x = Kafka{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kafka) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kafka) ProtoMessage() {}
+
+func (x *Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kafka.ProtoReflect.Descriptor instead.
+func (*Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Kafka) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Kafka) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Kafka) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+// 播放事件汇总，按批次累加到 videos.view_cnt，并标记视频待重算分数
+type Play struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FlushInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 累计多少条事件后提前刷新
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Play) Reset() {
+	*x = Play{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Play) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Play) ProtoMessage() {}
+
+func (x *Play) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Play.ProtoReflect.Descriptor instead.
+func (*Play) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Play) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Play) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Play) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+func (x *Play) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// 定时发布，扫描到期的定时视频并发布；发布事件经 outbox 写入 video_event_topic，由 video-service 执行发布后的副作用
+type Publish struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Interval        *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	BatchSize       int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每次最多发布的视频数
+	VideoEventTopic string                 `protobuf:"bytes,3,opt,name=video_event_topic,json=videoEventTopic,proto3" json:"video_event_topic,omitempty"`
+	Outbox          *Publish_Outbox        `protobuf:"bytes,4,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Publish) Reset() {
+	*x = Publish{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Publish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Publish) ProtoMessage() {}
+
+func (x *Publish) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Publish.ProtoReflect.Descriptor instead.
+func (*Publish) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Publish) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Publish) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Publish) GetVideoEventTopic() string {
+	if x != nil {
+		return x.VideoEventTopic
+	}
+	return ""
+}
+
+func (x *Publish) GetOutbox() *Publish_Outbox {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
+// 视频热度分数，score = Σ 计数 × 权重 + base / (发布小时数 + offset_hours) ^ gravity
+type ScoreWeights struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorite      float64                `protobuf:"fixed64,1,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Comment       float64                `protobuf:"fixed64,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	View          float64                `protobuf:"fixed64,3,opt,name=view,proto3" json:"view,omitempty"`
+	Share         float64                `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"`
+	Collect       float64                `protobuf:"fixed64,5,opt,name=collect,proto3" json:"collect,omitempty"`
+	Base          float64                `protobuf:"fixed64,6,opt,name=base,proto3" json:"base,omitempty"`
+	OffsetHours   float64                `protobuf:"fixed64,7,opt,name=offset_hours,json=offsetHours,proto3" json:"offset_hours,omitempty"`
+	Gravity       float64                `protobuf:"fixed64,8,opt,name=gravity,proto3" json:"gravity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+func (x *ScoreWeights) Reset() {
+	*x = ScoreWeights{}
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *ScoreWeights) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*ScoreWeights) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+func (x *ScoreWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,48 +697,95 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ScoreWeights.ProtoReflect.Descriptor instead.
+func (*ScoreWeights) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Server) GetHttp() *Server_HTTP {
+func (x *ScoreWeights) GetFavorite() float64 {
 	if x != nil {
-		return x.Http
+		return x.Favorite
 	}
-	return nil
+	return 0
 }
 
-func (x *Server) GetGrpc() *Server_GRPC {
+func (x *ScoreWeights) GetComment() float64 {
 	if x != nil {
-		return x.Grpc
+		return x.Comment
 	}
-	return nil
+	return 0
 }
 
-type Data struct {
+func (x *ScoreWeights) GetView() float64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *ScoreWeights) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *ScoreWeights) GetCollect() float64 {
+	if x != nil {
+		return x.Collect
+	}
+	return 0
+}
+
+func (x *ScoreWeights) GetBase() float64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *ScoreWeights) GetOffsetHours() float64 {
+	if x != nil {
+		return x.OffsetHours
+	}
+	return 0
+}
+
+func (x *ScoreWeights) GetGravity() float64 {
+	if x != nil {
+		return x.Gravity
+	}
+	return 0
+}
+
+// 定时重算 video:score，除 interval、dirty_interval 外修改配置后无需重启
+type Score struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                                // 全量重算活跃窗口的间隔
+	DirtyInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=dirty_interval,json=dirtyInterval,proto3" json:"dirty_interval,omitempty"` // 重算有互动的视频的间隔
+	Window        *durationpb.Duration   `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`                                    // 活跃窗口，更早发布的视频移出榜单
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	MaxSize       int64                  `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"` // 榜单最多保留的视频数，0 不限制
+	Weights       *ScoreWeights          `protobuf:"bytes,6,opt,name=weights,proto3" json:"weights,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data) String() string {
+func (x *Score) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data) ProtoMessage() {}
+func (*Score) ProtoMessage() {}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,48 +796,80 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *Score) GetInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Database
+		return x.Interval
 	}
 	return nil
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *Score) GetDirtyInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Redis
+		return x.DirtyInterval
 	}
 	return nil
 }
 
-type ElasticsearchIndex struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Index         string                 `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Score) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
 }
 
-func (x *ElasticsearchIndex) Reset() {
-	*x = ElasticsearchIndex{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+func (x *Score) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Score) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *Score) GetWeights() *ScoreWeights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+// 关注流推送，视频发布后写入粉丝的收件箱，与 feed-service 的 feed.following 保持一致
+type Fanout struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Topic              string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"` // videos 表的 canal 变更 topic
+	GroupId            string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	BigAuthorThreshold int32                  `protobuf:"varint,3,opt,name=big_author_threshold,json=bigAuthorThreshold,proto3" json:"big_author_threshold,omitempty"` // 粉丝数不低于该值的作者不推送，由 feed-service 读取时拉取
+	InboxSize          int32                  `protobuf:"varint,4,opt,name=inbox_size,json=inboxSize,proto3" json:"inbox_size,omitempty"`
+	BatchSize          int32                  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批推送的粉丝数
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Fanout) Reset() {
+	*x = Fanout{}
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ElasticsearchIndex) String() string {
+func (x *Fanout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ElasticsearchIndex) ProtoMessage() {}
+func (*Fanout) ProtoMessage() {}
 
-func (x *ElasticsearchIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+func (x *Fanout) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,48 +880,73 @@ func (x *ElasticsearchIndex) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ElasticsearchIndex.ProtoReflect.Descriptor instead.
-func (*ElasticsearchIndex) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Fanout.ProtoReflect.Descriptor instead.
+func (*Fanout) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ElasticsearchIndex) GetTopic() string {
+func (x *Fanout) GetTopic() string {
 	if x != nil {
 		return x.Topic
 	}
 	return ""
 }
 
-func (x *ElasticsearchIndex) GetIndex() string {
+func (x *Fanout) GetGroupId() string {
 	if x != nil {
-		return x.Index
+		return x.GroupId
 	}
 	return ""
 }
 
-type Elasticsearch struct {
+func (x *Fanout) GetBigAuthorThreshold() int32 {
+	if x != nil {
+		return x.BigAuthorThreshold
+	}
+	return 0
+}
+
+func (x *Fanout) GetInboxSize() int32 {
+	if x != nil {
+		return x.InboxSize
+	}
+	return 0
+}
+
+func (x *Fanout) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// 用户兴趣画像各行为的权重
+type ProfileWeights struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Indices       []*ElasticsearchIndex  `protobuf:"bytes,2,rep,name=indices,proto3" json:"indices,omitempty"`
+	Like          float64                `protobuf:"fixed64,1,opt,name=like,proto3" json:"like,omitempty"` // 点赞，取消点赞时扣除
+	Comment       float64                `protobuf:"fixed64,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Watch         float64                `protobuf:"fixed64,3,opt,name=watch,proto3" json:"watch,omitempty"`   // 按观看进度折算，看完整个视频得到全部权重
+	Finish        float64                `protobuf:"fixed64,4,opt,name=finish,proto3" json:"finish,omitempty"` // 完播额外加分
+	Follow        float64                `protobuf:"fixed64,5,opt,name=follow,proto3" json:"follow,omitempty"` // 关注作者，取关时扣除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Elasticsearch) Reset() {
-	*x = Elasticsearch{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *ProfileWeights) Reset() {
+	*x = ProfileWeights{}
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Elasticsearch) String() string {
+func (x *ProfileWeights) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Elasticsearch) ProtoMessage() {}
+func (*ProfileWeights) ProtoMessage() {}
 
-func (x *Elasticsearch) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *ProfileWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,49 +957,78 @@ func (x *Elasticsearch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Elasticsearch.ProtoReflect.Descriptor instead.
-func (*Elasticsearch) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ProfileWeights.ProtoReflect.Descriptor instead.
+func (*ProfileWeights) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Elasticsearch) GetAddresses() []string {
+func (x *ProfileWeights) GetLike() float64 {
 	if x != nil {
-		return x.Addresses
+		return x.Like
 	}
-	return nil
+	return 0
 }
 
-func (x *Elasticsearch) GetIndices() []*ElasticsearchIndex {
+func (x *ProfileWeights) GetComment() float64 {
 	if x != nil {
-		return x.Indices
+		return x.Comment
 	}
-	return nil
+	return 0
 }
 
-type Kafka struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Brokers       []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Topics        []string               `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProfileWeights) GetWatch() float64 {
+	if x != nil {
+		return x.Watch
+	}
+	return 0
 }
 
-func (x *Kafka) Reset() {
-	*x = Kafka{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+func (x *ProfileWeights) GetFinish() float64 {
+	if x != nil {
+		return x.Finish
+	}
+	return 0
+}
+
+func (x *ProfileWeights) GetFollow() float64 {
+	if x != nil {
+		return x.Follow
+	}
+	return 0
+}
+
+// 用户兴趣画像，从点赞、评论、播放、关注事件累计话题与作者偏好，与 feed-service 的 feed.recommend 保持一致
+type Profile struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	VideoEventTopic    string                 `protobuf:"bytes,1,opt,name=video_event_topic,json=videoEventTopic,proto3" json:"video_event_topic,omitempty"`          // 点赞、评论事件
+	RelationEventTopic string                 `protobuf:"bytes,2,opt,name=relation_event_topic,json=relationEventTopic,proto3" json:"relation_event_topic,omitempty"` // 关注、取关事件
+	PlayTopic          string                 `protobuf:"bytes,3,opt,name=play_topic,json=playTopic,proto3" json:"play_topic,omitempty"`                              // 播放事件
+	GroupId            string                 `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Weights            *ProfileWeights        `protobuf:"bytes,5,opt,name=weights,proto3" json:"weights,omitempty"`
+	MaxTags            int32                  `protobuf:"varint,6,opt,name=max_tags,json=maxTags,proto3" json:"max_tags,omitempty"` // 每个用户最多保留的话题数，超出时淘汰偏好最低的
+	MaxAuthors         int32                  `protobuf:"varint,7,opt,name=max_authors,json=maxAuthors,proto3" json:"max_authors,omitempty"`
+	Ttl                *durationpb.Duration   `protobuf:"bytes,8,opt,name=ttl,proto3" json:"ttl,omitempty"`       // 画像无更新后的过期时间
+	Decay              float64                `protobuf:"fixed64,9,opt,name=decay,proto3" json:"decay,omitempty"` // 每个衰减周期偏好乘以该系数，使兴趣随时间迁移
+	DecayInterval      *durationpb.Duration   `protobuf:"bytes,10,opt,name=decay_interval,json=decayInterval,proto3" json:"decay_interval,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Kafka) String() string {
+func (x *Profile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Kafka) ProtoMessage() {}
+func (*Profile) ProtoMessage() {}
 
-func (x *Kafka) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,28 +1039,77 @@ func (x *Kafka) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Kafka.ProtoReflect.Descriptor instead.
-func (*Kafka) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Kafka) GetBrokers() []string {
+func (x *Profile) GetVideoEventTopic() string {
 	if x != nil {
-		return x.Brokers
+		return x.VideoEventTopic
 	}
-	return nil
+	return ""
 }
 
-func (x *Kafka) GetGroupId() string {
+func (x *Profile) GetRelationEventTopic() string {
+	if x != nil {
+		return x.RelationEventTopic
+	}
+	return ""
+}
+
+func (x *Profile) GetPlayTopic() string {
+	if x != nil {
+		return x.PlayTopic
+	}
+	return ""
+}
+
+func (x *Profile) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *Kafka) GetTopics() []string {
+func (x *Profile) GetWeights() *ProfileWeights {
 	if x != nil {
-		return x.Topics
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Profile) GetMaxTags() int32 {
+	if x != nil {
+		return x.MaxTags
+	}
+	return 0
+}
+
+func (x *Profile) GetMaxAuthors() int32 {
+	if x != nil {
+		return x.MaxAuthors
+	}
+	return 0
+}
+
+func (x *Profile) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Profile) GetDecay() float64 {
+	if x != nil {
+		return x.Decay
+	}
+	return 0
+}
+
+func (x *Profile) GetDecayInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DecayInterval
 	}
 	return nil
 }
@@ -369,7 +1125,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -381,7 +1137,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +1185,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +1197,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +1244,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +1256,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +1298,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +1310,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,17 +1354,82 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Publish_Outbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Retention     *durationpb.Duration   `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"` // 已发布事件的保留时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Publish_Outbox) Reset() {
+	*x = Publish_Outbox{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Publish_Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Publish_Outbox) ProtoMessage() {}
+
+func (x *Publish_Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Publish_Outbox.ProtoReflect.Descriptor instead.
+func (*Publish_Outbox) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Publish_Outbox) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Publish_Outbox) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Publish_Outbox) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xc7\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xa0\x03\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12?\n" +
 	"\relasticsearch\x18\x03 \x01(\v2\x19.kratos.api.ElasticsearchR\relasticsearch\x12'\n" +
-	"\x05kafka\x18\x04 \x01(\v2\x11.kratos.api.KafkaR\x05kafka\"\xb8\x02\n" +
+	"\x05kafka\x18\x04 \x01(\v2\x11.kratos.api.KafkaR\x05kafka\x12$\n" +
+	"\x04play\x18\x05 \x01(\v2\x10.kratos.api.PlayR\x04play\x12-\n" +
+	"\apublish\x18\x06 \x01(\v2\x13.kratos.api.PublishR\apublish\x12'\n" +
+	"\x05score\x18\a \x01(\v2\x11.kratos.api.ScoreR\x05score\x12*\n" +
+	"\x06fanout\x18\b \x01(\v2\x12.kratos.api.FanoutR\x06fanout\x12-\n" +
+	"\aprofile\x18\t \x01(\v2\x13.kratos.api.ProfileR\aprofile\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -630,17 +1451,102 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"@\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\xf4\x02\n" +
 	"\x12ElasticsearchIndex\x12\x14\n" +
 	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\tR\x05index\"g\n" +
+	"\x05index\x18\x02 \x01(\tR\x05index\x12O\n" +
+	"\vfield_types\x18\x03 \x03(\v2..kratos.api.ElasticsearchIndex.FieldTypesEntryR\n" +
+	"fieldTypes\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x12L\n" +
+	"\n" +
+	"index_when\x18\x05 \x03(\v2-.kratos.api.ElasticsearchIndex.IndexWhenEntryR\tindexWhen\x1a=\n" +
+	"\x0fFieldTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eIndexWhenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xec\x01\n" +
+	"\rSuggestSource\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05split\x18\x04 \x01(\bR\x05split\x12G\n" +
+	"\n" +
+	"index_when\x18\x05 \x03(\v2(.kratos.api.SuggestSource.IndexWhenEntryR\tindexWhen\x1a<\n" +
+	"\x0eIndexWhenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd0\x01\n" +
 	"\rElasticsearch\x12\x1c\n" +
 	"\taddresses\x18\x01 \x03(\tR\taddresses\x128\n" +
-	"\aindices\x18\x02 \x03(\v2\x1e.kratos.api.ElasticsearchIndexR\aindices\"T\n" +
+	"\aindices\x18\x02 \x03(\v2\x1e.kratos.api.ElasticsearchIndexR\aindices\x12#\n" +
+	"\rsuggest_index\x18\x03 \x01(\tR\fsuggestIndex\x12B\n" +
+	"\x0fsuggest_sources\x18\x04 \x03(\v2\x19.kratos.api.SuggestSourceR\x0esuggestSources\"T\n" +
 	"\x05Kafka\x12\x18\n" +
 	"\abrokers\x18\x01 \x03(\tR\abrokers\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x16\n" +
-	"\x06topics\x18\x03 \x03(\tR\x06topicsB Z\x1ejob-service/internal/conf;confb\x06proto3"
+	"\x06topics\x18\x03 \x03(\tR\x06topics\"\x9e\x01\n" +
+	"\x04Play\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12@\n" +
+	"\x0eflush_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSizeJ\x04\b\x05\x10\x06\"\xd9\x02\n" +
+	"\aPublish\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12*\n" +
+	"\x11video_event_topic\x18\x03 \x01(\tR\x0fvideoEventTopic\x122\n" +
+	"\x06outbox\x18\x04 \x01(\v2\x1a.kratos.api.Publish.OutboxR\x06outbox\x1a\x97\x01\n" +
+	"\x06Outbox\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x127\n" +
+	"\tretention\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tretention\"\xd9\x01\n" +
+	"\fScoreWeights\x12\x1a\n" +
+	"\bfavorite\x18\x01 \x01(\x01R\bfavorite\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\x01R\acomment\x12\x12\n" +
+	"\x04view\x18\x03 \x01(\x01R\x04view\x12\x14\n" +
+	"\x05share\x18\x04 \x01(\x01R\x05share\x12\x18\n" +
+	"\acollect\x18\x05 \x01(\x01R\acollect\x12\x12\n" +
+	"\x04base\x18\x06 \x01(\x01R\x04base\x12!\n" +
+	"\foffset_hours\x18\a \x01(\x01R\voffsetHours\x12\x18\n" +
+	"\agravity\x18\b \x01(\x01R\agravity\"\xa1\x02\n" +
+	"\x05Score\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12@\n" +
+	"\x0edirty_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rdirtyInterval\x121\n" +
+	"\x06window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12\x19\n" +
+	"\bmax_size\x18\x05 \x01(\x03R\amaxSize\x122\n" +
+	"\aweights\x18\x06 \x01(\v2\x18.kratos.api.ScoreWeightsR\aweights\"\xa9\x01\n" +
+	"\x06Fanout\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x120\n" +
+	"\x14big_author_threshold\x18\x03 \x01(\x05R\x12bigAuthorThreshold\x12\x1d\n" +
+	"\n" +
+	"inbox_size\x18\x04 \x01(\x05R\tinboxSize\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\"\x84\x01\n" +
+	"\x0eProfileWeights\x12\x12\n" +
+	"\x04like\x18\x01 \x01(\x01R\x04like\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\x01R\acomment\x12\x14\n" +
+	"\x05watch\x18\x03 \x01(\x01R\x05watch\x12\x16\n" +
+	"\x06finish\x18\x04 \x01(\x01R\x06finish\x12\x16\n" +
+	"\x06follow\x18\x05 \x01(\x01R\x06follow\"\x98\x03\n" +
+	"\aProfile\x12*\n" +
+	"\x11video_event_topic\x18\x01 \x01(\tR\x0fvideoEventTopic\x120\n" +
+	"\x14relation_event_topic\x18\x02 \x01(\tR\x12relationEventTopic\x12\x1d\n" +
+	"\n" +
+	"play_topic\x18\x03 \x01(\tR\tplayTopic\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\x124\n" +
+	"\aweights\x18\x05 \x01(\v2\x1a.kratos.api.ProfileWeightsR\aweights\x12\x19\n" +
+	"\bmax_tags\x18\x06 \x01(\x05R\amaxTags\x12\x1f\n" +
+	"\vmax_authors\x18\a \x01(\x05R\n" +
+	"maxAuthors\x12+\n" +
+	"\x03ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12\x14\n" +
+	"\x05decay\x18\t \x01(\x01R\x05decay\x12@\n" +
+	"\x0edecay_interval\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\rdecayIntervalB Z\x1ejob-service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -654,39 +1560,72 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
 	(*Data)(nil),                // 2: kratos.api.Data
 	(*ElasticsearchIndex)(nil),  // 3: kratos.api.ElasticsearchIndex
-	(*Elasticsearch)(nil),       // 4: kratos.api.Elasticsearch
-	(*Kafka)(nil),               // 5: kratos.api.Kafka
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 8: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 9: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 10: google.protobuf.Duration
+	(*SuggestSource)(nil),       // 4: kratos.api.SuggestSource
+	(*Elasticsearch)(nil),       // 5: kratos.api.Elasticsearch
+	(*Kafka)(nil),               // 6: kratos.api.Kafka
+	(*Play)(nil),                // 7: kratos.api.Play
+	(*Publish)(nil),             // 8: kratos.api.Publish
+	(*ScoreWeights)(nil),        // 9: kratos.api.ScoreWeights
+	(*Score)(nil),               // 10: kratos.api.Score
+	(*Fanout)(nil),              // 11: kratos.api.Fanout
+	(*ProfileWeights)(nil),      // 12: kratos.api.ProfileWeights
+	(*Profile)(nil),             // 13: kratos.api.Profile
+	(*Server_HTTP)(nil),         // 14: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 15: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 16: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 17: kratos.api.Data.Redis
+	nil,                         // 18: kratos.api.ElasticsearchIndex.FieldTypesEntry
+	nil,                         // 19: kratos.api.ElasticsearchIndex.IndexWhenEntry
+	nil,                         // 20: kratos.api.SuggestSource.IndexWhenEntry
+	(*Publish_Outbox)(nil),      // 21: kratos.api.Publish.Outbox
+	(*durationpb.Duration)(nil), // 22: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	4,  // 2: kratos.api.Bootstrap.elasticsearch:type_name -> kratos.api.Elasticsearch
-	5,  // 3: kratos.api.Bootstrap.kafka:type_name -> kratos.api.Kafka
-	6,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	9,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	3,  // 8: kratos.api.Elasticsearch.indices:type_name -> kratos.api.ElasticsearchIndex
-	10, // 9: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	10, // 10: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	10, // 11: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	10, // 12: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 2: kratos.api.Bootstrap.elasticsearch:type_name -> kratos.api.Elasticsearch
+	6,  // 3: kratos.api.Bootstrap.kafka:type_name -> kratos.api.Kafka
+	7,  // 4: kratos.api.Bootstrap.play:type_name -> kratos.api.Play
+	8,  // 5: kratos.api.Bootstrap.publish:type_name -> kratos.api.Publish
+	10, // 6: kratos.api.Bootstrap.score:type_name -> kratos.api.Score
+	11, // 7: kratos.api.Bootstrap.fanout:type_name -> kratos.api.Fanout
+	13, // 8: kratos.api.Bootstrap.profile:type_name -> kratos.api.Profile
+	14, // 9: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	15, // 10: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	16, // 11: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	17, // 12: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	18, // 13: kratos.api.ElasticsearchIndex.field_types:type_name -> kratos.api.ElasticsearchIndex.FieldTypesEntry
+	19, // 14: kratos.api.ElasticsearchIndex.index_when:type_name -> kratos.api.ElasticsearchIndex.IndexWhenEntry
+	20, // 15: kratos.api.SuggestSource.index_when:type_name -> kratos.api.SuggestSource.IndexWhenEntry
+	3,  // 16: kratos.api.Elasticsearch.indices:type_name -> kratos.api.ElasticsearchIndex
+	4,  // 17: kratos.api.Elasticsearch.suggest_sources:type_name -> kratos.api.SuggestSource
+	22, // 18: kratos.api.Play.flush_interval:type_name -> google.protobuf.Duration
+	22, // 19: kratos.api.Publish.interval:type_name -> google.protobuf.Duration
+	21, // 20: kratos.api.Publish.outbox:type_name -> kratos.api.Publish.Outbox
+	22, // 21: kratos.api.Score.interval:type_name -> google.protobuf.Duration
+	22, // 22: kratos.api.Score.dirty_interval:type_name -> google.protobuf.Duration
+	22, // 23: kratos.api.Score.window:type_name -> google.protobuf.Duration
+	9,  // 24: kratos.api.Score.weights:type_name -> kratos.api.ScoreWeights
+	12, // 25: kratos.api.Profile.weights:type_name -> kratos.api.ProfileWeights
+	22, // 26: kratos.api.Profile.ttl:type_name -> google.protobuf.Duration
+	22, // 27: kratos.api.Profile.decay_interval:type_name -> google.protobuf.Duration
+	22, // 28: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	22, // 29: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	22, // 30: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	22, // 31: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	22, // 32: kratos.api.Publish.Outbox.interval:type_name -> google.protobuf.Duration
+	22, // 33: kratos.api.Publish.Outbox.retention:type_name -> google.protobuf.Duration
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -700,7 +1639,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,370 @@
+package job
+
+import (
+	"common/invalidate"
+	"common/rediskey"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"job-service/internal/conf"
+	"strconv"
+	"time"
+)
+
+const (
+	fanoutRetryMin = 100 * time.Millisecond
+	fanoutRetryMax = 10 * time.Second
+)
+
+// 只推送到已存在的收件箱，不存在的由 feed-service 读取时从数据库重建；
+// 排名 0 是 score 为 0 的占位成员，截断时保留
+var fanoutScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZREMRANGEBYRANK', KEYS[1], 1, -(tonumber(ARGV[3]) + 1))
+return 1
+`)
+
+// videos 表的 canal 变更消息，old 为 UPDATE 时被修改字段的旧值
+type videoBinlog struct {
+	Type  string                   `json:"type"`
+	Table string                   `json:"table"`
+	Data  []map[string]interface{} `json:"data"`
+	Old   []map[string]interface{} `json:"old"`
+}
+
+// 修改后需要失效本地缓存的字段，计数由计数缓存维护，不在此列
+var videoCardColumns = []string{"title", "cover_url", "user_id", "publish_status", "is_public", "delete_at", "created_at"}
+
+// 刚发布的视频，或从收件箱撤回的视频
+type publishedVideo struct {
+	ID          int64
+	UserID      int64
+	PublishTime int64  // 毫秒
+	CityCode    string // 撤回时为不可见前的城市代码
+}
+
+// 关注流推送 Worker，消费 videos 表变更，把刚发布的视频推送到粉丝收件箱，并通知其他服务失效缓存的视频卡片；
+// 视频可见性变化时重算其话题的视频数
+type FanoutWork struct {
+	reader             *kafka.Reader
+	db                 *gorm.DB
+	rdb                *redis.Client
+	bigAuthorThreshold int32
+	inboxSize          int
+	batchSize          int
+	log                *log.Helper
+}
+
+func NewFanoutWork(kc *conf.Kafka, fc *conf.Fanout, db *gorm.DB, rdb *redis.Client, logger log.Logger) *FanoutWork {
+	fw := &FanoutWork{
+		db:                 db,
+		rdb:                rdb,
+		bigAuthorThreshold: 10000,
+		inboxSize:          1000,
+		batchSize:          500,
+		log:                log.NewHelper(logger),
+	}
+	if fc.GetBigAuthorThreshold() > 0 {
+		fw.bigAuthorThreshold = fc.GetBigAuthorThreshold()
+	}
+	if fc.GetInboxSize() > 0 {
+		fw.inboxSize = int(fc.GetInboxSize())
+	}
+	if fc.GetBatchSize() > 0 {
+		fw.batchSize = int(fc.GetBatchSize())
+	}
+	if fc.GetTopic() != "" {
+		fw.reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers: kc.GetBrokers(),
+			Topic:   fc.GetTopic(),
+			GroupID: fc.GetGroupId(),
+		})
+	}
+	return fw
+}
+
+// 启动消费循环，推送完成后再提交 offset，失败时退避重试
+func (fw *FanoutWork) Start(ctx context.Context) error {
+	if fw.reader == nil {
+		return nil
+	}
+	fw.log.WithContext(ctx).Info("fanout work start")
+
+	for {
+		m, err := fw.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fw.log.Errorf("fetch video binlog failed: %v", err)
+			return err
+		}
+		published, retracted, stale := fw.parse(m)
+		fw.invalidate(ctx, stale)
+		for _, v := range published {
+			if !fw.withRetry(ctx, v, fw.fanout) || !fw.withRetry(ctx, v, fw.recountTags) {
+				return nil
+			}
+		}
+		for _, v := range retracted {
+			if !fw.withRetry(ctx, v, fw.retract) || !fw.withRetry(ctx, v, fw.recountTags) {
+				return nil
+			}
+		}
+		if err := fw.reader.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			fw.log.Errorf("commit video binlog offset failed: %v", err)
+		}
+	}
+}
+
+// parse 找出本次变更中刚发布的公开视频：插入时即发布，或更新时 publish_status、is_public 变为已发布且公开；
+// 以及需要从收件箱撤回的视频：被删除、软删除或改为私密；
+// 同时找出删除或修改了卡片字段的视频，供其他服务失效本地缓存
+func (fw *FanoutWork) parse(m kafka.Message) ([]publishedVideo, []publishedVideo, []int64) {
+	msg := new(videoBinlog)
+	if err := json.Unmarshal(m.Value, msg); err != nil {
+		fw.log.Errorf("unmarshal video binlog failed: %v", err)
+		return nil, nil, nil
+	}
+	stale := staleVideoIDs(msg)
+
+	var published, retracted []publishedVideo
+	for i, row := range msg.Data {
+		visible := videoVisible(row, nil)
+		switch msg.Type {
+		case "INSERT":
+			if !visible {
+				continue
+			}
+		case "UPDATE":
+			// 可见性未变化的修改不处理
+			if i >= len(msg.Old) || visible == videoVisible(row, msg.Old[i]) {
+				continue
+			}
+		case "DELETE":
+			if !visible {
+				continue
+			}
+			visible = false
+		default:
+			continue
+		}
+
+		v, err := parsePublishedVideo(row)
+		if err != nil {
+			fw.log.Errorf("parse published video failed: %v, row: %v", err, row)
+			continue
+		}
+		if visible {
+			published = append(published, v)
+		} else {
+			// 可见时按修改前的城市写入同城索引
+			if msg.Type == "UPDATE" {
+				if code, ok := msg.Old[i]["city_code"]; ok {
+					v.CityCode = binlogString(code)
+				}
+			}
+			retracted = append(retracted, v)
+		}
+	}
+	return published, retracted, stale
+}
+
+// videoVisible 视频是否已发布、公开且未删除，old 不为空时按修改前的值判断
+func videoVisible(row, old map[string]interface{}) bool {
+	value := func(col string) interface{} {
+		if v, ok := old[col]; ok {
+			return v
+		}
+		return row[col]
+	}
+	return binlogString(value("publish_status")) == strconv.Itoa(publishStatusPublished) &&
+		binlogString(value("is_public")) == "1" && value("delete_at") == nil
+}
+
+func parsePublishedVideo(row map[string]interface{}) (publishedVideo, error) {
+	id, err1 := strconv.ParseInt(binlogString(row["id"]), 10, 64)
+	uid, err2 := strconv.ParseInt(binlogString(row["user_id"]), 10, 64)
+	// canal 的时间为数据库时区的 DATETIME 字符串
+	createdAt, err3 := time.ParseInLocation(time.DateTime, binlogString(row["created_at"]), time.Local)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return publishedVideo{}, err
+	}
+	return publishedVideo{ID: id, UserID: uid, PublishTime: createdAt.UnixMilli(), CityCode: binlogString(row["city_code"])}, nil
+}
+
+// staleVideoIDs 被删除或修改了卡片字段的视频
+func staleVideoIDs(msg *videoBinlog) []int64 {
+	if msg.Type != "UPDATE" && msg.Type != "DELETE" {
+		return nil
+	}
+	var ids []int64
+	for i, row := range msg.Data {
+		if msg.Type == "UPDATE" {
+			if i >= len(msg.Old) {
+				continue
+			}
+			changed := false
+			for _, col := range videoCardColumns {
+				if _, ok := msg.Old[i][col]; ok {
+					changed = true
+					break
+				}
+			}
+			if !changed {
+				continue
+			}
+		}
+		if id, err := strconv.ParseInt(binlogString(row["id"]), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// invalidate 通知其他服务删除本地缓存的视频卡片，发布失败时等本地缓存过期
+func (fw *FanoutWork) invalidate(ctx context.Context, ids []int64) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := invalidate.Publish(ctx, fw.rdb, invalidate.KindVideo, ids...); err != nil {
+		fw.log.Warnf("publish video cache invalidation failed: %v", err)
+	}
+}
+
+// withRetry 推送或撤回直到成功，ctx 取消时返回 false
+func (fw *FanoutWork) withRetry(ctx context.Context, v publishedVideo, do func(context.Context, publishedVideo) error) bool {
+	backoff := fanoutRetryMin
+	for {
+		err := do(ctx, v)
+		if err == nil {
+			return true
+		}
+		fw.log.Errorf("fanout video %d failed, retry in %s: %v", v.ID, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, fanoutRetryMax)
+	}
+}
+
+// fanout 按粉丝 id 分批写入收件箱，大 V 不推送；重复推送结果相同
+func (fw *FanoutWork) fanout(ctx context.Context, v publishedVideo) error {
+	member := strconv.FormatInt(v.ID, 10)
+	total, err := fw.eachFollowerBatch(ctx, v.UserID, func(pipe redis.Pipeliner, followerID int64) {
+		fanoutScript.Eval(ctx, pipe, []string{rediskey.FeedInbox(followerID)}, v.PublishTime, member, fw.inboxSize)
+	})
+	if err != nil {
+		return err
+	}
+	fw.log.WithContext(ctx).Infof("fanout video %d of user %d to %d followers", v.ID, v.UserID, total)
+	return nil
+}
+
+// retract 从附近、同城索引和粉丝收件箱移除视频；大 V 的视频没有推送，读取时从数据库过滤
+func (fw *FanoutWork) retract(ctx context.Context, v publishedVideo) error {
+	member := strconv.FormatInt(v.ID, 10)
+	// 不移除时附近、同城列表的候选会被不可见的视频占满
+	pipe := fw.rdb.Pipeline()
+	pipe.ZRem(ctx, rediskey.VideoGeo, member)
+	if v.CityCode != "" {
+		pipe.ZRem(ctx, rediskey.VideoCity(v.CityCode), member)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	total, err := fw.eachFollowerBatch(ctx, v.UserID, func(pipe redis.Pipeliner, followerID int64) {
+		pipe.ZRem(ctx, rediskey.FeedInbox(followerID), member)
+	})
+	if err != nil {
+		return err
+	}
+	fw.log.WithContext(ctx).Infof("retract video %d of user %d from %d followers", v.ID, v.UserID, total)
+	return nil
+}
+
+// recountTags 按可见视频重算视频所属话题的视频数；重算结果与处理次数无关，重复投递、重试不会重复计数
+func (fw *FanoutWork) recountTags(ctx context.Context, v publishedVideo) error {
+	return fw.db.WithContext(ctx).Exec(`
+UPDATE tags SET video_cnt = (
+	SELECT COUNT(*) FROM video_tags vt JOIN videos v ON v.id = vt.video_id
+	WHERE vt.tag_id = tags.id AND v.publish_status = ? AND v.is_public = 1 AND v.delete_at IS NULL
+)
+WHERE id IN (SELECT tag_id FROM video_tags WHERE video_id = ?)`, publishStatusPublished, v.ID).Error
+}
+
+// eachFollowerBatch 按粉丝 id 分批对收件箱执行写入，每批一次 pipeline；大 V 跳过，返回处理的粉丝数
+func (fw *FanoutWork) eachFollowerBatch(ctx context.Context, authorID int64, write func(pipe redis.Pipeliner, followerID int64)) (int64, error) {
+	var followerCount int64
+	err := fw.db.WithContext(ctx).Table("users").Select("follower_count").Where("id = ?", authorID).Scan(&followerCount).Error
+	if err != nil {
+		return 0, err
+	}
+	if followerCount >= int64(fw.bigAuthorThreshold) {
+		return 0, nil
+	}
+
+	var lastID, total int64
+	for {
+		var rows []struct {
+			ID     int64
+			UserID int64
+		}
+		err := fw.db.WithContext(ctx).
+			Table("relation").
+			Select("id", "user_id").
+			Where("to_user_id = ? AND deleted_at IS NULL AND id > ?", authorID, lastID).
+			Order("id").
+			Limit(fw.batchSize).
+			Find(&rows).Error
+		if err != nil {
+			return total, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		pipe := fw.rdb.Pipeline()
+		for _, r := range rows {
+			write(pipe, r.UserID)
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return total, err
+		}
+		lastID = rows[len(rows)-1].ID
+		total += int64(len(rows))
+		if len(rows) < fw.batchSize {
+			break
+		}
+	}
+	return total, nil
+}
+
+func (fw *FanoutWork) Stop(ctx context.Context) error {
+	if fw.reader == nil {
+		return nil
+	}
+	fw.log.WithContext(ctx).Info("fanout work stop")
+	return fw.reader.Close()
+}
+
+// binlogString canal 的字段值通常为字符串，数字也按字符串处理
+func binlogString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return ""
+}
@@ -2,4 +2,4 @@ package job
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewJobWrok, NewESClient, NewKafkaReader)
+var ProviderSet = wire.NewSet(NewJobWrok, NewESClient, NewKafkaReader, NewPlayWork, NewPublishWork, NewScoreWork, NewFanoutWork, NewProfileWork, NewOutboxRelay, NewDB, NewRedisClient)
@@ -0,0 +1,273 @@
+package job
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// 字段类型
+const (
+	FieldTypeKeyword  = "keyword"  // 关键字
+	FieldTypeKeywords = "keywords" // 逗号分隔的字符串，写入时拆成关键字数组
+	FieldTypeText     = "text"     // 全文检索
+	FieldTypeLong     = "long"
+	FieldTypeDouble   = "double"
+	FieldTypeBoolean  = "boolean"   // canal 中 tinyint(1) 为 "0"/"1"
+	FieldTypeDate     = "date"      // canal 中格式为 yyyy-MM-dd HH:mm:ss
+	FieldTypeGeoPoint = "geo_point" // "lat,lon" 字符串，为空时不写入
+)
+
+// canal 同步过来的时间格式
+const dateFormat = "yyyy-MM-dd HH:mm:ss||strict_date_optional_time||epoch_millis"
+
+// ensureMappings 按配置为索引创建/更新字段映射与字段白名单，需在写入文档前执行
+func (jw *JobWork) ensureMappings(ctx context.Context) {
+	indices := make(map[string]bool, len(jw.indexFields)+len(jw.indexAllowed))
+	for index := range jw.indexFields {
+		indices[index] = true
+	}
+	for index := range jw.indexAllowed {
+		indices[index] = true
+	}
+	for index := range indices {
+		fields := jw.indexFields[index]
+		props := make(map[string]types.Property, len(fields))
+		for field, typ := range fields {
+			switch typ {
+			case FieldTypeKeyword, FieldTypeKeywords:
+				props[field] = types.NewKeywordProperty()
+			case FieldTypeText:
+				props[field] = types.NewTextProperty()
+			case FieldTypeLong:
+				props[field] = types.NewLongNumberProperty()
+			case FieldTypeDouble:
+				props[field] = types.NewDoubleNumberProperty()
+			case FieldTypeBoolean:
+				props[field] = types.NewBooleanProperty()
+			case FieldTypeGeoPoint:
+				props[field] = types.NewGeoPointProperty()
+			case FieldTypeDate:
+				p := types.NewDateProperty()
+				format := dateFormat
+				p.Format = &format
+				props[field] = p
+			default:
+				jw.log.WithContext(ctx).Warnf("unsupported field type %s for %s.%s", typ, index, field)
+			}
+		}
+		var sourceFields []string
+		for field := range jw.indexAllowed[index] {
+			sourceFields = append(sourceFields, field)
+		}
+		sort.Strings(sourceFields)
+		if len(props) == 0 && len(sourceFields) == 0 {
+			continue
+		}
+		jw.ensureIndex(ctx, index, props, sourceFields)
+	}
+	if jw.suggestIndex != "" {
+		jw.ensureIndex(ctx, jw.suggestIndex, suggestProperties(), nil)
+	}
+}
+
+// ensureIndex 按字段映射与字段白名单版本化索引，name 作为别名指向当前版本；已有字段不能修改类型，映射或白名单变化时
+// 新建一版索引，从旧索引重建数据后原子切换别名，重建时只保留白名单内的字段，已写入的敏感字段随之移除。
+// 旧版本只移除别名，确认无误后手动删除；升级前直接以 name 创建的索引在切换时删除
+func (jw *JobWork) ensureIndex(ctx context.Context, name string, props map[string]types.Property, sourceFields []string) {
+	target, err := versionedIndex(name, props, sourceFields)
+	if err != nil {
+		jw.log.WithContext(ctx).Errorf("build versioned index for %s failed: %v", name, err)
+		return
+	}
+	current, err := jw.resolveIndices(ctx, name)
+	if err != nil {
+		jw.log.WithContext(ctx).Errorf("resolve index %s failed: %v", name, err)
+		return
+	}
+	if len(current) == 1 && current[0] == target {
+		return
+	}
+
+	// 1. 新版本索引，上次重建中断时已存在
+	exists, err := jw.esClient.Indices.Exists(target).Do(ctx)
+	if err != nil {
+		jw.log.WithContext(ctx).Errorf("check index %s exists failed: %v", target, err)
+		return
+	}
+	if !exists {
+		if _, err := jw.esClient.Indices.Create(target).Mappings(&types.TypeMapping{Properties: props}).Do(ctx); err != nil {
+			jw.log.WithContext(ctx).Errorf("create index %s failed: %v", target, err)
+			return
+		}
+	}
+
+	// 2. 从旧索引重建数据，在消费变更消息之前执行，期间没有写入
+	if len(current) > 0 {
+		resp, err := jw.esClient.Reindex().
+			Source(&types.ReindexSource{Index: current, SourceFields_: sourceFields}).
+			Dest(&types.ReindexDestination{Index: target}).
+			WaitForCompletion(true).
+			Refresh(true).
+			Do(ctx)
+		if err != nil {
+			jw.log.WithContext(ctx).Errorf("reindex %v to %s failed: %v", current, target, err)
+			return
+		}
+		if len(resp.Failures) > 0 {
+			jw.log.WithContext(ctx).Errorf("reindex %v to %s failed: %v", current, target, resp.Failures)
+			return
+		}
+		var created int64
+		if resp.Created != nil {
+			created = *resp.Created
+		}
+		jw.log.WithContext(ctx).Infof("reindexed %d docs from %v to %s", created, current, target)
+	}
+
+	// 3. 切换别名
+	actions := make([]types.IndicesAction, 0, len(current)+1)
+	for _, idx := range current {
+		idx := idx
+		if idx == name {
+			actions = append(actions, types.IndicesAction{RemoveIndex: &types.RemoveIndexAction{Index: &idx}})
+		} else {
+			actions = append(actions, types.IndicesAction{Remove: &types.RemoveAction{Index: &idx, Alias: &name}})
+		}
+	}
+	actions = append(actions, types.IndicesAction{Add: &types.AddAction{Index: &target, Alias: &name}})
+	if _, err := jw.esClient.Indices.UpdateAliases().Actions(actions...).Do(ctx); err != nil {
+		jw.log.WithContext(ctx).Errorf("switch alias %s to %s failed: %v", name, target, err)
+		return
+	}
+	jw.log.WithContext(ctx).Infof("alias %s now points to %s, previous: %v", name, target, current)
+}
+
+// resolveIndices name 对应的索引：别名指向的索引，或同名的索引；都不存在时为空
+func (jw *JobWork) resolveIndices(ctx context.Context, name string) ([]string, error) {
+	exists, err := jw.esClient.Indices.Exists(name).Do(ctx)
+	if err != nil || !exists {
+		return nil, err
+	}
+	resp, err := jw.esClient.Indices.Get(name).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(resp))
+	for idx := range resp {
+		res = append(res, idx)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// versionedIndex 版本号取字段映射与白名单的哈希，都不变时版本不变
+func versionedIndex(name string, props map[string]types.Property, sourceFields []string) (string, error) {
+	b, err := json.Marshal(struct {
+		Properties   map[string]types.Property `json:"properties"`
+		SourceFields []string                  `json:"source_fields,omitempty"`
+	}{props, sourceFields})
+	if err != nil {
+		return "", err
+	}
+	sum := sha1.Sum(b)
+	return name + "_" + hex.EncodeToString(sum[:4]), nil
+}
+
+// filterFields 按白名单过滤字段，避免敏感字段写入 es
+func (jw *JobWork) filterFields(index string, data map[string]interface{}) map[string]interface{} {
+	allowed, ok := jw.indexAllowed[index]
+	if !ok {
+		return data
+	}
+	res := make(map[string]interface{}, len(allowed))
+	for k, v := range data {
+		if allowed[k] {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// matchIndexWhen 判断变更行是否满足索引的写入条件
+func (jw *JobWork) matchIndexWhen(index string, data map[string]interface{}) bool {
+	return matchWhen(jw.indexWhen[index], data)
+}
+
+// matchWhen 行中字段值全部与条件相等，NULL 按空字符串比较
+func matchWhen(when map[string]string, data map[string]interface{}) bool {
+	for field, want := range when {
+		if toString(data[field]) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// convertFields 按字段类型转换 canal 中的原始值
+func (jw *JobWork) convertFields(index string, data map[string]interface{}) map[string]interface{} {
+	fields, ok := jw.indexFields[index]
+	if !ok {
+		return data
+	}
+	for field, typ := range fields {
+		v, ok := data[field]
+		if !ok {
+			continue
+		}
+		switch typ {
+		case FieldTypeKeywords:
+			data[field] = splitKeywords(v)
+		case FieldTypeLong:
+			if n, err := strconv.ParseInt(toString(v), 10, 64); err == nil {
+				data[field] = n
+			}
+		case FieldTypeDouble:
+			if n, err := strconv.ParseFloat(toString(v), 64); err == nil {
+				data[field] = n
+			}
+		case FieldTypeBoolean:
+			if b, err := strconv.ParseBool(toString(v)); err == nil {
+				data[field] = b
+			}
+		case FieldTypeGeoPoint:
+			// 空字符串无法解析为坐标，写入 null 表示没有位置
+			if toString(v) == "" {
+				data[field] = nil
+			}
+		}
+	}
+	return data
+}
+
+func toString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	}
+	return ""
+}
+
+func splitKeywords(v interface{}) []string {
+	s, ok := v.(string)
+	if !ok {
+		return []string{}
+	}
+	res := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
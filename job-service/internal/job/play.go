@@ -0,0 +1,209 @@
+package job
+
+import (
+	"common/rediskey"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"job-service/internal/conf"
+	"strings"
+	"time"
+)
+
+// 播放事件，与 video-service 的 params.PlayEvent 对应
+type PlayEvent struct {
+	VideoID  int64  `json:"video_id"`
+	UserID   int64  `json:"user_id"`
+	DeviceID string `json:"device_id"`
+	WatchMs  int64  `json:"watch_ms"`
+	Finished bool   `json:"finished"`
+	Unique   bool   `json:"unique"`
+	Ts       int64  `json:"ts"`
+}
+
+// 单个视频在一个批次内的汇总
+type playStat struct {
+	views    int64 // 去重后的有效播放
+	plays    int64 // 全部播放
+	watchMs  int64
+	finished int64
+}
+
+const (
+	// 视频观看时长统计 hash，字段 plays、watch_ms、finished
+	videoWatchKey = "video:watch:%d"
+)
+
+// 播放事件汇总 Worker，定时把播放数累加到数据库，并标记视频待重算分数
+type PlayWork struct {
+	reader        *kafka.Reader
+	db            *gorm.DB
+	rdb           *redis.Client
+	flushInterval time.Duration
+	batchSize     int
+	log           *log.Helper
+}
+
+func NewPlayWork(kc *conf.Kafka, pc *conf.Play, db *gorm.DB, rdb *redis.Client, logger log.Logger) *PlayWork {
+	flushInterval := 10 * time.Second
+	if pc.FlushInterval != nil && pc.FlushInterval.AsDuration() > 0 {
+		flushInterval = pc.FlushInterval.AsDuration()
+	}
+	batchSize := 1000
+	if pc.BatchSize > 0 {
+		batchSize = int(pc.BatchSize)
+	}
+	return &PlayWork{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: kc.Brokers,
+			Topic:   pc.Topic,
+			GroupID: pc.GroupId,
+		}),
+		db:            db,
+		rdb:           rdb,
+		flushInterval: flushInterval,
+		batchSize:     batchSize,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// NewDB 数据库连接
+func NewDB(c *conf.Data) (*gorm.DB, error) {
+	switch strings.ToLower(c.Database.Driver) {
+	case "mysql":
+		return gorm.Open(mysql.Open(c.Database.Source))
+	}
+	return nil, errors.New("connect db failed unsupported db driver")
+}
+
+// NewRedisClient 连接redis
+func NewRedisClient(c *conf.Data) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:         c.Redis.Addr,
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+	})
+}
+
+// 启动消费循环，攒够一批或到达刷新间隔时写库并提交 offset
+func (pw *PlayWork) Start(ctx context.Context) error {
+	pw.log.WithContext(ctx).Info("play work start")
+
+	stats := make(map[int64]*playStat)
+	pending := make([]kafka.Message, 0, pw.batchSize)
+	deadline := time.Now().Add(pw.flushInterval)
+
+	for {
+		fetchCtx, cancel := context.WithDeadline(ctx, deadline)
+		m, err := pw.reader.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			// 上层 ctx 被取消，刷新剩余数据后退出
+			if ctx.Err() != nil {
+				pw.flush(context.Background(), stats, pending)
+				return nil
+			}
+			if !errors.Is(err, context.DeadlineExceeded) {
+				pw.log.Errorf("fetch play message failed: %v", err)
+				return err
+			}
+		} else {
+			pending = append(pending, m)
+			pw.collect(stats, m)
+		}
+
+		if len(pending) >= pw.batchSize || !time.Now().Before(deadline) {
+			if pw.flush(ctx, stats, pending) {
+				stats = make(map[int64]*playStat)
+				pending = pending[:0]
+			}
+			deadline = time.Now().Add(pw.flushInterval)
+		}
+	}
+}
+
+// 解析播放事件并按视频汇总
+func (pw *PlayWork) collect(stats map[int64]*playStat, m kafka.Message) {
+	event := new(PlayEvent)
+	if err := json.Unmarshal(m.Value, event); err != nil {
+		pw.log.Errorf("unmarshal play event failed: %v, value: %s", err, string(m.Value))
+		return
+	}
+	if event.VideoID <= 0 {
+		return
+	}
+	st, ok := stats[event.VideoID]
+	if !ok {
+		st = &playStat{}
+		stats[event.VideoID] = st
+	}
+	st.plays++
+	st.watchMs += event.WatchMs
+	if event.Unique {
+		st.views++
+	}
+	if event.Finished {
+		st.finished++
+	}
+}
+
+// 写入一个批次，成功后提交 offset；失败时保留数据等待下次重试
+func (pw *PlayWork) flush(ctx context.Context, stats map[int64]*playStat, pending []kafka.Message) bool {
+	if len(pending) == 0 {
+		return true
+	}
+
+	// 1. 累加 view_cnt，话题播放数一并累加
+	err := pw.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for videoID, st := range stats {
+			if st.views == 0 {
+				continue
+			}
+			if err := tx.Exec("UPDATE videos SET view_cnt = view_cnt + ? WHERE id = ?", st.views, videoID).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("UPDATE tags t JOIN video_tags vt ON vt.tag_id = t.id SET t.view_cnt = t.view_cnt + ? WHERE vt.video_id = ?", st.views, videoID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		pw.log.WithContext(ctx).Errorf("flush play stats to db failed: %v", err)
+		return false
+	}
+
+	// 2. 标记待重算分数，由 ScoreWork 按 score.weights 计算；累加观看时长
+	pipe := pw.rdb.Pipeline()
+	for videoID, st := range stats {
+		if st.views > 0 {
+			pipe.SAdd(ctx, rediskey.VideoScoreDirty, videoID)
+		}
+		key := fmt.Sprintf(videoWatchKey, videoID)
+		pipe.HIncrBy(ctx, key, "plays", st.plays)
+		pipe.HIncrBy(ctx, key, "watch_ms", st.watchMs)
+		pipe.HIncrBy(ctx, key, "finished", st.finished)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		// 数据库已写入，redis 失败不回滚，避免重复累加 view_cnt
+		pw.log.WithContext(ctx).Errorf("flush play stats to redis failed: %v", err)
+	}
+
+	// 3. 提交 offset
+	if err := pw.reader.CommitMessages(ctx, pending...); err != nil {
+		pw.log.WithContext(ctx).Errorf("commit play messages failed: %v", err)
+	}
+	pw.log.WithContext(ctx).Infof("flushed %d play events for %d videos", len(pending), len(stats))
+	return true
+}
+
+func (pw *PlayWork) Stop(ctx context.Context) error {
+	pw.log.WithContext(ctx).Info("play work stop")
+	return pw.reader.Close()
+}
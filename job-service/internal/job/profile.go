@@ -0,0 +1,357 @@
+package job
+
+import (
+	"common/rediskey"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"job-service/internal/conf"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// 用户兴趣画像的衰减标记，存在期间不再衰减
+	userProfileDecayKey = "user:profile:decay:%d"
+
+	// 点赞、评论、关注事件类型，与各服务 outbox 写入的一致
+	eventVideoLiked         = "VideoLiked"
+	eventVideoUnliked       = "VideoUnliked"
+	eventCommentCreated     = "CommentCreated"
+	eventRelationFollowed   = "RelationFollowed"
+	eventRelationUnfollowed = "RelationUnfollowed"
+
+	profileRetryMin = 100 * time.Millisecond
+	profileRetryMax = 10 * time.Second
+)
+
+// 先按周期衰减整份画像，再累加本次偏好；偏好降到 0 以下的成员删除，超出上限时淘汰偏好最低的
+var profileScript = redis.NewScript(`
+local decay = tonumber(ARGV[1])
+if decay < 1 and redis.call('SET', KEYS[3], 1, 'NX', 'EX', ARGV[2]) then
+	for i = 1, 2 do
+		if redis.call('EXISTS', KEYS[i]) == 1 then
+			redis.call('ZUNIONSTORE', KEYS[i], 1, KEYS[i], 'WEIGHTS', decay)
+		end
+	end
+end
+local function apply(key, member, delta, max)
+	redis.call('ZINCRBY', key, delta, member)
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', 0)
+	redis.call('ZREMRANGEBYRANK', key, 0, -(max + 1))
+	redis.call('EXPIRE', key, ARGV[3])
+end
+if ARGV[6] ~= '0' then
+	apply(KEYS[2], ARGV[6], ARGV[7], tonumber(ARGV[5]))
+end
+for i = 9, #ARGV do
+	apply(KEYS[1], ARGV[i], ARGV[8], tonumber(ARGV[4]))
+end
+return 1
+`)
+
+// 点赞、评论事件消息体，与 outbox.Envelope 对应
+type videoEventEnvelope struct {
+	Type string `json:"type"`
+	Data struct {
+		VideoID int64 `json:"video_id"`
+		UserID  int64 `json:"user_id"`
+	} `json:"data"`
+}
+
+// 关注事件消息体
+type relationEventEnvelope struct {
+	Type string `json:"type"`
+	Data struct {
+		UserID   int64 `json:"user_id"`
+		ToUserID int64 `json:"to_user_id"`
+	} `json:"data"`
+}
+
+// 画像用到的视频字段
+type profileVideo struct {
+	UserID   int64
+	Tags     string
+	Duration float32
+}
+
+// 一次行为对画像的影响，话题与作者分别累加
+type profileDelta struct {
+	UserID      int64
+	AuthorID    int64
+	AuthorDelta float64
+	Tags        []string
+	TagDelta    float64
+}
+
+// 兴趣画像 Worker，消费点赞、评论、播放、关注事件，累计用户对话题和作者的偏好
+type ProfileWork struct {
+	reader        *kafka.Reader
+	db            *gorm.DB
+	rdb           *redis.Client
+	videoTopic    string
+	relationTopic string
+	playTopic     string
+	weights       *conf.ProfileWeights
+	maxTags       int
+	maxAuthors    int
+	ttl           time.Duration
+	decay         float64
+	decayInterval time.Duration
+	log           *log.Helper
+}
+
+func NewProfileWork(kc *conf.Kafka, pc *conf.Profile, db *gorm.DB, rdb *redis.Client, logger log.Logger) *ProfileWork {
+	pw := &ProfileWork{
+		db:            db,
+		rdb:           rdb,
+		videoTopic:    pc.GetVideoEventTopic(),
+		relationTopic: pc.GetRelationEventTopic(),
+		playTopic:     pc.GetPlayTopic(),
+		weights:       pc.GetWeights(),
+		maxTags:       50,
+		maxAuthors:    100,
+		ttl:           30 * 24 * time.Hour,
+		decay:         1,
+		decayInterval: 24 * time.Hour,
+		log:           log.NewHelper(logger),
+	}
+	if pw.weights == nil {
+		pw.weights = &conf.ProfileWeights{Like: 3, Comment: 4, Watch: 1, Finish: 1, Follow: 5}
+	}
+	if pc.GetMaxTags() > 0 {
+		pw.maxTags = int(pc.GetMaxTags())
+	}
+	if pc.GetMaxAuthors() > 0 {
+		pw.maxAuthors = int(pc.GetMaxAuthors())
+	}
+	if pc.GetTtl().AsDuration() > 0 {
+		pw.ttl = pc.GetTtl().AsDuration()
+	}
+	if d := pc.GetDecay(); d > 0 && d < 1 {
+		pw.decay = d
+	}
+	if pc.GetDecayInterval().AsDuration() > 0 {
+		pw.decayInterval = pc.GetDecayInterval().AsDuration()
+	}
+
+	var topics []string
+	for _, t := range []string{pw.videoTopic, pw.relationTopic, pw.playTopic} {
+		if t != "" {
+			topics = append(topics, t)
+		}
+	}
+	if len(topics) > 0 && pc.GetGroupId() != "" {
+		pw.reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     kc.GetBrokers(),
+			GroupID:     pc.GetGroupId(),
+			GroupTopics: topics,
+		})
+	}
+	return pw
+}
+
+// 启动消费循环，写入画像后再提交 offset，失败时退避重试
+func (pw *ProfileWork) Start(ctx context.Context) error {
+	if pw.reader == nil {
+		return nil
+	}
+	pw.log.WithContext(ctx).Info("profile work start")
+
+	for {
+		m, err := pw.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			pw.log.Errorf("fetch profile event failed: %v", err)
+			return err
+		}
+		if !pw.handleWithRetry(ctx, m) {
+			return nil
+		}
+		if err := pw.reader.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			pw.log.Errorf("commit profile event offset failed: %v", err)
+		}
+	}
+}
+
+// handleWithRetry 处理直到成功，ctx 取消时返回 false
+func (pw *ProfileWork) handleWithRetry(ctx context.Context, m kafka.Message) bool {
+	backoff := profileRetryMin
+	for {
+		err := pw.handle(ctx, m)
+		if err == nil {
+			return true
+		}
+		pw.log.Errorf("handle profile event from %s failed, retry in %s: %v", m.Topic, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, profileRetryMax)
+	}
+}
+
+// handle 按 topic 解析事件并写入画像，无法解析或与画像无关的事件直接跳过
+func (pw *ProfileWork) handle(ctx context.Context, m kafka.Message) error {
+	var (
+		delta *profileDelta
+		err   error
+	)
+	switch m.Topic {
+	case pw.videoTopic:
+		delta, err = pw.parseVideoEvent(ctx, m.Value)
+	case pw.relationTopic:
+		delta = pw.parseRelationEvent(m.Value)
+	case pw.playTopic:
+		delta, err = pw.parsePlayEvent(ctx, m.Value)
+	}
+	if err != nil || delta == nil {
+		return err
+	}
+	return pw.apply(ctx, delta)
+}
+
+// parseVideoEvent 点赞、评论同时提升视频话题和作者的偏好，取消点赞时扣回
+func (pw *ProfileWork) parseVideoEvent(ctx context.Context, value []byte) (*profileDelta, error) {
+	env := new(videoEventEnvelope)
+	if err := json.Unmarshal(value, env); err != nil {
+		pw.log.Errorf("unmarshal video event failed: %v, value: %s", err, string(value))
+		return nil, nil
+	}
+	var w float64
+	switch env.Type {
+	case eventVideoLiked:
+		w = pw.weights.GetLike()
+	case eventVideoUnliked:
+		w = -pw.weights.GetLike()
+	case eventCommentCreated:
+		w = pw.weights.GetComment()
+	}
+	if w == 0 || env.Data.UserID <= 0 {
+		return nil, nil
+	}
+	video, err := pw.loadVideo(ctx, env.Data.VideoID)
+	if err != nil || video == nil {
+		return nil, err
+	}
+	return video.delta(env.Data.UserID, w), nil
+}
+
+// parsePlayEvent 按观看进度折算偏好，完播额外加分，游客的播放不计入
+func (pw *ProfileWork) parsePlayEvent(ctx context.Context, value []byte) (*profileDelta, error) {
+	event := new(PlayEvent)
+	if err := json.Unmarshal(value, event); err != nil {
+		pw.log.Errorf("unmarshal play event failed: %v, value: %s", err, string(value))
+		return nil, nil
+	}
+	if event.UserID <= 0 || event.VideoID <= 0 {
+		return nil, nil
+	}
+	video, err := pw.loadVideo(ctx, event.VideoID)
+	if err != nil || video == nil {
+		return nil, err
+	}
+	var w float64
+	if video.Duration > 0 {
+		w = pw.weights.GetWatch() * min(float64(event.WatchMs)/(float64(video.Duration)*1000), 1)
+	}
+	if event.Finished {
+		w += pw.weights.GetFinish()
+	}
+	if w <= 0 {
+		return nil, nil
+	}
+	return video.delta(event.UserID, w), nil
+}
+
+// parseRelationEvent 关注只影响作者偏好，取关时扣回
+func (pw *ProfileWork) parseRelationEvent(value []byte) *profileDelta {
+	env := new(relationEventEnvelope)
+	if err := json.Unmarshal(value, env); err != nil {
+		pw.log.Errorf("unmarshal relation event failed: %v, value: %s", err, string(value))
+		return nil
+	}
+	var w float64
+	switch env.Type {
+	case eventRelationFollowed:
+		w = pw.weights.GetFollow()
+	case eventRelationUnfollowed:
+		w = -pw.weights.GetFollow()
+	}
+	if w == 0 || env.Data.UserID <= 0 || env.Data.ToUserID <= 0 {
+		return nil
+	}
+	return &profileDelta{UserID: env.Data.UserID, AuthorID: env.Data.ToUserID, AuthorDelta: w}
+}
+
+// loadVideo 查询视频的作者、话题与时长，视频不存在时返回 nil
+func (pw *ProfileWork) loadVideo(ctx context.Context, id int64) (*profileVideo, error) {
+	video := new(profileVideo)
+	res := pw.db.WithContext(ctx).Table("videos").Select("user_id", "tags", "duration").Where("id = ?", id).Limit(1).Scan(video)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, nil
+	}
+	return video, nil
+}
+
+// delta 对视频的行为同时影响话题和作者偏好，自己的视频不计入
+func (v *profileVideo) delta(uid int64, w float64) *profileDelta {
+	if v.UserID == uid {
+		return nil
+	}
+	d := &profileDelta{UserID: uid, AuthorID: v.UserID, AuthorDelta: w, TagDelta: w}
+	for _, t := range strings.Split(v.Tags, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			d.Tags = append(d.Tags, t)
+		}
+	}
+	return d
+}
+
+// apply 原子地衰减并累加画像
+func (pw *ProfileWork) apply(ctx context.Context, d *profileDelta) error {
+	keys := []string{
+		rediskey.UserProfileTag(d.UserID),
+		rediskey.UserProfileAuthor(d.UserID),
+		fmt.Sprintf(userProfileDecayKey, d.UserID),
+	}
+	args := make([]interface{}, 0, 8+len(d.Tags))
+	args = append(args,
+		pw.decay,
+		int64(pw.decayInterval.Seconds()),
+		int64(pw.ttl.Seconds()),
+		pw.maxTags,
+		pw.maxAuthors,
+		strconv.FormatInt(d.AuthorID, 10),
+		d.AuthorDelta,
+		d.TagDelta,
+	)
+	for _, t := range d.Tags {
+		args = append(args, t)
+	}
+	err := profileScript.Run(ctx, pw.rdb, keys, args...).Err()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
+
+func (pw *ProfileWork) Stop(ctx context.Context) error {
+	if pw.reader == nil {
+		return nil
+	}
+	pw.log.WithContext(ctx).Info("profile work stop")
+	return pw.reader.Close()
+}
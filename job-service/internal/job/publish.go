@@ -0,0 +1,155 @@
+package job
+
+import (
+	"common/outbox"
+	"common/rediskey"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"job-service/internal/conf"
+	"strconv"
+	"time"
+)
+
+const (
+	// 发布状态，与 video-service 的 consts 对应
+	publishStatusPublished = 0
+	publishStatusScheduled = 2
+
+	// 定时发布事件类型，与 video-service 的 consts.EventVideoPublished 一致
+	eventVideoPublished = "VideoPublished"
+)
+
+// 到期的定时视频
+type scheduledVideo struct {
+	ID        int64
+	UserID    int64
+	PublishAt time.Time
+}
+
+// 定时发布事件数据，与 video-service 解析的 video 事件一致
+type videoPublishedEvent struct {
+	VideoID int64 `json:"video_id"`
+	UserID  int64 `json:"user_id"`
+}
+
+// 定时发布 Worker，定期扫描到期的定时视频并发布
+type PublishWork struct {
+	db        *gorm.DB
+	rdb       *redis.Client
+	topic     string
+	interval  time.Duration
+	batchSize int
+	log       *log.Helper
+}
+
+func NewPublishWork(pc *conf.Publish, db *gorm.DB, rdb *redis.Client, logger log.Logger) *PublishWork {
+	interval := 30 * time.Second
+	if pc.GetInterval() != nil && pc.GetInterval().AsDuration() > 0 {
+		interval = pc.GetInterval().AsDuration()
+	}
+	batchSize := 100
+	if pc.GetBatchSize() > 0 {
+		batchSize = int(pc.GetBatchSize())
+	}
+	return &PublishWork{
+		db:        db,
+		rdb:       rdb,
+		topic:     pc.GetVideoEventTopic(),
+		interval:  interval,
+		batchSize: batchSize,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// 启动定时扫描
+func (pw *PublishWork) Start(ctx context.Context) error {
+	pw.log.WithContext(ctx).Info("publish work start")
+
+	ticker := time.NewTicker(pw.interval)
+	defer ticker.Stop()
+	for {
+		pw.publishDue(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// 发布所有到期的定时视频，一批处理不完时继续下一批
+func (pw *PublishWork) publishDue(ctx context.Context) {
+	for {
+		var videos []scheduledVideo
+		err := pw.db.WithContext(ctx).
+			Table("videos").
+			Select("id", "user_id", "publish_at").
+			Where("publish_status = ? AND publish_at <= ?", publishStatusScheduled, time.Now()).
+			Order("publish_at").
+			Limit(pw.batchSize).
+			Find(&videos).Error
+		if err != nil {
+			pw.log.WithContext(ctx).Errorf("query scheduled videos failed: %v", err)
+			return
+		}
+
+		for _, v := range videos {
+			pw.publish(ctx, v)
+		}
+		if len(videos) < pw.batchSize {
+			return
+		}
+	}
+}
+
+// 发布单个视频，发布时间作为创建时间，使其按计划时间进入 feed
+// 位置索引、话题热度等发布后的副作用由 video-service 消费发布事件执行，与立即发布共用同一实现
+func (pw *PublishWork) publish(ctx context.Context, v scheduledVideo) {
+	published := false
+	err := pw.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 只更新仍处于定时状态的视频，避免与用户手动发布重复
+		res := tx.Exec(
+			"UPDATE videos SET publish_status = ?, created_at = publish_at WHERE id = ? AND publish_status = ?",
+			publishStatusPublished, v.ID, publishStatusScheduled)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		published = true
+		return outbox.Add(tx, outbox.Event{
+			Topic: pw.topic,
+			Key:   strconv.FormatInt(v.ID, 10),
+			Type:  eventVideoPublished,
+			Data:  videoPublishedEvent{VideoID: v.ID, UserID: v.UserID},
+		})
+	})
+	if err != nil {
+		pw.log.WithContext(ctx).Errorf("publish video %d failed: %v", v.ID, err)
+		return
+	}
+	if !published {
+		return
+	}
+
+	// 交给 ScoreWork 计算分数加入榜单，失败不影响发布，video-service 处理发布事件时会再次标记
+	if err := pw.rdb.SAdd(ctx, rediskey.VideoScoreDirty, v.ID).Err(); err != nil {
+		pw.log.WithContext(ctx).Errorf("mark video %d score dirty failed: %v", v.ID, err)
+	}
+	pw.log.WithContext(ctx).Infof("published scheduled video %d at %s", v.ID, v.PublishAt.Format(time.DateTime))
+}
+
+// NewOutboxRelay 发布定时发布事件
+func NewOutboxRelay(kc *conf.Kafka, pc *conf.Publish, db *gorm.DB, logger log.Logger) *outbox.Relay {
+	return outbox.NewRelay(db, outbox.Config{
+		Brokers:   kc.GetBrokers(),
+		Interval:  pc.GetOutbox().GetInterval().AsDuration(),
+		BatchSize: int(pc.GetOutbox().GetBatchSize()),
+		Retention: pc.GetOutbox().GetRetention().AsDuration(),
+	}, logger)
+}
+
+func (pw *PublishWork) Stop(ctx context.Context) error {
+	pw.log.WithContext(ctx).Info("publish work stop")
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/segmentio/kafka-go"
 	"job-service/internal/conf"
@@ -18,6 +19,7 @@ type Msg struct {
 	Table    string `json:"table"`
 	IsDdl    bool   `json:"isDdl"`
 	Data     []map[string]interface{}
+	Old      []map[string]interface{} `json:"old"` // UPDATE 时与 Data 一一对应，只含变更前的字段
 }
 
 // ES 客户端封装
@@ -35,19 +37,46 @@ type JobWork struct {
 	kafkaReader   *kafka.Reader
 	esClient      *EsClient
 	topicIndexMap map[string]string
-	log           *log.Helper
+	indexFields   map[string]map[string]string // index -> 字段 -> 字段类型
+	indexAllowed  map[string]map[string]bool   // index -> 允许写入的字段
+	indexWhen     map[string]map[string]string // index -> 写入条件
+	// 搜索建议
+	suggestIndex   string
+	suggestSources map[string][]*conf.SuggestSource // topic -> 建议来源
+	log            *log.Helper
 }
 
 func NewJobWrok(kafkaReader *kafka.Reader, esClient *EsClient, conf *conf.Elasticsearch, logger log.Logger) *JobWork {
 	topicIndexMap := make(map[string]string)
+	indexFields := make(map[string]map[string]string)
+	indexAllowed := make(map[string]map[string]bool)
+	indexWhen := make(map[string]map[string]string)
 	for _, idx := range conf.Indices {
 		topicIndexMap[idx.Topic] = idx.Index
+		if len(idx.FieldTypes) > 0 {
+			indexFields[idx.Index] = idx.FieldTypes
+		}
+		if len(idx.Fields) > 0 {
+			allowed := make(map[string]bool, len(idx.Fields))
+			for _, f := range idx.Fields {
+				allowed[f] = true
+			}
+			indexAllowed[idx.Index] = allowed
+		}
+		if len(idx.IndexWhen) > 0 {
+			indexWhen[idx.Index] = idx.IndexWhen
+		}
 	}
 	return &JobWork{
-		kafkaReader:   kafkaReader,
-		esClient:      esClient,
-		topicIndexMap: topicIndexMap,
-		log:           log.NewHelper(logger),
+		kafkaReader:    kafkaReader,
+		esClient:       esClient,
+		topicIndexMap:  topicIndexMap,
+		indexFields:    indexFields,
+		indexAllowed:   indexAllowed,
+		indexWhen:      indexWhen,
+		suggestIndex:   conf.SuggestIndex,
+		suggestSources: groupSuggestSources(conf.SuggestSources),
+		log:            log.NewHelper(logger),
 	}
 }
 
@@ -81,6 +110,9 @@ func NewESClient(conf *conf.Elasticsearch) (*EsClient, error) {
 func (jw JobWork) Start(ctx context.Context) error {
 	jw.log.WithContext(ctx).Info("job work start")
 
+	// 按配置初始化索引字段映射
+	jw.ensureMappings(ctx)
+
 	// 1. 从kafka中获取MySQL中的数据变更消息
 	// 接收消息
 	for {
@@ -111,7 +143,7 @@ func (jw JobWork) Start(ctx context.Context) error {
 		}
 
 		// 遍历变更的行数据
-		for _, data := range msg.Data {
+		for i, data := range msg.Data {
 			// 提取唯一 ID（用于 ES 的文档 _id）
 			docID := jw.extractID(data)
 			if docID == "" {
@@ -119,6 +151,23 @@ func (jw JobWork) Start(ctx context.Context) error {
 				continue
 			}
 
+			// 建议词按各自的条件同步，行不再可见或被删除时移除
+			var old map[string]interface{}
+			if i < len(msg.Old) {
+				old = msg.Old[i]
+			}
+			jw.syncSuggestions(ctx, m.Topic, msg.Type, docID, data, old)
+
+			// 不满足写入条件的行不进 es，更新后不满足的删除已有文档
+			if !jw.matchIndexWhen(index, data) {
+				if msg.Type == "UPDATE" {
+					jw.deleteDocument(ctx, index, docID)
+				}
+				continue
+			}
+
+			data = jw.convertFields(index, jw.filterFields(index, data))
+
 			// 根据 canal 类型选择插入或更新
 			switch msg.Type {
 			case "INSERT":
@@ -167,6 +216,20 @@ func (jw *JobWork) updateDocument(ctx context.Context, index, id string, data ma
 	}
 }
 
+// 在 Elasticsearch 中删除文档，文档不存在时忽略
+func (jw *JobWork) deleteDocument(ctx context.Context, index, id string) {
+	_, err := jw.esClient.Delete(index, id).Do(ctx)
+	if err != nil {
+		var esErr *types.ElasticsearchError
+		if errors.As(err, &esErr) && esErr.Status == 404 {
+			return
+		}
+		jw.log.WithContext(ctx).Errorf("delete document failed: %v", err)
+	} else {
+		jw.log.WithContext(ctx).Infof("deleted document id=%s in index=%s", id, index)
+	}
+}
+
 func (jw JobWork) Stop(ctx context.Context) error {
 	jw.log.WithContext(ctx).Info("job work stop")
 	return jw.kafkaReader.Close()
@@ -0,0 +1,266 @@
+package job
+
+import (
+	"common/rediskey"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"job-service/internal/conf"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// 全量重算时的临时榜单、开始时的榜单快照、重算期间新加入的视频
+	videoScoreTmpKey   = "video:score:tmp"
+	videoScorePrevKey  = "video:score:prev"
+	videoScoreAddedKey = "video:score:added"
+	// 多实例部署时只允许一个实例全量重算
+	videoScoreLockKey = "video:score:lock"
+)
+
+// 活跃视频的计数
+type scoreVideo struct {
+	ID          int64
+	FavoriteCnt int64
+	CommentCnt  int64
+	ViewCnt     int64
+	ShareCnt    int64
+	CollectCnt  int64
+	CreatedAt   time.Time
+}
+
+// 分数重算 Worker，定期重算活跃窗口内的视频并清理过期成员
+type ScoreWork struct {
+	cfg           config.Config
+	conf          atomic.Pointer[conf.Score]
+	db            *gorm.DB
+	rdb           *redis.Client
+	interval      time.Duration
+	dirtyInterval time.Duration
+	log           *log.Helper
+}
+
+func NewScoreWork(cfg config.Config, sc *conf.Score, db *gorm.DB, rdb *redis.Client, logger log.Logger) *ScoreWork {
+	interval := 5 * time.Minute
+	if sc.GetInterval() != nil && sc.GetInterval().AsDuration() > 0 {
+		interval = sc.GetInterval().AsDuration()
+	}
+	dirtyInterval := 10 * time.Second
+	if sc.GetDirtyInterval() != nil && sc.GetDirtyInterval().AsDuration() > 0 {
+		dirtyInterval = sc.GetDirtyInterval().AsDuration()
+	}
+	sw := &ScoreWork{
+		cfg:           cfg,
+		db:            db,
+		rdb:           rdb,
+		interval:      interval,
+		dirtyInterval: dirtyInterval,
+		log:           log.NewHelper(logger),
+	}
+	sw.conf.Store(sc)
+	return sw
+}
+
+// 启动定时重算，配置变更时替换权重等参数
+func (sw *ScoreWork) Start(ctx context.Context) error {
+	sw.log.WithContext(ctx).Info("score work start")
+
+	if err := sw.cfg.Watch("score", sw.reload); err != nil {
+		sw.log.WithContext(ctx).Warnf("watch score config failed: %v", err)
+	}
+
+	full := time.NewTicker(sw.interval)
+	defer full.Stop()
+	dirty := time.NewTicker(sw.dirtyInterval)
+	defer dirty.Stop()
+
+	sw.rescoreWindow(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-full.C:
+			sw.rescoreWindow(ctx)
+		case <-dirty.C:
+			sw.rescoreRequested(ctx)
+			sw.rescoreDirty(ctx)
+		}
+	}
+}
+
+// reload 配置文件中 score 变更时重新加载
+func (sw *ScoreWork) reload(key string, value config.Value) {
+	sc := new(conf.Score)
+	if err := value.Scan(sc); err != nil {
+		sw.log.Errorf("reload score config failed: %v", err)
+		return
+	}
+	sw.conf.Store(sc)
+	sw.log.Infof("score config reloaded: %v", sc.GetWeights())
+}
+
+// rescoreDirty 重算有互动的视频
+func (sw *ScoreWork) rescoreDirty(ctx context.Context) {
+	sc := sw.conf.Load()
+	for {
+		members, err := sw.rdb.SPopN(ctx, rediskey.VideoScoreDirty, int64(batchSizeOf(sc))).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			sw.log.WithContext(ctx).Errorf("pop dirty videos failed: %v", err)
+			return
+		}
+		if len(members) == 0 {
+			return
+		}
+		ids := make([]int64, 0, len(members))
+		for _, m := range members {
+			if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+				ids = append(ids, id)
+			}
+		}
+
+		var videos []scoreVideo
+		err = sw.activeVideos(ctx, sc).Where("id IN ?", ids).Find(&videos).Error
+		if err != nil {
+			// 放回集合，下次重试
+			sw.rdb.SAdd(ctx, rediskey.VideoScoreDirty, members)
+			sw.log.WithContext(ctx).Errorf("query dirty videos failed: %v", err)
+			return
+		}
+		if len(videos) == 0 {
+			continue
+		}
+
+		// 查询已限定在活跃窗口内，新发布的视频也在这里加入榜单
+		scores := make([]redis.Z, 0, len(videos))
+		for _, v := range videos {
+			scores = append(scores, redis.Z{Score: calcScore(sc.GetWeights(), v), Member: strconv.FormatInt(v.ID, 10)})
+		}
+		if err := sw.rdb.ZAdd(ctx, rediskey.VideoScore, scores...).Err(); err != nil {
+			sw.log.WithContext(ctx).Errorf("update dirty scores failed: %v", err)
+			return
+		}
+	}
+}
+
+// rescoreWindow 全量重算活跃窗口内的视频，写入临时榜单后替换，不在窗口内的视频随之移出；返回是否完成重算
+func (sw *ScoreWork) rescoreWindow(ctx context.Context) bool {
+	ok, err := sw.rdb.SetNX(ctx, videoScoreLockKey, time.Now().Unix(), sw.interval*9/10).Result()
+	if err != nil || !ok {
+		return false
+	}
+
+	sc := sw.conf.Load()
+	start := time.Now()
+
+	// 1. 记录开始时的榜单，用于找出重算期间新发布的视频
+	if err := sw.rdb.ZUnionStore(ctx, videoScorePrevKey, &redis.ZStore{Keys: []string{rediskey.VideoScore}}).Err(); err != nil {
+		sw.log.WithContext(ctx).Errorf("snapshot video score failed: %v", err)
+		return false
+	}
+	sw.rdb.Del(ctx, videoScoreTmpKey)
+
+	// 2. 按 id 分批重算
+	var lastID, total int64
+	batchSize := batchSizeOf(sc)
+	for {
+		var videos []scoreVideo
+		err := sw.activeVideos(ctx, sc).Where("id > ?", lastID).Order("id").Limit(batchSize).Find(&videos).Error
+		if err != nil {
+			sw.log.WithContext(ctx).Errorf("query active videos failed: %v", err)
+			sw.rdb.Del(ctx, videoScoreTmpKey, videoScorePrevKey)
+			return false
+		}
+		if len(videos) == 0 {
+			break
+		}
+		members := make([]redis.Z, 0, len(videos))
+		for _, v := range videos {
+			members = append(members, redis.Z{Score: calcScore(sc.GetWeights(), v), Member: strconv.FormatInt(v.ID, 10)})
+		}
+		if err := sw.rdb.ZAdd(ctx, videoScoreTmpKey, members...).Err(); err != nil {
+			sw.log.WithContext(ctx).Errorf("write tmp video score failed: %v", err)
+			sw.rdb.Del(ctx, videoScoreTmpKey, videoScorePrevKey)
+			return false
+		}
+		lastID = videos[len(videos)-1].ID
+		total += int64(len(videos))
+		if len(videos) < batchSize {
+			break
+		}
+	}
+
+	// 3. 新榜单 = 重算结果 + 重算期间新加入的视频，并按 max_size 截断
+	_, err = sw.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZDiffStore(ctx, videoScoreAddedKey, rediskey.VideoScore, videoScorePrevKey)
+		pipe.ZUnionStore(ctx, rediskey.VideoScore, &redis.ZStore{Keys: []string{videoScoreTmpKey, videoScoreAddedKey}, Aggregate: "MAX"})
+		if sc.GetMaxSize() > 0 {
+			pipe.ZRemRangeByRank(ctx, rediskey.VideoScore, 0, -sc.GetMaxSize()-1)
+		}
+		pipe.Del(ctx, videoScoreTmpKey, videoScorePrevKey, videoScoreAddedKey)
+		return nil
+	})
+	if err != nil {
+		sw.log.WithContext(ctx).Errorf("replace video score failed: %v", err)
+		return false
+	}
+	sw.log.WithContext(ctx).Infof("rescored %d videos in %s", total, time.Since(start))
+	return true
+}
+
+// rescoreRequested 处理 feed-service 发现热榜缺失时的重算请求，拿不到锁或失败时保留请求，下次重试
+func (sw *ScoreWork) rescoreRequested(ctx context.Context) {
+	n, err := sw.rdb.Exists(ctx, rediskey.VideoScoreRebuildRequest).Result()
+	if err != nil || n == 0 {
+		return
+	}
+	if sw.rescoreWindow(ctx) {
+		sw.rdb.Del(ctx, rediskey.VideoScoreRebuildRequest)
+	}
+}
+
+// activeVideos 活跃窗口内已发布、公开且未删除的视频
+func (sw *ScoreWork) activeVideos(ctx context.Context, sc *conf.Score) *gorm.DB {
+	window := 7 * 24 * time.Hour
+	if sc.GetWindow() != nil && sc.GetWindow().AsDuration() > 0 {
+		window = sc.GetWindow().AsDuration()
+	}
+	return sw.db.WithContext(ctx).
+		Table("videos").
+		Select("id", "favorite_cnt", "comment_cnt", "view_cnt", "share_cnt", "collect_cnt", "created_at").
+		Where("publish_status = ? AND is_public = 1 AND delete_at IS NULL AND created_at >= ?", publishStatusPublished, time.Now().Add(-window))
+}
+
+func (sw *ScoreWork) Stop(ctx context.Context) error {
+	sw.log.WithContext(ctx).Info("score work stop")
+	return nil
+}
+
+func batchSizeOf(sc *conf.Score) int {
+	if sc.GetBatchSize() > 0 {
+		return int(sc.GetBatchSize())
+	}
+	return 500
+}
+
+// calcScore 互动计数加权求和，加上随发布时间衰减的新视频加成
+func calcScore(w *conf.ScoreWeights, v scoreVideo) float64 {
+	hours := time.Since(v.CreatedAt).Hours()
+	if hours < 0 {
+		hours = 0
+	}
+	score := float64(v.FavoriteCnt)*w.GetFavorite() +
+		float64(v.CommentCnt)*w.GetComment() +
+		float64(v.ViewCnt)*w.GetView() +
+		float64(v.ShareCnt)*w.GetShare() +
+		float64(v.CollectCnt)*w.GetCollect()
+	if w.GetBase() > 0 {
+		score += w.GetBase() / math.Pow(math.Max(hours+w.GetOffsetHours(), 1), w.GetGravity())
+	}
+	return score
+}
@@ -0,0 +1,151 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"job-service/internal/conf"
+)
+
+// 建议词最大字符数，过长的标题不适合作为建议
+const suggestMaxLen = 64
+
+// 同一建议词可能来自多行（如多个视频的同一话题），refs 记录当前提供该词的行 id，全部移除后删除文档
+var (
+	suggestAddRefScript = `if (ctx._source.refs == null) { ctx._source.refs = []; }
+if (!ctx._source.refs.contains(params.ref)) { ctx._source.refs.add(params.ref); }`
+	suggestRemoveRefScript = `if (ctx._source.refs != null) { ctx._source.refs.removeIf(r -> r == params.ref); }
+if (ctx._source.refs == null || ctx._source.refs.isEmpty()) { ctx.op = 'delete'; } else { ctx.op = 'none'; }`
+)
+
+// suggestDoc 搜索建议索引中的文档
+type suggestDoc struct {
+	Text    string       `json:"text"`
+	Type    string       `json:"type"`
+	Suggest suggestInput `json:"suggest"`
+	Refs    []string     `json:"refs"`
+}
+
+type suggestInput struct {
+	Input []string `json:"input"`
+}
+
+// groupSuggestSources 按 topic 分组建议来源
+func groupSuggestSources(sources []*conf.SuggestSource) map[string][]*conf.SuggestSource {
+	res := make(map[string][]*conf.SuggestSource)
+	for _, src := range sources {
+		res[src.Topic] = append(res[src.Topic], src)
+	}
+	return res
+}
+
+// suggestProperties 建议索引的字段映射
+func suggestProperties() map[string]types.Property {
+	return map[string]types.Property{
+		"text":    types.NewKeywordProperty(),
+		"type":    types.NewKeywordProperty(),
+		"suggest": types.NewCompletionProperty(),
+		"refs":    types.NewKeywordProperty(),
+	}
+}
+
+// syncSuggestions 按配置同步变更行提供的建议词：行满足写入条件时加入，不满足或被删除时移除；
+// 更新前的旧值不再出现时一并移除
+func (jw *JobWork) syncSuggestions(ctx context.Context, topic, msgType, rowID string, data, old map[string]interface{}) {
+	if jw.suggestIndex == "" {
+		return
+	}
+	for _, src := range jw.suggestSources[topic] {
+		texts := suggestTexts(src, data[src.Field])
+		visible := msgType != "DELETE" && matchWhen(src.IndexWhen, data)
+		for key, text := range texts {
+			if visible {
+				jw.addSuggestion(ctx, src, rowID, key, text)
+			} else {
+				jw.removeSuggestion(ctx, src, rowID, key)
+			}
+		}
+		if prev, ok := old[src.Field]; ok {
+			for key := range suggestTexts(src, prev) {
+				if _, ok := texts[key]; !ok {
+					jw.removeSuggestion(ctx, src, rowID, key)
+				}
+			}
+		}
+	}
+}
+
+// suggestTexts 字段中的建议词，key 为小写文本
+func suggestTexts(src *conf.SuggestSource, v interface{}) map[string]string {
+	value, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	texts := []string{value}
+	if src.Split {
+		texts = strings.Split(value, ",")
+	}
+	res := make(map[string]string, len(texts))
+	for _, text := range texts {
+		text = strings.TrimSpace(text)
+		if text == "" || utf8.RuneCountInString(text) > suggestMaxLen {
+			continue
+		}
+		res[strings.ToLower(text)] = text
+	}
+	return res
+}
+
+// suggestID 以 类型:小写文本 作为文档 id，同一建议词重复写入时合并
+func suggestID(src *conf.SuggestSource, key string) string {
+	return src.Type + ":" + key
+}
+
+// addSuggestion 写入建议词并记录提供该词的行
+func (jw *JobWork) addSuggestion(ctx context.Context, src *conf.SuggestSource, rowID, key, text string) {
+	id := suggestID(src, key)
+	doc := suggestDoc{Text: text, Type: src.Type, Suggest: suggestInput{Input: suggestInputs(text)}, Refs: []string{rowID}}
+	_, err := jw.esClient.Update(jw.suggestIndex, id).
+		Script(suggestRefScript(suggestAddRefScript, rowID)).
+		Upsert(doc).
+		RetryOnConflict(3).
+		Do(ctx)
+	if err != nil {
+		jw.log.WithContext(ctx).Errorf("index suggestion %s failed: %v", id, err)
+	}
+}
+
+// removeSuggestion 移除行对建议词的引用，没有行引用时删除文档；文档不存在时忽略
+func (jw *JobWork) removeSuggestion(ctx context.Context, src *conf.SuggestSource, rowID, key string) {
+	id := suggestID(src, key)
+	_, err := jw.esClient.Update(jw.suggestIndex, id).
+		Script(suggestRefScript(suggestRemoveRefScript, rowID)).
+		RetryOnConflict(3).
+		Do(ctx)
+	if err != nil {
+		var esErr *types.ElasticsearchError
+		if errors.As(err, &esErr) && esErr.Status == 404 {
+			return
+		}
+		jw.log.WithContext(ctx).Errorf("remove suggestion %s failed: %v", id, err)
+	}
+}
+
+func suggestRefScript(source, rowID string) *types.Script {
+	ref, _ := json.Marshal(rowID)
+	return &types.Script{Source: &source, Params: map[string]json.RawMessage{"ref": ref}}
+}
+
+// suggestInputs 除整句外，再以每个单词开头的后缀作为输入，使输入中间的单词也能匹配
+func suggestInputs(text string) []string {
+	inputs := []string{strings.ToLower(text)}
+	words := strings.Fields(strings.ToLower(text))
+	for i := 1; i < len(words) && i < 5; i++ {
+		inputs = append(inputs, strings.Join(words[i:], " "))
+	}
+	return inputs
+}
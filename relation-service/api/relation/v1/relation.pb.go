@@ -240,6 +240,103 @@ func (x *GetRelationListByUserIDReply) GetUser() []*User {
 	return nil
 }
 
+// BatchIsFollowing 批量查询关注状态
+type BatchIsFollowingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ToUserIds     []int64                `protobuf:"varint,2,rep,packed,name=to_user_ids,json=toUserIds,proto3" json:"to_user_ids,omitempty"` // 最多 100 个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchIsFollowingRequest) Reset() {
+	*x = BatchIsFollowingRequest{}
+	mi := &file_relation_v1_relation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchIsFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchIsFollowingRequest) ProtoMessage() {}
+
+func (x *BatchIsFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_v1_relation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchIsFollowingRequest.ProtoReflect.Descriptor instead.
+func (*BatchIsFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_relation_v1_relation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchIsFollowingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchIsFollowingRequest) GetToUserIds() []int64 {
+	if x != nil {
+		return x.ToUserIds
+	}
+	return nil
+}
+
+type BatchIsFollowingReply struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FollowingUserIds []int64                `protobuf:"varint,1,rep,packed,name=following_user_ids,json=followingUserIds,proto3" json:"following_user_ids,omitempty"` // 已关注的用户
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchIsFollowingReply) Reset() {
+	*x = BatchIsFollowingReply{}
+	mi := &file_relation_v1_relation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchIsFollowingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchIsFollowingReply) ProtoMessage() {}
+
+func (x *BatchIsFollowingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_v1_relation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchIsFollowingReply.ProtoReflect.Descriptor instead.
+func (*BatchIsFollowingReply) Descriptor() ([]byte, []int) {
+	return file_relation_v1_relation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchIsFollowingReply) GetFollowingUserIds() []int64 {
+	if x != nil {
+		return x.FollowingUserIds
+	}
+	return nil
+}
+
 type User struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // 用户id
@@ -259,7 +356,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_relation_v1_relation_proto_msgTypes[4]
+	mi := &file_relation_v1_relation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +368,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_relation_v1_relation_proto_msgTypes[4]
+	mi := &file_relation_v1_relation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +381,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_relation_v1_relation_proto_rawDescGZIP(), []int{4}
+	return file_relation_v1_relation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *User) GetId() int64 {
@@ -383,7 +480,12 @@ const file_relation_v1_relation_proto_rawDesc = "" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\x03R\x06userId\"B\n" +
 	"\x1cGetRelationListByUserIDReply\x12\"\n" +
-	"\x04user\x18\x01 \x03(\v2\x0e.relation.UserR\x04user\"\xe1\x02\n" +
+	"\x04user\x18\x01 \x03(\v2\x0e.relation.UserR\x04user\"R\n" +
+	"\x17BatchIsFollowingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1e\n" +
+	"\vto_user_ids\x18\x02 \x03(\x03R\ttoUserIds\"E\n" +
+	"\x15BatchIsFollowingReply\x12,\n" +
+	"\x12following_user_ids\x18\x01 \x03(\x03R\x10followingUserIds\"\xe1\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
@@ -397,10 +499,11 @@ const file_relation_v1_relation_proto_rawDesc = "" +
 	"\n" +
 	"work_count\x18\n" +
 	" \x01(\x05R\tworkCount\x12%\n" +
-	"\x0efavorite_count\x18\v \x01(\x05R\rfavoriteCount2\x92\x02\n" +
+	"\x0efavorite_count\x18\v \x01(\x05R\rfavoriteCount2\xea\x02\n" +
 	"\x0fRelationService\x12u\n" +
 	"\x0fRelationControl\x12 .relation.RelationControlRequest\x1a\x1e.relation.RelationControlReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/relation/control\x12\x87\x01\n" +
-	"\x17GetRelationListByUserID\x12(.relation.GetRelationListByUserIDRequest\x1a&.relation.GetRelationListByUserIDReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/relation/listB\x14Z\x12relation/api/v1;v1b\x06proto3"
+	"\x17GetRelationListByUserID\x12(.relation.GetRelationListByUserIDRequest\x1a&.relation.GetRelationListByUserIDReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/relation/list\x12V\n" +
+	"\x10BatchIsFollowing\x12!.relation.BatchIsFollowingRequest\x1a\x1f.relation.BatchIsFollowingReplyB\x14Z\x12relation/api/v1;v1b\x06proto3"
 
 var (
 	file_relation_v1_relation_proto_rawDescOnce sync.Once
@@ -414,22 +517,26 @@ func file_relation_v1_relation_proto_rawDescGZIP() []byte {
 	return file_relation_v1_relation_proto_rawDescData
 }
 
-var file_relation_v1_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_relation_v1_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_relation_v1_relation_proto_goTypes = []any{
 	(*RelationControlRequest)(nil),         // 0: relation.RelationControlRequest
 	(*RelationControlReply)(nil),           // 1: relation.RelationControlReply
 	(*GetRelationListByUserIDRequest)(nil), // 2: relation.GetRelationListByUserIDRequest
 	(*GetRelationListByUserIDReply)(nil),   // 3: relation.GetRelationListByUserIDReply
-	(*User)(nil),                           // 4: relation.User
+	(*BatchIsFollowingRequest)(nil),        // 4: relation.BatchIsFollowingRequest
+	(*BatchIsFollowingReply)(nil),          // 5: relation.BatchIsFollowingReply
+	(*User)(nil),                           // 6: relation.User
 }
 var file_relation_v1_relation_proto_depIdxs = []int32{
-	4, // 0: relation.GetRelationListByUserIDReply.user:type_name -> relation.User
+	6, // 0: relation.GetRelationListByUserIDReply.user:type_name -> relation.User
 	0, // 1: relation.RelationService.RelationControl:input_type -> relation.RelationControlRequest
 	2, // 2: relation.RelationService.GetRelationListByUserID:input_type -> relation.GetRelationListByUserIDRequest
-	1, // 3: relation.RelationService.RelationControl:output_type -> relation.RelationControlReply
-	3, // 4: relation.RelationService.GetRelationListByUserID:output_type -> relation.GetRelationListByUserIDReply
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
+	4, // 3: relation.RelationService.BatchIsFollowing:input_type -> relation.BatchIsFollowingRequest
+	1, // 4: relation.RelationService.RelationControl:output_type -> relation.RelationControlReply
+	3, // 5: relation.RelationService.GetRelationListByUserID:output_type -> relation.GetRelationListByUserIDReply
+	5, // 6: relation.RelationService.BatchIsFollowing:output_type -> relation.BatchIsFollowingReply
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
 	1, // [1:1] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
@@ -446,7 +553,7 @@ func file_relation_v1_relation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relation_v1_relation_proto_rawDesc), len(file_relation_v1_relation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	RelationService_RelationControl_FullMethodName         = "/relation.RelationService/RelationControl"
 	RelationService_GetRelationListByUserID_FullMethodName = "/relation.RelationService/GetRelationListByUserID"
+	RelationService_BatchIsFollowing_FullMethodName        = "/relation.RelationService/BatchIsFollowing"
 )
 
 // RelationServiceClient is the client API for RelationService service.
@@ -30,6 +31,8 @@ type RelationServiceClient interface {
 	// 用户关系操作
 	RelationControl(ctx context.Context, in *RelationControlRequest, opts ...grpc.CallOption) (*RelationControlReply, error)
 	GetRelationListByUserID(ctx context.Context, in *GetRelationListByUserIDRequest, opts ...grpc.CallOption) (*GetRelationListByUserIDReply, error)
+	// 批量查询用户是否关注了这些用户，供视频流等服务内部调用
+	BatchIsFollowing(ctx context.Context, in *BatchIsFollowingRequest, opts ...grpc.CallOption) (*BatchIsFollowingReply, error)
 }
 
 type relationServiceClient struct {
@@ -60,6 +63,16 @@ func (c *relationServiceClient) GetRelationListByUserID(ctx context.Context, in
 	return out, nil
 }
 
+func (c *relationServiceClient) BatchIsFollowing(ctx context.Context, in *BatchIsFollowingRequest, opts ...grpc.CallOption) (*BatchIsFollowingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchIsFollowingReply)
+	err := c.cc.Invoke(ctx, RelationService_BatchIsFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelationServiceServer is the server API for RelationService service.
 // All implementations must embed UnimplementedRelationServiceServer
 // for forward compatibility.
@@ -67,6 +80,8 @@ type RelationServiceServer interface {
 	// 用户关系操作
 	RelationControl(context.Context, *RelationControlRequest) (*RelationControlReply, error)
 	GetRelationListByUserID(context.Context, *GetRelationListByUserIDRequest) (*GetRelationListByUserIDReply, error)
+	// 批量查询用户是否关注了这些用户，供视频流等服务内部调用
+	BatchIsFollowing(context.Context, *BatchIsFollowingRequest) (*BatchIsFollowingReply, error)
 	mustEmbedUnimplementedRelationServiceServer()
 }
 
@@ -83,6 +98,9 @@ func (UnimplementedRelationServiceServer) RelationControl(context.Context, *Rela
 func (UnimplementedRelationServiceServer) GetRelationListByUserID(context.Context, *GetRelationListByUserIDRequest) (*GetRelationListByUserIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRelationListByUserID not implemented")
 }
+func (UnimplementedRelationServiceServer) BatchIsFollowing(context.Context, *BatchIsFollowingRequest) (*BatchIsFollowingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchIsFollowing not implemented")
+}
 func (UnimplementedRelationServiceServer) mustEmbedUnimplementedRelationServiceServer() {}
 func (UnimplementedRelationServiceServer) testEmbeddedByValue()                         {}
 
@@ -140,6 +158,24 @@ func _RelationService_GetRelationListByUserID_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelationService_BatchIsFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchIsFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).BatchIsFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_BatchIsFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).BatchIsFollowing(ctx, req.(*BatchIsFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RelationService_ServiceDesc is the grpc.ServiceDesc for RelationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -155,6 +191,10 @@ var RelationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRelationListByUserID",
 			Handler:    _RelationService_GetRelationListByUserID_Handler,
 		},
+		{
+			MethodName: "BatchIsFollowing",
+			Handler:    _RelationService_BatchIsFollowing_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "relation/v1/relation.proto",
@@ -6,7 +6,9 @@ import (
 	"github.com/go-kratos/kratos/v2/registry"
 	"os"
 
+	"common/outbox"
 	"ralation-service/internal/conf"
+	"ralation-service/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -35,7 +37,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, es *server.RelationEventServer, ob *outbox.Relay, reg registry.Registrar) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +47,8 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Re
 		kratos.Server(
 			gs,
 			hs,
+			es,
+			ob,
 		),
 		kratos.Registrar(reg),
 	)
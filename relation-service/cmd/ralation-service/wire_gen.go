@@ -44,8 +44,10 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, re
 	relationService := service.NewRelationService(relationUsecase)
 	grpcServer := server.NewGRPCServer(confServer, relationService, logger)
 	httpServer := server.NewHTTPServer(confServer, relationService, logger)
+	relationEventServer := server.NewRelationEventServer(confData, relationUsecase, logger)
+	relay := data.NewOutboxRelay(confData, db, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, httpServer, registrar)
+	app := newApp(logger, grpcServer, httpServer, relationEventServer, relay, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -4,3 +4,6 @@ const (
 	Action_Type_Create = 1
 	Action_Type_Delete = 2
 )
+
+// MaxBatchRelationQuery 批量查询关注状态时最多的用户数
+const MaxBatchRelationQuery = 100
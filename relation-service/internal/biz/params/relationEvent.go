@@ -0,0 +1,13 @@
+package params
+
+const (
+	// 关注、取关事件类型
+	EventRelationFollowed   = "RelationFollowed"
+	EventRelationUnfollowed = "RelationUnfollowed"
+)
+
+// RelationEvent 关注、取关事件
+type RelationEvent struct {
+	UserID   int64 `json:"user_id"`
+	ToUserID int64 `json:"to_user_id"`
+}
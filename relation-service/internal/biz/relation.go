@@ -14,6 +14,10 @@ type RelationRepo interface {
 	ParseToken(ctx context.Context, token, refreshToken string) (userID int64, err error)
 	CheckUserExistByUserID(ctx context.Context, toUserID int64) (bool, error)
 	GetFollowList(ctx context.Context, userID, toUserID int64) (users []*params.UserInfo, err error)
+	// SyncRelationCache 按关注事件同步 Redis
+	SyncRelationCache(ctx context.Context, userID, toUserID int64, following bool) error
+	// BatchCheckRelation 返回 toUserIDs 中 userID 已关注的用户
+	BatchCheckRelation(ctx context.Context, userID int64, toUserIDs []int64) ([]int64, error)
 }
 
 type RelationUsecase struct {
@@ -49,6 +53,19 @@ func (uc *RelationUsecase) RelationControl(ctx context.Context, param *params.Re
 	}
 }
 
+// HandleRelationEvent 处理关注、取关事件
+func (uc *RelationUsecase) HandleRelationEvent(ctx context.Context, eventType string, event *params.RelationEvent) error {
+	switch eventType {
+	case params.EventRelationFollowed:
+		return uc.repo.SyncRelationCache(ctx, event.UserID, event.ToUserID, true)
+	case params.EventRelationUnfollowed:
+		return uc.repo.SyncRelationCache(ctx, event.UserID, event.ToUserID, false)
+	default:
+		uc.log.WithContext(ctx).Warnf("ignore unknown relation event: %s", eventType)
+		return nil
+	}
+}
+
 // ParseToken 解析token
 func (uc *RelationUsecase) ParseToken(ctx context.Context, token, refreshToken string) (int64, error) {
 	return uc.repo.ParseToken(ctx, token, refreshToken)
@@ -81,3 +98,11 @@ func (uc *RelationUsecase) GetRelationListByUserID(ctx context.Context, currentU
 	}
 	return userList, nil
 }
+
+// BatchIsFollowing 批量查询关注状态，未登录用户视为都未关注
+func (uc *RelationUsecase) BatchIsFollowing(ctx context.Context, userID int64, toUserIDs []int64) ([]int64, error) {
+	if userID == 0 || len(toUserIDs) == 0 {
+		return []int64{}, nil
+	}
+	return uc.repo.BatchCheckRelation(ctx, userID, toUserIDs)
+}
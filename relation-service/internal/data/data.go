@@ -1,6 +1,7 @@
 package data
 
 import (
+	"common/outbox"
 	"context"
 	"errors"
 	"github.com/elastic/go-elasticsearch/v8"
@@ -22,7 +23,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewRelationRepo, NewDB, NewRedisClient, NewEsClient, NewDiscover, UserClient)
+var ProviderSet = wire.NewSet(NewData, NewRelationRepo, NewDB, NewRedisClient, NewEsClient, NewDiscover, UserClient, NewOutboxRelay)
 
 // Data .
 type Data struct {
@@ -35,6 +36,8 @@ type Data struct {
 	es      *elasticsearch.TypedClient
 	esIndex string
 
+	relationEventTopic string
+
 	UserClient pbUser.UserServiceClient
 }
 
@@ -53,9 +56,21 @@ func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, es
 		es:         es,
 		esIndex:    esCfg.Index,
 		UserClient: cu,
+
+		relationEventTopic: c.GetKafka().GetRelationEventTopic(),
 	}, cleanup, nil
 }
 
+// NewOutboxRelay 发布 outbox 中的事件
+func NewOutboxRelay(c *conf.Data, db *gorm.DB, logger log.Logger) *outbox.Relay {
+	return outbox.NewRelay(db, outbox.Config{
+		Brokers:   c.GetKafka().GetBrokers(),
+		Interval:  c.GetOutbox().GetInterval().AsDuration(),
+		BatchSize: int(c.GetOutbox().GetBatchSize()),
+		Retention: c.GetOutbox().GetRetention().AsDuration(),
+	}, logger)
+}
+
 // NewDB 数据库连接
 func NewDB(cfg *conf.Data) (*gorm.DB, error) {
 	switch strings.ToLower(cfg.Database.Driver) {
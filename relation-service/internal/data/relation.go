@@ -1,12 +1,15 @@
 package data
 
 import (
+	"common/outbox"
+	"common/rediskey"
 	"context"
 	"errors"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	pbUser "ralation-service/api/user/v1"
 	"ralation-service/internal/biz/params"
 	"ralation-service/internal/data/model"
@@ -53,77 +56,41 @@ func (r *relationRepo) ParseToken(ctx context.Context, token, refreshToken strin
 	return resp.UserId, nil
 }
 
-// CreateRelation 建立关系
+// CreateRelation 建立关系，Redis 由关注事件异步同步
 func (r *relationRepo) CreateRelation(ctx context.Context, userID, toUserID int64) error {
 	r.log.WithContext(ctx).Infof("User %d followed user %d", userID, toUserID)
 
-	// 1. 判断是否已经建立了关系
-	exist, err := r.CheckRelationExist(ctx, userID, toUserID)
-	if err != nil {
-		return err
-	}
-
-	// 事务完成后，同步更新 Redis（最终一致，不影响主流程）
-	go func() {
-		keyFollowing := fmt.Sprintf("user:folloing:%d", userID)
-		keyFollower := fmt.Sprintf("user:folloer:%d", toUserID)
-		relationKey := fmt.Sprintf("relation:%d:%d", userID, toUserID)
-
-		pipe := r.data.rdb.TxPipeline()
-		pipe.SAdd(ctx, keyFollowing, toUserID)
-		pipe.SAdd(ctx, keyFollower, userID)
-		pipe.Set(ctx, relationKey, "1", 24*time.Hour)
-		pipe.Expire(ctx, keyFollowing, 24*time.Hour)
-		pipe.Expire(ctx, keyFollower, 24*time.Hour)
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		queryTx := query.Use(tx)
 
-		_, err := pipe.Exec(ctx)
+		// 1. 恢复软删除关系
+		result, err := queryTx.Relation.WithContext(ctx).Unscoped().
+			Where(
+				queryTx.Relation.UserID.Eq(userID),
+				queryTx.Relation.ToUserID.Eq(toUserID),
+				queryTx.Relation.DeletedAt.IsNotNull(),
+			).
+			Update(queryTx.Relation.DeletedAt, nil)
 		if err != nil {
-			r.log.WithContext(ctx).Errorf("pipe.SAdd: %v", err)
+			return err
 		}
-	}()
-
-	if exist {
-		return r.data.db.Transaction(func(tx *gorm.DB) error {
-			queryTx := query.Use(tx)
-
-			// 恢复软删除关系
-			_, err := queryTx.Relation.WithContext(ctx).
-				Where(
-					queryTx.Relation.UserID.Eq(userID),
-					queryTx.Relation.ToUserID.Eq(toUserID),
-					queryTx.Relation.DeletedAt.IsNotNull(),
-				).
-				Update(queryTx.Relation.DeletedAt, nil)
-			if err != nil {
-				return err
-			}
-
-			if err := r.updateFollowStats(ctx, tx, userID, toUserID, 1); err != nil {
-				return err
-			}
 
-			return nil
-		})
-	} else {
-		// 2. 建立关系
-		return r.data.db.Transaction(func(tx *gorm.DB) error {
-			queryTx := query.Use(tx)
-
-			// 添加relation表
-			if err := queryTx.Relation.
-				WithContext(ctx).
-				Create(&model.Relation{UserID: userID, ToUserID: toUserID}); err != nil {
-				return err
+		// 2. 没有历史关系时新建，已关注时不重复计数
+		if result.RowsAffected == 0 {
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Relation{UserID: userID, ToUserID: toUserID})
+			if res.Error != nil {
+				return res.Error
 			}
-
-			if err := r.updateFollowStats(ctx, tx, userID, toUserID, 1); err != nil {
-				return err
+			if res.RowsAffected == 0 {
+				return nil
 			}
+		}
 
-			return nil
-		})
-	}
-
+		if err := r.updateFollowStats(ctx, tx, userID, toUserID, 1); err != nil {
+			return err
+		}
+		return r.addRelationEvent(tx, params.EventRelationFollowed, userID, toUserID)
+	})
 }
 
 func (r *relationRepo) updateFollowStats(ctx context.Context, tx *gorm.DB, userID, toUserID int64, delta int32) error {
@@ -160,58 +127,64 @@ func (r *relationRepo) DeleteRelation(ctx context.Context, userID, toUserID int6
 	}
 
 	// 2. 删除关系
-	err = r.data.db.Transaction(func(tx *gorm.DB) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txQuery := query.Use(tx)
 
-		// 删除用户关系
-		if _, err := txQuery.Relation.
+		// 删除用户关系，并发取关时只有一次生效
+		result, err := txQuery.Relation.
 			WithContext(ctx).
 			Where(
 				txQuery.Relation.UserID.Eq(userID),
 				txQuery.Relation.ToUserID.Eq(toUserID),
 				txQuery.Relation.DeletedAt.IsNull()).
-			Update(txQuery.Relation.DeletedAt, time.Now()); err != nil {
+			Update(txQuery.Relation.DeletedAt, time.Now())
+		if err != nil {
 			return err
 		}
-
-		// 更新user表中的粉丝数和关注数
-		if _, err := txQuery.User.
-			WithContext(ctx).
-			Where(txQuery.User.ID.Eq(toUserID)).
-			UpdateSimple(txQuery.User.FollowerCount.Sub(1)); err != nil {
-			return err
+		if result.RowsAffected == 0 {
+			return nil
 		}
 
-		if _, err := txQuery.User.
-			WithContext(ctx).
-			Where(txQuery.User.ID.Eq(userID)).
-			UpdateSimple(txQuery.User.FollowCount.Sub(1)); err != nil {
+		// 更新user表中的粉丝数和关注数
+		if err := r.updateFollowStats(ctx, tx, userID, toUserID, -1); err != nil {
 			return err
 		}
-
-		return nil
+		return r.addRelationEvent(tx, params.EventRelationUnfollowed, userID, toUserID)
 	})
-	if err != nil {
-		return err
-	}
+}
 
-	go func() {
-		keyFollowing := fmt.Sprintf("user:following:%d", userID)
-		keyFollower := fmt.Sprintf("user:follower:%d", toUserID)
-		relationKey := fmt.Sprintf("relation:%d:%d", userID, toUserID)
+// addRelationEvent 在关系事务中写入事件，同一对用户的关注、取关按顺序投递
+func (r *relationRepo) addRelationEvent(tx *gorm.DB, eventType string, userID, toUserID int64) error {
+	return outbox.Add(tx, outbox.Event{
+		Topic: r.data.relationEventTopic,
+		Key:   fmt.Sprintf("%d:%d", userID, toUserID),
+		Type:  eventType,
+		Data:  params.RelationEvent{UserID: userID, ToUserID: toUserID},
+	})
+}
 
-		pipe := r.data.rdb.TxPipeline()
+// SyncRelationCache 按关注事件更新 Redis 中的关注、粉丝集合与关系缓存，重复执行结果相同
+func (r *relationRepo) SyncRelationCache(ctx context.Context, userID, toUserID int64, following bool) error {
+	keyFollowing := fmt.Sprintf("user:following:%d", userID)
+	keyFollower := fmt.Sprintf("user:follower:%d", toUserID)
+	relationKey := fmt.Sprintf("relation:%d:%d", userID, toUserID)
 
+	pipe := r.data.rdb.TxPipeline()
+	if following {
+		pipe.SAdd(ctx, keyFollowing, toUserID)
+		pipe.SAdd(ctx, keyFollower, userID)
+		pipe.Set(ctx, relationKey, "1", 24*time.Hour)
+		pipe.Expire(ctx, keyFollowing, 24*time.Hour)
+		pipe.Expire(ctx, keyFollower, 24*time.Hour)
+	} else {
 		pipe.SRem(ctx, keyFollowing, toUserID) // 从 following 集合移除被关注人
 		pipe.SRem(ctx, keyFollower, userID)    // 从 follower 集合移除关注人
 		pipe.Set(ctx, relationKey, "0", 24*time.Hour)
-		_, err := pipe.Exec(ctx)
-		if err != nil {
-			r.log.WithContext(ctx).Errorf("pipe.Del: %v", err)
-		}
-	}()
-
-	return nil
+	}
+	// 关注的人变化后删除关注流收件箱，由 feed-service 下次读取时重建
+	pipe.Del(ctx, rediskey.FeedInbox(userID))
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
 // CheckRelationExist 关系是否存在
@@ -262,6 +235,57 @@ func (r *relationRepo) CheckRelationExist(ctx context.Context, userID, toUserID
 	return true, nil
 }
 
+// BatchCheckRelation 先批量读取关系缓存，未命中的查数据库并回填，不存在的关系也缓存避免穿透
+func (r *relationRepo) BatchCheckRelation(ctx context.Context, userID int64, toUserIDs []int64) ([]int64, error) {
+	keys := make([]string, 0, len(toUserIDs))
+	for _, id := range toUserIDs {
+		keys = append(keys, fmt.Sprintf("relation:%d:%d", userID, id))
+	}
+	vals, err := r.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("redis mget error: %v", err)
+		vals = make([]interface{}, len(keys))
+	}
+
+	res := make([]int64, 0, len(toUserIDs))
+	var misses []int64
+	for i, id := range toUserIDs {
+		switch vals[i] {
+		case "1":
+			res = append(res, id)
+		case "0":
+		default:
+			misses = append(misses, id)
+		}
+	}
+	if len(misses) == 0 {
+		return res, nil
+	}
+
+	rel := r.data.query.Relation
+	var found []int64
+	if err := rel.WithContext(ctx).Where(rel.UserID.Eq(userID), rel.ToUserID.In(misses...)).Pluck(rel.ToUserID, &found); err != nil {
+		return nil, err
+	}
+	following := make(map[int64]bool, len(found))
+	for _, id := range found {
+		following[id] = true
+	}
+	// 只在缓存仍不存在时回填，避免覆盖并发关注、取关刚写入的值
+	pipe := r.data.rdb.Pipeline()
+	for _, id := range misses {
+		val := "0"
+		if following[id] {
+			val = "1"
+		}
+		pipe.SetNX(ctx, fmt.Sprintf("relation:%d:%d", userID, id), val, 10*time.Minute)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.WithContext(ctx).Errorf("redis backfill relation error: %v", err)
+	}
+	return append(res, found...), nil
+}
+
 func (r *relationRepo) queryRelationExistInES(ctx context.Context, userID, toUserID int64) (bool, error) {
 
 	resq, err := r.data.es.Search().Index(r.data.esIndex).Query(
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"ralation-service/internal/biz"
+	"ralation-service/internal/biz/params"
+	"ralation-service/internal/conf"
+	"time"
+)
+
+const (
+	relationEventRetryMin = 100 * time.Millisecond
+	relationEventRetryMax = 10 * time.Second
+)
+
+// 事件消息体，与 outbox.Envelope 对应
+type relationEventEnvelope struct {
+	EventID string               `json:"event_id"`
+	Type    string               `json:"type"`
+	Data    params.RelationEvent `json:"data"`
+}
+
+// RelationEventServer 消费关注事件，同步 Redis
+type RelationEventServer struct {
+	reader *kafka.Reader
+	uc     *biz.RelationUsecase
+	log    *log.Helper
+}
+
+// NewRelationEventServer new a relation event consumer.
+func NewRelationEventServer(c *conf.Data, uc *biz.RelationUsecase, logger log.Logger) *RelationEventServer {
+	s := &RelationEventServer{uc: uc, log: log.NewHelper(logger)}
+	if c.GetKafka().GetRelationEventTopic() != "" {
+		s.reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers: c.GetKafka().GetBrokers(),
+			Topic:   c.GetKafka().GetRelationEventTopic(),
+			GroupID: c.GetKafka().GetGroupId(),
+		})
+	}
+	return s
+}
+
+// Start 逐条处理并提交 offset，失败时退避重试，保证同一对用户的事件按顺序生效
+func (s *RelationEventServer) Start(ctx context.Context) error {
+	if s.reader == nil {
+		return nil
+	}
+	s.log.Info("relation event consumer start")
+
+	for {
+		m, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.log.Errorf("fetch relation event failed: %v", err)
+			return err
+		}
+		if !s.handle(ctx, m) {
+			return nil
+		}
+		if err := s.reader.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			s.log.Errorf("commit relation event offset failed: %v", err)
+		}
+	}
+}
+
+// handle 处理单条消息直到成功，ctx 取消时返回 false
+func (s *RelationEventServer) handle(ctx context.Context, m kafka.Message) bool {
+	env := new(relationEventEnvelope)
+	if err := json.Unmarshal(m.Value, env); err != nil {
+		// 无法解析的消息重试也不会成功，跳过
+		s.log.Errorf("unmarshal relation event failed: %v, value: %s", err, string(m.Value))
+		return true
+	}
+
+	backoff := relationEventRetryMin
+	for {
+		err := s.uc.HandleRelationEvent(ctx, env.Type, &env.Data)
+		if err == nil {
+			return true
+		}
+		s.log.Errorf("handle relation event %s failed, retry in %s: %v", env.EventID, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > relationEventRetryMax {
+			backoff = relationEventRetryMax
+		}
+	}
+}
+
+func (s *RelationEventServer) Stop(ctx context.Context) error {
+	if s.reader == nil {
+		return nil
+	}
+	s.log.Info("relation event consumer stop")
+	return s.reader.Close()
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRegistrar)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRelationEventServer, NewRegistrar)
 
 func NewRegistrar(cfg *conf.Registry) registry.Registrar {
 	c := api.DefaultConfig()
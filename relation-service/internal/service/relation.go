@@ -72,3 +72,16 @@ func (s *RelationService) GetRelationListByUserID(ctx context.Context, req *v1.G
 	}
 	return &v1.GetRelationListByUserIDReply{User: users}, nil
 }
+
+// BatchIsFollowing 批量查询关注状态
+func (s *RelationService) BatchIsFollowing(ctx context.Context, req *v1.BatchIsFollowingRequest) (*v1.BatchIsFollowingReply, error) {
+	if len(req.ToUserIds) > params.MaxBatchRelationQuery {
+		return nil, status.Error(codes.InvalidArgument, "too many to_user_ids")
+	}
+
+	ids, err := s.uc.BatchIsFollowing(ctx, req.UserId, req.ToUserIds)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.BatchIsFollowingReply{FollowingUserIds: ids}, nil
+}
@@ -22,6 +22,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// =========================按用户名搜索用户============================
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchUsersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersReply) Reset() {
+	*x = SearchUsersReply{}
+	mi := &file_user_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersReply) ProtoMessage() {}
+
+func (x *SearchUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersReply.ProtoReflect.Descriptor instead.
+func (*SearchUsersReply) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchUsersReply) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 // =========================更新用户信息============================
 type UpdateUserProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -34,7 +147,7 @@ type UpdateUserProfileRequest struct {
 
 func (x *UpdateUserProfileRequest) Reset() {
 	*x = UpdateUserProfileRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[0]
+	mi := &file_user_v1_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46,7 +159,7 @@ func (x *UpdateUserProfileRequest) String() string {
 func (*UpdateUserProfileRequest) ProtoMessage() {}
 
 func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[0]
+	mi := &file_user_v1_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +172,7 @@ func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpdateUserProfileRequest) GetUser() *User {
@@ -92,7 +205,7 @@ type UpdateUserProfileReply struct {
 
 func (x *UpdateUserProfileReply) Reset() {
 	*x = UpdateUserProfileReply{}
-	mi := &file_user_v1_user_proto_msgTypes[1]
+	mi := &file_user_v1_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +217,7 @@ func (x *UpdateUserProfileReply) String() string {
 func (*UpdateUserProfileReply) ProtoMessage() {}
 
 func (x *UpdateUserProfileReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[1]
+	mi := &file_user_v1_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +230,7 @@ func (x *UpdateUserProfileReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserProfileReply.ProtoReflect.Descriptor instead.
 func (*UpdateUserProfileReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{1}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateUserProfileReply) GetMsg() string {
@@ -137,7 +250,7 @@ type BatchGetUserInfoRequest struct {
 
 func (x *BatchGetUserInfoRequest) Reset() {
 	*x = BatchGetUserInfoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[2]
+	mi := &file_user_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -149,7 +262,7 @@ func (x *BatchGetUserInfoRequest) String() string {
 func (*BatchGetUserInfoRequest) ProtoMessage() {}
 
 func (x *BatchGetUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[2]
+	mi := &file_user_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,7 +275,7 @@ func (x *BatchGetUserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetUserInfoRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BatchGetUserInfoRequest) GetAuthorIds() []int64 {
@@ -181,7 +294,7 @@ type BatchGetUserInfoReply struct {
 
 func (x *BatchGetUserInfoReply) Reset() {
 	*x = BatchGetUserInfoReply{}
-	mi := &file_user_v1_user_proto_msgTypes[3]
+	mi := &file_user_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -193,7 +306,7 @@ func (x *BatchGetUserInfoReply) String() string {
 func (*BatchGetUserInfoReply) ProtoMessage() {}
 
 func (x *BatchGetUserInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[3]
+	mi := &file_user_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +319,7 @@ func (x *BatchGetUserInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetUserInfoReply.ProtoReflect.Descriptor instead.
 func (*BatchGetUserInfoReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BatchGetUserInfoReply) GetUsers() []*Author {
@@ -227,7 +340,7 @@ type Author struct {
 
 func (x *Author) Reset() {
 	*x = Author{}
-	mi := &file_user_v1_user_proto_msgTypes[4]
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +352,7 @@ func (x *Author) String() string {
 func (*Author) ProtoMessage() {}
 
 func (x *Author) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[4]
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +365,7 @@ func (x *Author) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Author.ProtoReflect.Descriptor instead.
 func (*Author) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Author) GetId() int64 {
@@ -286,7 +399,7 @@ type CheckUserExistByUserIDRequest struct {
 
 func (x *CheckUserExistByUserIDRequest) Reset() {
 	*x = CheckUserExistByUserIDRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[5]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +411,7 @@ func (x *CheckUserExistByUserIDRequest) String() string {
 func (*CheckUserExistByUserIDRequest) ProtoMessage() {}
 
 func (x *CheckUserExistByUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[5]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +424,7 @@ func (x *CheckUserExistByUserIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserExistByUserIDRequest.ProtoReflect.Descriptor instead.
 func (*CheckUserExistByUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CheckUserExistByUserIDRequest) GetUserId() int64 {
@@ -330,7 +443,7 @@ type CheckUserExistByUserIDReply struct {
 
 func (x *CheckUserExistByUserIDReply) Reset() {
 	*x = CheckUserExistByUserIDReply{}
-	mi := &file_user_v1_user_proto_msgTypes[6]
+	mi := &file_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -342,7 +455,7 @@ func (x *CheckUserExistByUserIDReply) String() string {
 func (*CheckUserExistByUserIDReply) ProtoMessage() {}
 
 func (x *CheckUserExistByUserIDReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[6]
+	mi := &file_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +468,7 @@ func (x *CheckUserExistByUserIDReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserExistByUserIDReply.ProtoReflect.Descriptor instead.
 func (*CheckUserExistByUserIDReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CheckUserExistByUserIDReply) GetExist() bool {
@@ -375,7 +488,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +500,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +513,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RegisterRequest) GetUsername() string {
@@ -429,7 +542,7 @@ type RegisterReply struct {
 
 func (x *RegisterReply) Reset() {
 	*x = RegisterReply{}
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +554,7 @@ func (x *RegisterReply) String() string {
 func (*RegisterReply) ProtoMessage() {}
 
 func (x *RegisterReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +567,7 @@ func (x *RegisterReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
 func (*RegisterReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RegisterReply) GetStatusCode() int32 {
@@ -496,7 +609,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +621,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +634,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LoginRequest) GetUsername() string {
@@ -551,7 +664,7 @@ type LoginReply struct {
 
 func (x *LoginReply) Reset() {
 	*x = LoginReply{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -563,7 +676,7 @@ func (x *LoginReply) String() string {
 func (*LoginReply) ProtoMessage() {}
 
 func (x *LoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,7 +689,7 @@ func (x *LoginReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
 func (*LoginReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LoginReply) GetStatusCode() int32 {
@@ -625,7 +738,7 @@ type UserInfoRequest struct {
 
 func (x *UserInfoRequest) Reset() {
 	*x = UserInfoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +750,7 @@ func (x *UserInfoRequest) String() string {
 func (*UserInfoRequest) ProtoMessage() {}
 
 func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +763,7 @@ func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
 func (*UserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UserInfoRequest) GetUserId() int64 {
@@ -678,7 +791,7 @@ type UserInfoReply struct {
 
 func (x *UserInfoReply) Reset() {
 	*x = UserInfoReply{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +803,7 @@ func (x *UserInfoReply) String() string {
 func (*UserInfoReply) ProtoMessage() {}
 
 func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +816,7 @@ func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
 func (*UserInfoReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UserInfoReply) GetStatusCode() int32 {
@@ -746,7 +859,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +871,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +884,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *User) GetId() int64 {
@@ -862,7 +975,7 @@ type ParseTokenRequest struct {
 
 func (x *ParseTokenRequest) Reset() {
 	*x = ParseTokenRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +987,7 @@ func (x *ParseTokenRequest) String() string {
 func (*ParseTokenRequest) ProtoMessage() {}
 
 func (x *ParseTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1000,7 @@ func (x *ParseTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseTokenRequest.ProtoReflect.Descriptor instead.
 func (*ParseTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ParseTokenRequest) GetToken() string {
@@ -913,7 +1026,7 @@ type ParseTokenReply struct {
 
 func (x *ParseTokenReply) Reset() {
 	*x = ParseTokenReply{}
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -925,7 +1038,7 @@ func (x *ParseTokenReply) String() string {
 func (*ParseTokenReply) ProtoMessage() {}
 
 func (x *ParseTokenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,7 +1051,7 @@ func (x *ParseTokenReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseTokenReply.ProtoReflect.Descriptor instead.
 func (*ParseTokenReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ParseTokenReply) GetUserId() int64 {
@@ -957,7 +1070,7 @@ type RefreshRequest struct {
 
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -969,7 +1082,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1095,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RefreshRequest) GetRefreshToken() string {
@@ -1003,7 +1116,7 @@ type RefreshReply struct {
 
 func (x *RefreshReply) Reset() {
 	*x = RefreshReply{}
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1015,7 +1128,7 @@ func (x *RefreshReply) String() string {
 func (*RefreshReply) ProtoMessage() {}
 
 func (x *RefreshReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1141,7 @@ func (x *RefreshReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshReply.ProtoReflect.Descriptor instead.
 func (*RefreshReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RefreshReply) GetStatusCode() int32 {
@@ -1062,7 +1175,7 @@ type BatchGetUserDetailInfoRequest struct {
 
 func (x *BatchGetUserDetailInfoRequest) Reset() {
 	*x = BatchGetUserDetailInfoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1074,7 +1187,7 @@ func (x *BatchGetUserDetailInfoRequest) String() string {
 func (*BatchGetUserDetailInfoRequest) ProtoMessage() {}
 
 func (x *BatchGetUserDetailInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,7 +1200,7 @@ func (x *BatchGetUserDetailInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetUserDetailInfoRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetUserDetailInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BatchGetUserDetailInfoRequest) GetIds() []int64 {
@@ -1106,7 +1219,7 @@ type BatchGetUserDetailInfoReply struct {
 
 func (x *BatchGetUserDetailInfoReply) Reset() {
 	*x = BatchGetUserDetailInfoReply{}
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +1231,7 @@ func (x *BatchGetUserDetailInfoReply) String() string {
 func (*BatchGetUserDetailInfoReply) ProtoMessage() {}
 
 func (x *BatchGetUserDetailInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1244,7 @@ func (x *BatchGetUserDetailInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetUserDetailInfoReply.ProtoReflect.Descriptor instead.
 func (*BatchGetUserDetailInfoReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *BatchGetUserDetailInfoReply) GetUser() []*User {
@@ -1145,7 +1258,15 @@ var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\"u\n" +
+	"\x12user/v1/user.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\"_\n" +
+	"\x12SearchUsersRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"O\n" +
+	"\x10SearchUsersReply\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05users\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"u\n" +
 	"\x18UpdateUserProfileRequest\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".user.UserR\x04user\x12\x14\n" +
@@ -1230,7 +1351,7 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x03ids\x18\x01 \x03(\x03R\x03ids\"=\n" +
 	"\x1bBatchGetUserDetailInfoReply\x12\x1e\n" +
 	"\x04user\x18\x01 \x03(\v2\n" +
-	".user.UserR\x04user2\xf2\x05\n" +
+	".user.UserR\x04user2\xcd\x06\n" +
 	"\vUserService\x12U\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x13.user.RegisterReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/user/register\x12I\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x10.user.LoginReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/user/login\x12I\n" +
@@ -1241,7 +1362,8 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x16CheckUserExistByUserID\x12#.user.CheckUserExistByUserIDRequest\x1a!.user.CheckUserExistByUserIDReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/user/check\x12N\n" +
 	"\x10BatchGetUserInfo\x12\x1d.user.BatchGetUserInfoRequest\x1a\x1b.user.BatchGetUserInfoReply\x12`\n" +
 	"\x16BatchGetUserDetailInfo\x12#.user.BatchGetUserDetailInfoRequest\x1a!.user.BatchGetUserDetailInfoReply\x12Q\n" +
-	"\x11UpdateUserProfile\x12\x1e.user.UpdateUserProfileRequest\x1a\x1c.user.UpdateUserProfileReplyB\x15Z\x13user/api/user/v1;v1b\x06proto3"
+	"\x11UpdateUserProfile\x12\x1e.user.UpdateUserProfileRequest\x1a\x1c.user.UpdateUserProfileReply\x12Y\n" +
+	"\vSearchUsers\x12\x18.user.SearchUsersRequest\x1a\x16.user.SearchUsersReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/user/searchB\x15Z\x13user/api/user/v1;v1b\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -1255,57 +1377,62 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_user_v1_user_proto_goTypes = []any{
-	(*UpdateUserProfileRequest)(nil),      // 0: user.UpdateUserProfileRequest
-	(*UpdateUserProfileReply)(nil),        // 1: user.UpdateUserProfileReply
-	(*BatchGetUserInfoRequest)(nil),       // 2: user.BatchGetUserInfoRequest
-	(*BatchGetUserInfoReply)(nil),         // 3: user.BatchGetUserInfoReply
-	(*Author)(nil),                        // 4: user.Author
-	(*CheckUserExistByUserIDRequest)(nil), // 5: user.CheckUserExistByUserIDRequest
-	(*CheckUserExistByUserIDReply)(nil),   // 6: user.CheckUserExistByUserIDReply
-	(*RegisterRequest)(nil),               // 7: user.RegisterRequest
-	(*RegisterReply)(nil),                 // 8: user.RegisterReply
-	(*LoginRequest)(nil),                  // 9: user.LoginRequest
-	(*LoginReply)(nil),                    // 10: user.LoginReply
-	(*UserInfoRequest)(nil),               // 11: user.UserInfoRequest
-	(*UserInfoReply)(nil),                 // 12: user.UserInfoReply
-	(*User)(nil),                          // 13: user.User
-	(*ParseTokenRequest)(nil),             // 14: user.ParseTokenRequest
-	(*ParseTokenReply)(nil),               // 15: user.ParseTokenReply
-	(*RefreshRequest)(nil),                // 16: user.RefreshRequest
-	(*RefreshReply)(nil),                  // 17: user.RefreshReply
-	(*BatchGetUserDetailInfoRequest)(nil), // 18: user.BatchGetUserDetailInfoRequest
-	(*BatchGetUserDetailInfoReply)(nil),   // 19: user.BatchGetUserDetailInfoReply
+	(*SearchUsersRequest)(nil),            // 0: user.SearchUsersRequest
+	(*SearchUsersReply)(nil),              // 1: user.SearchUsersReply
+	(*UpdateUserProfileRequest)(nil),      // 2: user.UpdateUserProfileRequest
+	(*UpdateUserProfileReply)(nil),        // 3: user.UpdateUserProfileReply
+	(*BatchGetUserInfoRequest)(nil),       // 4: user.BatchGetUserInfoRequest
+	(*BatchGetUserInfoReply)(nil),         // 5: user.BatchGetUserInfoReply
+	(*Author)(nil),                        // 6: user.Author
+	(*CheckUserExistByUserIDRequest)(nil), // 7: user.CheckUserExistByUserIDRequest
+	(*CheckUserExistByUserIDReply)(nil),   // 8: user.CheckUserExistByUserIDReply
+	(*RegisterRequest)(nil),               // 9: user.RegisterRequest
+	(*RegisterReply)(nil),                 // 10: user.RegisterReply
+	(*LoginRequest)(nil),                  // 11: user.LoginRequest
+	(*LoginReply)(nil),                    // 12: user.LoginReply
+	(*UserInfoRequest)(nil),               // 13: user.UserInfoRequest
+	(*UserInfoReply)(nil),                 // 14: user.UserInfoReply
+	(*User)(nil),                          // 15: user.User
+	(*ParseTokenRequest)(nil),             // 16: user.ParseTokenRequest
+	(*ParseTokenReply)(nil),               // 17: user.ParseTokenReply
+	(*RefreshRequest)(nil),                // 18: user.RefreshRequest
+	(*RefreshReply)(nil),                  // 19: user.RefreshReply
+	(*BatchGetUserDetailInfoRequest)(nil), // 20: user.BatchGetUserDetailInfoRequest
+	(*BatchGetUserDetailInfoReply)(nil),   // 21: user.BatchGetUserDetailInfoReply
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	13, // 0: user.UpdateUserProfileRequest.user:type_name -> user.User
-	4,  // 1: user.BatchGetUserInfoReply.users:type_name -> user.Author
-	13, // 2: user.UserInfoReply.user:type_name -> user.User
-	13, // 3: user.BatchGetUserDetailInfoReply.user:type_name -> user.User
-	7,  // 4: user.UserService.Register:input_type -> user.RegisterRequest
-	9,  // 5: user.UserService.Login:input_type -> user.LoginRequest
-	11, // 6: user.UserService.UserInfo:input_type -> user.UserInfoRequest
-	16, // 7: user.UserService.RefreshToken:input_type -> user.RefreshRequest
-	14, // 8: user.UserService.ParseToken:input_type -> user.ParseTokenRequest
-	5,  // 9: user.UserService.CheckUserExistByUserID:input_type -> user.CheckUserExistByUserIDRequest
-	2,  // 10: user.UserService.BatchGetUserInfo:input_type -> user.BatchGetUserInfoRequest
-	18, // 11: user.UserService.BatchGetUserDetailInfo:input_type -> user.BatchGetUserDetailInfoRequest
-	0,  // 12: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
-	8,  // 13: user.UserService.Register:output_type -> user.RegisterReply
-	10, // 14: user.UserService.Login:output_type -> user.LoginReply
-	12, // 15: user.UserService.UserInfo:output_type -> user.UserInfoReply
-	17, // 16: user.UserService.RefreshToken:output_type -> user.RefreshReply
-	15, // 17: user.UserService.ParseToken:output_type -> user.ParseTokenReply
-	6,  // 18: user.UserService.CheckUserExistByUserID:output_type -> user.CheckUserExistByUserIDReply
-	3,  // 19: user.UserService.BatchGetUserInfo:output_type -> user.BatchGetUserInfoReply
-	19, // 20: user.UserService.BatchGetUserDetailInfo:output_type -> user.BatchGetUserDetailInfoReply
-	1,  // 21: user.UserService.UpdateUserProfile:output_type -> user.UpdateUserProfileReply
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	15, // 0: user.SearchUsersReply.users:type_name -> user.User
+	15, // 1: user.UpdateUserProfileRequest.user:type_name -> user.User
+	6,  // 2: user.BatchGetUserInfoReply.users:type_name -> user.Author
+	15, // 3: user.UserInfoReply.user:type_name -> user.User
+	15, // 4: user.BatchGetUserDetailInfoReply.user:type_name -> user.User
+	9,  // 5: user.UserService.Register:input_type -> user.RegisterRequest
+	11, // 6: user.UserService.Login:input_type -> user.LoginRequest
+	13, // 7: user.UserService.UserInfo:input_type -> user.UserInfoRequest
+	18, // 8: user.UserService.RefreshToken:input_type -> user.RefreshRequest
+	16, // 9: user.UserService.ParseToken:input_type -> user.ParseTokenRequest
+	7,  // 10: user.UserService.CheckUserExistByUserID:input_type -> user.CheckUserExistByUserIDRequest
+	4,  // 11: user.UserService.BatchGetUserInfo:input_type -> user.BatchGetUserInfoRequest
+	20, // 12: user.UserService.BatchGetUserDetailInfo:input_type -> user.BatchGetUserDetailInfoRequest
+	2,  // 13: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
+	0,  // 14: user.UserService.SearchUsers:input_type -> user.SearchUsersRequest
+	10, // 15: user.UserService.Register:output_type -> user.RegisterReply
+	12, // 16: user.UserService.Login:output_type -> user.LoginReply
+	14, // 17: user.UserService.UserInfo:output_type -> user.UserInfoReply
+	19, // 18: user.UserService.RefreshToken:output_type -> user.RefreshReply
+	17, // 19: user.UserService.ParseToken:output_type -> user.ParseTokenReply
+	8,  // 20: user.UserService.CheckUserExistByUserID:output_type -> user.CheckUserExistByUserIDReply
+	5,  // 21: user.UserService.BatchGetUserInfo:output_type -> user.BatchGetUserInfoReply
+	21, // 22: user.UserService.BatchGetUserDetailInfo:output_type -> user.BatchGetUserDetailInfoReply
+	3,  // 23: user.UserService.UpdateUserProfile:output_type -> user.UpdateUserProfileReply
+	1,  // 24: user.UserService.SearchUsers:output_type -> user.SearchUsersReply
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -1319,7 +1446,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,12 +22,14 @@ const _ = http.SupportPackageIsVersion1
 const OperationUserServiceCheckUserExistByUserID = "/user.UserService/CheckUserExistByUserID"
 const OperationUserServiceLogin = "/user.UserService/Login"
 const OperationUserServiceRegister = "/user.UserService/Register"
+const OperationUserServiceSearchUsers = "/user.UserService/SearchUsers"
 const OperationUserServiceUserInfo = "/user.UserService/UserInfo"
 
 type UserServiceHTTPServer interface {
 	CheckUserExistByUserID(context.Context, *CheckUserExistByUserIDRequest) (*CheckUserExistByUserIDReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 }
 
@@ -37,6 +39,7 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.POST("/api/user/login", _UserService_Login0_HTTP_Handler(srv))
 	r.GET("/api/user", _UserService_UserInfo0_HTTP_Handler(srv))
 	r.GET("/api/user/check", _UserService_CheckUserExistByUserID0_HTTP_Handler(srv))
+	r.GET("/api/user/search", _UserService_SearchUsers0_HTTP_Handler(srv))
 }
 
 func _UserService_Register0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -121,10 +124,30 @@ func _UserService_CheckUserExistByUserID0_HTTP_Handler(srv UserServiceHTTPServer
 	}
 }
 
+func _UserService_SearchUsers0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceSearchUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchUsers(ctx, req.(*SearchUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
 	CheckUserExistByUserID(ctx context.Context, req *CheckUserExistByUserIDRequest, opts ...http.CallOption) (rsp *CheckUserExistByUserIDReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	SearchUsers(ctx context.Context, req *SearchUsersRequest, opts ...http.CallOption) (rsp *SearchUsersReply, err error)
 	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
 }
 
@@ -175,6 +198,19 @@ func (c *UserServiceHTTPClientImpl) Register(ctx context.Context, in *RegisterRe
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...http.CallOption) (*SearchUsersReply, error) {
+	var out SearchUsersReply
+	pattern := "/api/user/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceSearchUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...http.CallOption) (*UserInfoReply, error) {
 	var out UserInfoReply
 	pattern := "/api/user"
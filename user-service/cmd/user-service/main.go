@@ -89,7 +89,7 @@ func main() {
 		panic(err)
 	}
 
-	log.Debugf("bootstrap: %+v", bc)
+	log.Debugf("bootstrap: %+v", &bc)
 
 	//consulAddr := bc.Registry.Consul.Addr
 	Name = bc.Service.Name
@@ -107,7 +107,7 @@ func main() {
 		}
 	}()
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, bc.IdGen, logger, bc.Registry, bc.OpenTelemetry)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, bc.IdGen, logger, bc.Registry, bc.OpenTelemetry, bc.Elasticsearch)
 	if err != nil {
 		panic(err)
 	}
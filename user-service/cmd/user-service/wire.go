@@ -19,6 +19,6 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.JWT, *conf.IDGen, log.Logger, *conf.Registry, *conf.OpenTelemetry) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.JWT, *conf.IDGen, log.Logger, *conf.Registry, *conf.OpenTelemetry, *conf.Elasticsearch) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, pkg.ProviderSet, newApp))
 }
@@ -24,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, idGen *conf.IDGen, logger log.Logger, registry *conf.Registry, openTelemetry *conf.OpenTelemetry) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, idGen *conf.IDGen, logger log.Logger, registry *conf.Registry, openTelemetry *conf.OpenTelemetry, elasticsearch *conf.Elasticsearch) (*kratos.App, func(), error) {
 	db, err := data.NewDB(confData)
 	if err != nil {
 		return nil, nil, err
@@ -32,7 +32,11 @@ func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, idGen
 	client := data.NewRedisClient(confData)
 	jwtManager := pkg.NewJWTManagerProvider(jwt)
 	idGenerator := pkg.NewIDGen(idGen)
-	dataData, cleanup, err := data.NewData(db, logger, client, jwtManager, idGenerator)
+	typedClient, err := data.NewEsClient(elasticsearch)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataData, cleanup, err := data.NewData(db, logger, client, jwtManager, idGenerator, elasticsearch, typedClient)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,3 +63,9 @@ type UpdateUserRequsetParam struct {
 	BackgroundImage string
 	Signature       string
 }
+
+type SearchUsersParam struct {
+	Keyword  string
+	Page     int32
+	PageSize int32
+}
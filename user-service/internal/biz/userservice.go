@@ -24,6 +24,7 @@ type UserRepo interface {
 	BatchGetUserInfo(ctx context.Context, userIds []int64) ([]*param.Author, error)
 	BatchGetUserDetailInfo(ctx context.Context, userIds []int64) ([]*param.UserInfoParam, error)
 	UpdateUserProfile(ctx context.Context, requsetParam *param.UpdateUserRequsetParam) error
+	SearchUsers(ctx context.Context, in *param.SearchUsersParam) ([]*param.UserInfoParam, bool, error)
 }
 
 // UserService 用户相关业务逻辑封装
@@ -207,3 +208,29 @@ func (uc *UserService) UpdateUserProfile(ctx context.Context, param *param.Updat
 	uc.log.WithContext(ctx).Debugf("UpdateUserProfile: %v", param)
 	return uc.repo.UpdateUserProfile(ctx, param)
 }
+
+// SearchUsers 按用户名搜索用户
+func (uc *UserService) SearchUsers(ctx context.Context, in *param.SearchUsersParam) ([]*pb.User, bool, error) {
+	users, hasMore, err := uc.repo.SearchUsers(ctx, in)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("SearchUsers failed: %v", err)
+		return nil, false, errors.New(500, "SEARCH_USERS_FAILED", "搜索用户失败")
+	}
+
+	userList := make([]*pb.User, 0, len(users))
+	for _, u := range users {
+		userList = append(userList, &pb.User{
+			Id:              u.ID,
+			Name:            u.Name,
+			FollowCount:     u.FollowCount,
+			FollowerCount:   u.FollowerCount,
+			Avatar:          u.Avatar,
+			BackgroundImage: u.BackgroundImage,
+			Signature:       u.Signature,
+			TotalFavorited:  u.TotalFavorited,
+			WorkCount:       u.WorkCount,
+			FavoriteCount:   u.FavoriteCount,
+		})
+	}
+	return userList, hasMore, nil
+}
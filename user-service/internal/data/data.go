@@ -2,6 +2,7 @@ package data
 
 import (
 	"errors"
+	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/sqlite"
@@ -16,7 +17,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewDB, NewRedisClient)
+var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewDB, NewRedisClient, NewEsClient)
 
 // Data .
 type Data struct {
@@ -27,15 +28,18 @@ type Data struct {
 	rdb   *redis.Client
 	jwt   *pkg.JWTManager
 	idg   *pkg.IDGenerator
+
+	es          *elasticsearch.TypedClient
+	esUserIndex string
 }
 
-func NewData(db *gorm.DB, logger log.Logger, rdb *redis.Client, jwt *pkg.JWTManager, idg *pkg.IDGenerator) (*Data, func(), error) {
+func NewData(db *gorm.DB, logger log.Logger, rdb *redis.Client, jwt *pkg.JWTManager, idg *pkg.IDGenerator, esCfg *conf.Elasticsearch, es *elasticsearch.TypedClient) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 	// 非常重要!为GEN生成的query代码设置数据库连接对象
 	query.SetDefault(db)
-	return &Data{query: query.Q, log: log.NewHelper(logger), rdb: rdb, jwt: jwt, idg: idg}, cleanup, nil
+	return &Data{query: query.Q, log: log.NewHelper(logger), rdb: rdb, jwt: jwt, idg: idg, es: es, esUserIndex: esCfg.GetUserIndex()}, cleanup, nil
 }
 
 // NewDB 数据库连接
@@ -57,3 +61,11 @@ func NewRedisClient(cfg *conf.Data) *redis.Client {
 		ReadTimeout:  cfg.Redis.ReadTimeout.AsDuration(),
 	})
 }
+
+// NewEsClient 连接es
+func NewEsClient(cfg *conf.Elasticsearch) (*elasticsearch.TypedClient, error) {
+	c := elasticsearch.Config{
+		Addresses: cfg.GetAddresses(),
+	}
+	return elasticsearch.NewTypedClient(c)
+}
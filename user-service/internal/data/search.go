@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
+	"user-service/internal/biz/param"
+	"user-service/internal/pkg/metrics"
+)
+
+const (
+	// searchMaxOffset 最多翻到的条数，避免深分页
+	searchMaxOffset = 200
+	// searchDBTimeout sql 兜底查询超时时间
+	searchDBTimeout = 2 * time.Second
+)
+
+// likeEscaper 转义 LIKE 中的通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchUsers 按用户名搜索用户，es 不可用时用 sql 前缀匹配兜底
+func (r *userRepo) SearchUsers(ctx context.Context, in *param.SearchUsersParam) ([]*param.UserInfoParam, bool, error) {
+	offset := int((in.Page - 1) * in.PageSize)
+	if offset >= searchMaxOffset {
+		return []*param.UserInfoParam{}, false, nil
+	}
+
+	ids, hasMore, err := r.searchUserIDsFromES(ctx, in.Keyword, offset, int(in.PageSize))
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("search users from es err: %v, fallback to DB", err)
+		return r.searchUsersFromDB(ctx, in.Keyword, offset, int(in.PageSize))
+	}
+	if len(ids) == 0 {
+		return []*param.UserInfoParam{}, false, nil
+	}
+
+	// es 只负责召回排序，用户详情以 DB 为准
+	users, err := r.BatchGetUserDetailInfo(ctx, ids)
+	if err != nil {
+		return nil, false, err
+	}
+	userMap := make(map[int64]*param.UserInfoParam, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+	res := make([]*param.UserInfoParam, 0, len(ids))
+	for _, id := range ids {
+		if u, ok := userMap[id]; ok {
+			res = append(res, u)
+		}
+	}
+	return res, hasMore && offset+int(in.PageSize) < searchMaxOffset, nil
+}
+
+func (r *userRepo) searchUserIDsFromES(ctx context.Context, keyword string, offset, size int) ([]int64, bool, error) {
+	if r.data.esUserIndex == "" {
+		return nil, false, fmt.Errorf("user index not configured")
+	}
+	done := ObserveDuration(metrics.DBQueryDuration, []string{"SearchUsersES"})
+	res, err := r.data.es.Search().
+		Index(r.data.esUserIndex).
+		Query(&types.Query{
+			Bool: &types.BoolQuery{
+				Should: []types.Query{
+					{Match: map[string]types.MatchQuery{"username": {Query: keyword, Fuzziness: "AUTO"}}},
+					{MatchPhrasePrefix: map[string]types.MatchPhrasePrefixQuery{"username": {Query: keyword}}},
+				},
+				MinimumShouldMatch: 1,
+			},
+		}).
+		Sort(
+			types.SortOptions{Score_: &types.ScoreSort{Order: &sortorder.Desc}},
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"follower_count": {Order: &sortorder.Desc}}},
+		).
+		Source_(&types.SourceFilter{Includes: []string{"id"}}).
+		From(offset).
+		Size(size + 1).
+		Do(ctx)
+	done()
+	if err != nil {
+		return nil, false, err
+	}
+
+	hits := res.Hits.Hits
+	hasMore := len(hits) > size
+	if hasMore {
+		hits = hits[:size]
+	}
+	ids := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		var doc struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.Unmarshal(hit.Source_, &doc); err != nil {
+			continue
+		}
+		// canal 同步的 id 为字符串
+		var idStr string
+		if err := json.Unmarshal(doc.ID, &idStr); err != nil {
+			idStr = string(doc.ID)
+		}
+		if id, err := strconv.ParseInt(idStr, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids, hasMore, nil
+}
+
+// searchUsersFromDB 用户名前缀匹配，可以走 username 索引
+func (r *userRepo) searchUsersFromDB(ctx context.Context, keyword string, offset, size int) ([]*param.UserInfoParam, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, searchDBTimeout)
+	defer cancel()
+
+	done := ObserveDuration(metrics.DBQueryDuration, []string{"SearchUsers"})
+	u := r.data.query.User
+	users, err := u.WithContext(ctx).
+		Where(u.Username.Like(likeEscaper.Replace(keyword) + "%")).
+		Order(u.FollowerCount.Desc(), u.ID.Desc()).
+		Offset(offset).
+		Limit(size + 1).
+		Find()
+	done()
+	if err != nil {
+		metrics.DBQueryErrorCount.WithLabelValues("SearchUsers", err.Error()).Inc()
+		return nil, false, err
+	}
+
+	hasMore := len(users) > size && offset+size < searchMaxOffset
+	if len(users) > size {
+		users = users[:size]
+	}
+	res := make([]*param.UserInfoParam, 0, len(users))
+	for _, u := range users {
+		res = append(res, &param.UserInfoParam{
+			ID:              u.ID,
+			Name:            u.Username,
+			FollowCount:     u.FollowCount,
+			FollowerCount:   u.FollowerCount,
+			Avatar:          u.Avatar,
+			BackgroundImage: u.BackgroundImage,
+			Signature:       u.Signature,
+			TotalFavorited:  u.TotalFavorited,
+			WorkCount:       u.WorkCount,
+			FavoriteCount:   u.FavoriteCount,
+		})
+	}
+	return res, hasMore, nil
+}
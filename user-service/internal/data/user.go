@@ -1,6 +1,7 @@
 package data
 
 import (
+	"common/invalidate"
 	"context"
 	"encoding/json"
 	"errors"
@@ -188,7 +189,7 @@ func (r *userRepo) CheckUserExistByUserID(ctx context.Context, userID int64) (bo
 	_, err := r.data.query.User.WithContext(ctx).Where(r.data.query.User.ID.Eq(userID)).First()
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			log.Debugf("user %s not exist", userID)
+			log.Debugf("user %d not exist", userID)
 			return false, nil
 		}
 		return false, err
@@ -287,9 +288,17 @@ func (r *userRepo) UpdateUserProfile(ctx context.Context, requestParam *param.Up
 		r.log.WithContext(ctx).Warnf("Redis DEL user profile error: %v", err)
 		return err
 	}
+	r.invalidateAuthor(ctx, requestParam.ID)
 	return nil
 }
 
+// invalidateAuthor 通知其他服务删除本地缓存的作者信息，发布失败时等本地缓存过期
+func (r *userRepo) invalidateAuthor(ctx context.Context, userID int64) {
+	if err := invalidate.Publish(ctx, r.data.rdb, invalidate.KindAuthor, userID); err != nil {
+		r.log.WithContext(ctx).Warnf("publish author cache invalidation error: %v", err)
+	}
+}
+
 // ObserveDuration Prometheus 监控
 func ObserveDuration(histogram *prometheus.HistogramVec, labels []string) func() {
 	start := time.Now()
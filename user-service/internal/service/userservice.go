@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
 	"user-service/internal/biz"
 	param "user-service/internal/biz/param"
 
@@ -151,3 +152,27 @@ func (s *UserServiceService) UpdateUserProfile(ctx context.Context, req *pb.Upda
 	}
 	return &pb.UpdateUserProfileReply{Msg: "success"}, nil
 }
+
+// 按用户名搜索用户
+func (s *UserServiceService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersReply, error) {
+	keyword := strings.TrimSpace(req.Keyword)
+	if keyword == "" {
+		return nil, errors.New(400, "INVALID_PARAM", "keyword 不能为空")
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 20 {
+		req.PageSize = 20
+	}
+
+	users, hasMore, err := s.uc.SearchUsers(ctx, &param.SearchUsersParam{
+		Keyword:  keyword,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SearchUsersReply{Users: users, HasMore: hasMore}, nil
+}
@@ -23,6 +23,2336 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ShareChannel int32
+
+const (
+	ShareChannel_SHARE_CHANNEL_LINK    ShareChannel = 0 // 复制链接
+	ShareChannel_SHARE_CHANNEL_WECHAT  ShareChannel = 1
+	ShareChannel_SHARE_CHANNEL_MOMENTS ShareChannel = 2
+	ShareChannel_SHARE_CHANNEL_QQ      ShareChannel = 3
+	ShareChannel_SHARE_CHANNEL_WEIBO   ShareChannel = 4
+	ShareChannel_SHARE_CHANNEL_OTHER   ShareChannel = 5
+)
+
+// Enum value maps for ShareChannel.
+var (
+	ShareChannel_name = map[int32]string{
+		0: "SHARE_CHANNEL_LINK",
+		1: "SHARE_CHANNEL_WECHAT",
+		2: "SHARE_CHANNEL_MOMENTS",
+		3: "SHARE_CHANNEL_QQ",
+		4: "SHARE_CHANNEL_WEIBO",
+		5: "SHARE_CHANNEL_OTHER",
+	}
+	ShareChannel_value = map[string]int32{
+		"SHARE_CHANNEL_LINK":    0,
+		"SHARE_CHANNEL_WECHAT":  1,
+		"SHARE_CHANNEL_MOMENTS": 2,
+		"SHARE_CHANNEL_QQ":      3,
+		"SHARE_CHANNEL_WEIBO":   4,
+		"SHARE_CHANNEL_OTHER":   5,
+	}
+)
+
+func (x ShareChannel) Enum() *ShareChannel {
+	p := new(ShareChannel)
+	*p = x
+	return p
+}
+
+func (x ShareChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_video_v1_video_proto_enumTypes[0].Descriptor()
+}
+
+func (ShareChannel) Type() protoreflect.EnumType {
+	return &file_video_v1_video_proto_enumTypes[0]
+}
+
+func (x ShareChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareChannel.Descriptor instead.
+func (ShareChannel) EnumDescriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{0}
+}
+
+type SearchSectionType int32
+
+const (
+	SearchSectionType_SEARCH_SECTION_VIDEO SearchSectionType = 0
+	SearchSectionType_SEARCH_SECTION_USER  SearchSectionType = 1
+	SearchSectionType_SEARCH_SECTION_TAG   SearchSectionType = 2
+)
+
+// Enum value maps for SearchSectionType.
+var (
+	SearchSectionType_name = map[int32]string{
+		0: "SEARCH_SECTION_VIDEO",
+		1: "SEARCH_SECTION_USER",
+		2: "SEARCH_SECTION_TAG",
+	}
+	SearchSectionType_value = map[string]int32{
+		"SEARCH_SECTION_VIDEO": 0,
+		"SEARCH_SECTION_USER":  1,
+		"SEARCH_SECTION_TAG":   2,
+	}
+)
+
+func (x SearchSectionType) Enum() *SearchSectionType {
+	p := new(SearchSectionType)
+	*p = x
+	return p
+}
+
+func (x SearchSectionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchSectionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_video_v1_video_proto_enumTypes[1].Descriptor()
+}
+
+func (SearchSectionType) Type() protoreflect.EnumType {
+	return &file_video_v1_video_proto_enumTypes[1]
+}
+
+func (x SearchSectionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchSectionType.Descriptor instead.
+func (SearchSectionType) EnumDescriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{1}
+}
+
+type HotSearchAction int32
+
+const (
+	HotSearchAction_HOT_SEARCH_ACTION_UNSPECIFIED HotSearchAction = 0
+	HotSearchAction_HOT_SEARCH_ACTION_PIN         HotSearchAction = 1
+	HotSearchAction_HOT_SEARCH_ACTION_UNPIN       HotSearchAction = 2
+	HotSearchAction_HOT_SEARCH_ACTION_BLOCK       HotSearchAction = 3
+	HotSearchAction_HOT_SEARCH_ACTION_UNBLOCK     HotSearchAction = 4
+)
+
+// Enum value maps for HotSearchAction.
+var (
+	HotSearchAction_name = map[int32]string{
+		0: "HOT_SEARCH_ACTION_UNSPECIFIED",
+		1: "HOT_SEARCH_ACTION_PIN",
+		2: "HOT_SEARCH_ACTION_UNPIN",
+		3: "HOT_SEARCH_ACTION_BLOCK",
+		4: "HOT_SEARCH_ACTION_UNBLOCK",
+	}
+	HotSearchAction_value = map[string]int32{
+		"HOT_SEARCH_ACTION_UNSPECIFIED": 0,
+		"HOT_SEARCH_ACTION_PIN":         1,
+		"HOT_SEARCH_ACTION_UNPIN":       2,
+		"HOT_SEARCH_ACTION_BLOCK":       3,
+		"HOT_SEARCH_ACTION_UNBLOCK":     4,
+	}
+)
+
+func (x HotSearchAction) Enum() *HotSearchAction {
+	p := new(HotSearchAction)
+	*p = x
+	return p
+}
+
+func (x HotSearchAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HotSearchAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_video_v1_video_proto_enumTypes[2].Descriptor()
+}
+
+func (HotSearchAction) Type() protoreflect.EnumType {
+	return &file_video_v1_video_proto_enumTypes[2]
+}
+
+func (x HotSearchAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HotSearchAction.Descriptor instead.
+func (HotSearchAction) EnumDescriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{2}
+}
+
+// 搜索排序方式
+type SearchSort int32
+
+const (
+	SearchSort_SEARCH_SORT_RELEVANCE  SearchSort = 0 // 相关度
+	SearchSort_SEARCH_SORT_NEWEST     SearchSort = 1 // 最新发布
+	SearchSort_SEARCH_SORT_MOST_LIKED SearchSort = 2 // 最多点赞
+)
+
+// Enum value maps for SearchSort.
+var (
+	SearchSort_name = map[int32]string{
+		0: "SEARCH_SORT_RELEVANCE",
+		1: "SEARCH_SORT_NEWEST",
+		2: "SEARCH_SORT_MOST_LIKED",
+	}
+	SearchSort_value = map[string]int32{
+		"SEARCH_SORT_RELEVANCE":  0,
+		"SEARCH_SORT_NEWEST":     1,
+		"SEARCH_SORT_MOST_LIKED": 2,
+	}
+)
+
+func (x SearchSort) Enum() *SearchSort {
+	p := new(SearchSort)
+	*p = x
+	return p
+}
+
+func (x SearchSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_video_v1_video_proto_enumTypes[3].Descriptor()
+}
+
+func (SearchSort) Type() protoreflect.EnumType {
+	return &file_video_v1_video_proto_enumTypes[3]
+}
+
+func (x SearchSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchSort.Descriptor instead.
+func (SearchSort) EnumDescriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{3}
+}
+
+// 发布草稿
+type PublishVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // 为空时立即发布，否则定时发布
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishVideoRequest) Reset() {
+	*x = PublishVideoRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishVideoRequest) ProtoMessage() {}
+
+func (x *PublishVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishVideoRequest.ProtoReflect.Descriptor instead.
+func (*PublishVideoRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PublishVideoRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *PublishVideoRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *PublishVideoRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PublishVideoRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type PublishVideoReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublishStatus int32                  `protobuf:"varint,1,opt,name=publish_status,json=publishStatus,proto3" json:"publish_status,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishVideoReply) Reset() {
+	*x = PublishVideoReply{}
+	mi := &file_video_v1_video_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishVideoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishVideoReply) ProtoMessage() {}
+
+func (x *PublishVideoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishVideoReply.ProtoReflect.Descriptor instead.
+func (*PublishVideoReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublishVideoReply) GetPublishStatus() int32 {
+	if x != nil {
+		return x.PublishStatus
+	}
+	return 0
+}
+
+func (x *PublishVideoReply) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// 草稿列表
+type ListDraftVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDraftVideosRequest) Reset() {
+	*x = ListDraftVideosRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDraftVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftVideosRequest) ProtoMessage() {}
+
+func (x *ListDraftVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftVideosRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDraftVideosRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListDraftVideosRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ListDraftVideosRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDraftVideosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDraftVideosReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDraftVideosReply) Reset() {
+	*x = ListDraftVideosReply{}
+	mi := &file_video_v1_video_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDraftVideosReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftVideosReply) ProtoMessage() {}
+
+func (x *ListDraftVideosReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftVideosReply.ProtoReflect.Descriptor instead.
+func (*ListDraftVideosReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDraftVideosReply) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListDraftVideosReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 分享视频
+type ShareVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Channel       ShareChannel           `protobuf:"varint,2,opt,name=channel,proto3,enum=video.ShareChannel" json:"channel,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareVideoRequest) Reset() {
+	*x = ShareVideoRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareVideoRequest) ProtoMessage() {}
+
+func (x *ShareVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareVideoRequest.ProtoReflect.Descriptor instead.
+func (*ShareVideoRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShareVideoRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ShareVideoRequest) GetChannel() ShareChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ShareChannel_SHARE_CHANNEL_LINK
+}
+
+func (x *ShareVideoRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareVideoRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ShareVideoReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareCode     string                 `protobuf:"bytes,1,opt,name=share_code,json=shareCode,proto3" json:"share_code,omitempty"`
+	ShareUrl      string                 `protobuf:"bytes,2,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareVideoReply) Reset() {
+	*x = ShareVideoReply{}
+	mi := &file_video_v1_video_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareVideoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareVideoReply) ProtoMessage() {}
+
+func (x *ShareVideoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareVideoReply.ProtoReflect.Descriptor instead.
+func (*ShareVideoReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShareVideoReply) GetShareCode() string {
+	if x != nil {
+		return x.ShareCode
+	}
+	return ""
+}
+
+func (x *ShareVideoReply) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+// 解析分享码
+type ResolveShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // 可选，未登录时使用 device_id 区分访客
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveShareRequest) Reset() {
+	*x = ResolveShareRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareRequest) ProtoMessage() {}
+
+func (x *ResolveShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareRequest.ProtoReflect.Descriptor instead.
+func (*ResolveShareRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResolveShareRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResolveShareRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResolveShareRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ResolveShareRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type ResolveShareReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ReferrerId    int64                  `protobuf:"varint,2,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"` // 分享者
+	Channel       ShareChannel           `protobuf:"varint,3,opt,name=channel,proto3,enum=video.ShareChannel" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveShareReply) Reset() {
+	*x = ResolveShareReply{}
+	mi := &file_video_v1_video_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveShareReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareReply) ProtoMessage() {}
+
+func (x *ResolveShareReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareReply.ProtoReflect.Descriptor instead.
+func (*ResolveShareReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResolveShareReply) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ResolveShareReply) GetReferrerId() int64 {
+	if x != nil {
+		return x.ReferrerId
+	}
+	return 0
+}
+
+func (x *ResolveShareReply) GetChannel() ShareChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ShareChannel_SHARE_CHANNEL_LINK
+}
+
+// 上报播放
+type ReportPlayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	WatchMs       int64                  `protobuf:"varint,2,opt,name=watch_ms,json=watchMs,proto3" json:"watch_ms,omitempty"` // 本次观看时长，毫秒
+	Finished      bool                   `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`              // 是否完整播放
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`                     // 可选，未登录时使用 device_id 区分观众
+	RefreshToken  string                 `protobuf:"bytes,5,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayRequest) Reset() {
+	*x = ReportPlayRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayRequest) ProtoMessage() {}
+
+func (x *ReportPlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayRequest.ProtoReflect.Descriptor instead.
+func (*ReportPlayRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReportPlayRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ReportPlayRequest) GetWatchMs() int64 {
+	if x != nil {
+		return x.WatchMs
+	}
+	return 0
+}
+
+func (x *ReportPlayRequest) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *ReportPlayRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReportPlayRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ReportPlayRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type ReportPlayReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counted       bool                   `protobuf:"varint,1,opt,name=counted,proto3" json:"counted,omitempty"` // 是否为该视频当天的新观众
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayReply) Reset() {
+	*x = ReportPlayReply{}
+	mi := &file_video_v1_video_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayReply) ProtoMessage() {}
+
+func (x *ReportPlayReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayReply.ProtoReflect.Descriptor instead.
+func (*ReportPlayReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReportPlayReply) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+// 综合搜索
+type UniversalSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	SectionSize   int32                  `protobuf:"varint,2,opt,name=section_size,json=sectionSize,proto3" json:"section_size,omitempty"` // 每个分组返回的条数
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                                 // 可选，登录用户会记录搜索历史
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UniversalSearchRequest) Reset() {
+	*x = UniversalSearchRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UniversalSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniversalSearchRequest) ProtoMessage() {}
+
+func (x *UniversalSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniversalSearchRequest.ProtoReflect.Descriptor instead.
+func (*UniversalSearchRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UniversalSearchRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *UniversalSearchRequest) GetSectionSize() int32 {
+	if x != nil {
+		return x.SectionSize
+	}
+	return 0
+}
+
+func (x *UniversalSearchRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UniversalSearchRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type SearchUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	FollowerCount int32                  `protobuf:"varint,5,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	WorkCount     int32                  `protobuf:"varint,6,opt,name=work_count,json=workCount,proto3" json:"work_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUser) Reset() {
+	*x = SearchUser{}
+	mi := &file_video_v1_video_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUser) ProtoMessage() {}
+
+func (x *SearchUser) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUser.ProtoReflect.Descriptor instead.
+func (*SearchUser) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchUser) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchUser) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *SearchUser) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SearchUser) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *SearchUser) GetWorkCount() int32 {
+	if x != nil {
+		return x.WorkCount
+	}
+	return 0
+}
+
+type SearchSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SearchSectionType      `protobuf:"varint,1,opt,name=type,proto3,enum=video.SearchSectionType" json:"type,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // 分组排序分，越大越靠前
+	Videos        []*SearchVideoItem     `protobuf:"bytes,3,rep,name=videos,proto3" json:"videos,omitempty"`
+	Users         []*SearchUser          `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	HasMore       bool                   `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSection) Reset() {
+	*x = SearchSection{}
+	mi := &file_video_v1_video_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSection) ProtoMessage() {}
+
+func (x *SearchSection) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSection.ProtoReflect.Descriptor instead.
+func (*SearchSection) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchSection) GetType() SearchSectionType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchSectionType_SEARCH_SECTION_VIDEO
+}
+
+func (x *SearchSection) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchSection) GetVideos() []*SearchVideoItem {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *SearchSection) GetUsers() []*SearchUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchSection) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchSection) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UniversalSearchReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*SearchSection       `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UniversalSearchReply) Reset() {
+	*x = UniversalSearchReply{}
+	mi := &file_video_v1_video_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UniversalSearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniversalSearchReply) ProtoMessage() {}
+
+func (x *UniversalSearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniversalSearchReply.ProtoReflect.Descriptor instead.
+func (*UniversalSearchReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UniversalSearchReply) GetSections() []*SearchSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// 搜索建议
+type SuggestQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestQueriesRequest) Reset() {
+	*x = SuggestQueriesRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestQueriesRequest) ProtoMessage() {}
+
+func (x *SuggestQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestQueriesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuggestQueriesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestQueriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // 来源：video、tag、user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_video_v1_video_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SuggestQueriesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestQueriesReply) Reset() {
+	*x = SuggestQueriesReply{}
+	mi := &file_video_v1_video_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestQueriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestQueriesReply) ProtoMessage() {}
+
+func (x *SuggestQueriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestQueriesReply.ProtoReflect.Descriptor instead.
+func (*SuggestQueriesReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SuggestQueriesReply) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// 搜索历史
+type ListSearchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchHistoryRequest) Reset() {
+	*x = ListSearchHistoryRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchHistoryRequest) ProtoMessage() {}
+
+func (x *ListSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListSearchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSearchHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListSearchHistoryRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ListSearchHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keywords      []string               `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchHistoryReply) Reset() {
+	*x = ListSearchHistoryReply{}
+	mi := &file_video_v1_video_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchHistoryReply) ProtoMessage() {}
+
+func (x *ListSearchHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListSearchHistoryReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListSearchHistoryReply) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type ClearSearchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"` // 为空时清除全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSearchHistoryRequest) Reset() {
+	*x = ClearSearchHistoryRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSearchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSearchHistoryRequest) ProtoMessage() {}
+
+func (x *ClearSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearSearchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClearSearchHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ClearSearchHistoryRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ClearSearchHistoryRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type ClearSearchHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSearchHistoryReply) Reset() {
+	*x = ClearSearchHistoryReply{}
+	mi := &file_video_v1_video_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSearchHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSearchHistoryReply) ProtoMessage() {}
+
+func (x *ClearSearchHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSearchHistoryReply.ProtoReflect.Descriptor instead.
+func (*ClearSearchHistoryReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{20}
+}
+
+// 热搜榜
+type ListHotSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHotSearchesRequest) Reset() {
+	*x = ListHotSearchesRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHotSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHotSearchesRequest) ProtoMessage() {}
+
+func (x *ListHotSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHotSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListHotSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListHotSearchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type HotSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Pinned        bool                   `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotSearch) Reset() {
+	*x = HotSearch{}
+	mi := &file_video_v1_video_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotSearch) ProtoMessage() {}
+
+func (x *HotSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotSearch.ProtoReflect.Descriptor instead.
+func (*HotSearch) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HotSearch) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *HotSearch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *HotSearch) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type ListHotSearchesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*HotSearch           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHotSearchesReply) Reset() {
+	*x = ListHotSearchesReply{}
+	mi := &file_video_v1_video_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHotSearchesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHotSearchesReply) ProtoMessage() {}
+
+func (x *ListHotSearchesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHotSearchesReply.ProtoReflect.Descriptor instead.
+func (*ListHotSearchesReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListHotSearchesReply) GetItems() []*HotSearch {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ManageHotSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Action        HotSearchAction        `protobuf:"varint,4,opt,name=action,proto3,enum=video.HotSearchAction" json:"action,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // 置顶位置，从 1 开始
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManageHotSearchRequest) Reset() {
+	*x = ManageHotSearchRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManageHotSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageHotSearchRequest) ProtoMessage() {}
+
+func (x *ManageHotSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageHotSearchRequest.ProtoReflect.Descriptor instead.
+func (*ManageHotSearchRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ManageHotSearchRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ManageHotSearchRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ManageHotSearchRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ManageHotSearchRequest) GetAction() HotSearchAction {
+	if x != nil {
+		return x.Action
+	}
+	return HotSearchAction_HOT_SEARCH_ACTION_UNSPECIFIED
+}
+
+func (x *ManageHotSearchRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ManageHotSearchReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManageHotSearchReply) Reset() {
+	*x = ManageHotSearchReply{}
+	mi := &file_video_v1_video_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManageHotSearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageHotSearchReply) ProtoMessage() {}
+
+func (x *ManageHotSearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageHotSearchReply.ProtoReflect.Descriptor instead.
+func (*ManageHotSearchReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{25}
+}
+
+// 搜索视频
+type SearchVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	MinDuration   float32                `protobuf:"fixed32,2,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"` // 时长下限（秒），0 表示不限
+	MaxDuration   float32                `protobuf:"fixed32,3,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"` // 时长上限（秒），0 表示不限
+	PublishStart  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publish_start,json=publishStart,proto3" json:"publish_start,omitempty"`
+	PublishEnd    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=publish_end,json=publishEnd,proto3" json:"publish_end,omitempty"`
+	OriginalOnly  bool                   `protobuf:"varint,6,opt,name=original_only,json=originalOnly,proto3" json:"original_only,omitempty"`
+	Sort          SearchSort             `protobuf:"varint,7,opt,name=sort,proto3,enum=video.SearchSort" json:"sort,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，首页为空
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Token         string                 `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"` // 可选，登录用户会记录搜索历史
+	RefreshToken  string                 `protobuf:"bytes,11,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchVideosRequest) Reset() {
+	*x = SearchVideosRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVideosRequest) ProtoMessage() {}
+
+func (x *SearchVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVideosRequest.ProtoReflect.Descriptor instead.
+func (*SearchVideosRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchVideosRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetMinDuration() float32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *SearchVideosRequest) GetMaxDuration() float32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *SearchVideosRequest) GetPublishStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishStart
+	}
+	return nil
+}
+
+func (x *SearchVideosRequest) GetPublishEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishEnd
+	}
+	return nil
+}
+
+func (x *SearchVideosRequest) GetOriginalOnly() bool {
+	if x != nil {
+		return x.OriginalOnly
+	}
+	return false
+}
+
+func (x *SearchVideosRequest) GetSort() SearchSort {
+	if x != nil {
+		return x.Sort
+	}
+	return SearchSort_SEARCH_SORT_RELEVANCE
+}
+
+func (x *SearchVideosRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchVideosRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type SearchVideoItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	Highlights    map[string]string      `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 字段 -> 高亮片段，命中词以 <em></em> 包裹
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchVideoItem) Reset() {
+	*x = SearchVideoItem{}
+	mi := &file_video_v1_video_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVideoItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVideoItem) ProtoMessage() {}
+
+func (x *SearchVideoItem) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVideoItem.ProtoReflect.Descriptor instead.
+func (*SearchVideoItem) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchVideoItem) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *SearchVideoItem) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchVideosReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SearchVideoItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchVideosReply) Reset() {
+	*x = SearchVideosReply{}
+	mi := &file_video_v1_video_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVideosReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVideosReply) ProtoMessage() {}
+
+func (x *SearchVideosReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVideosReply.ProtoReflect.Descriptor instead.
+func (*SearchVideosReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchVideosReply) GetItems() []*SearchVideoItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchVideosReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchVideosReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 话题
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VideoCount    int64                  `protobuf:"varint,3,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"` // 已发布、公开且未删除的关联视频数
+	ViewCount     int64                  `protobuf:"varint,4,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`    // 播放数
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`                            // 热度（仅热门话题返回）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_video_v1_video_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Tag) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *Tag) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Tag) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 根据话题获取视频列表
+type ListVideosByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideosByTagRequest) Reset() {
+	*x = ListVideosByTagRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideosByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideosByTagRequest) ProtoMessage() {}
+
+func (x *ListVideosByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideosByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListVideosByTagRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListVideosByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListVideosByTagRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListVideosByTagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListVideosByTagReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Videos        []*Video               `protobuf:"bytes,2,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideosByTagReply) Reset() {
+	*x = ListVideosByTagReply{}
+	mi := &file_video_v1_video_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideosByTagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideosByTagReply) ProtoMessage() {}
+
+func (x *ListVideosByTagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideosByTagReply.ProtoReflect.Descriptor instead.
+func (*ListVideosByTagReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListVideosByTagReply) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *ListVideosByTagReply) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListVideosByTagReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取话题信息
+type GetTagInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagInfoRequest) Reset() {
+	*x = GetTagInfoRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagInfoRequest) ProtoMessage() {}
+
+func (x *GetTagInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetTagInfoRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTagInfoRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetTagInfoReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagInfoReply) Reset() {
+	*x = GetTagInfoReply{}
+	mi := &file_video_v1_video_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagInfoReply) ProtoMessage() {}
+
+func (x *GetTagInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagInfoReply.ProtoReflect.Descriptor instead.
+func (*GetTagInfoReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTagInfoReply) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// 热门话题
+type TrendingTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingTagsRequest) Reset() {
+	*x = TrendingTagsRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTagsRequest) ProtoMessage() {}
+
+func (x *TrendingTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*TrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TrendingTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingTagsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingTagsReply) Reset() {
+	*x = TrendingTagsReply{}
+	mi := &file_video_v1_video_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTagsReply) ProtoMessage() {}
+
+func (x *TrendingTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTagsReply.ProtoReflect.Descriptor instead.
+func (*TrendingTagsReply) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TrendingTagsReply) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // 根据title模糊获取视频
 type GetVideoByTitleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -33,7 +2363,7 @@ type GetVideoByTitleRequest struct {
 
 func (x *GetVideoByTitleRequest) Reset() {
 	*x = GetVideoByTitleRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[0]
+	mi := &file_video_v1_video_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +2375,7 @@ func (x *GetVideoByTitleRequest) String() string {
 func (*GetVideoByTitleRequest) ProtoMessage() {}
 
 func (x *GetVideoByTitleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[0]
+	mi := &file_video_v1_video_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +2388,7 @@ func (x *GetVideoByTitleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoByTitleRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoByTitleRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{0}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetVideoByTitleRequest) GetTitle() string {
@@ -77,7 +2407,7 @@ type GetVideoByTitleReply struct {
 
 func (x *GetVideoByTitleReply) Reset() {
 	*x = GetVideoByTitleReply{}
-	mi := &file_video_v1_video_proto_msgTypes[1]
+	mi := &file_video_v1_video_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -89,7 +2419,7 @@ func (x *GetVideoByTitleReply) String() string {
 func (*GetVideoByTitleReply) ProtoMessage() {}
 
 func (x *GetVideoByTitleReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[1]
+	mi := &file_video_v1_video_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -102,7 +2432,7 @@ func (x *GetVideoByTitleReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoByTitleReply.ProtoReflect.Descriptor instead.
 func (*GetVideoByTitleReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{1}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetVideoByTitleReply) GetVideos() []*Video {
@@ -121,7 +2451,7 @@ type GetVideoFavoriteAndCommentCountRequest struct {
 
 func (x *GetVideoFavoriteAndCommentCountRequest) Reset() {
 	*x = GetVideoFavoriteAndCommentCountRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[2]
+	mi := &file_video_v1_video_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +2463,7 @@ func (x *GetVideoFavoriteAndCommentCountRequest) String() string {
 func (*GetVideoFavoriteAndCommentCountRequest) ProtoMessage() {}
 
 func (x *GetVideoFavoriteAndCommentCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[2]
+	mi := &file_video_v1_video_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +2476,7 @@ func (x *GetVideoFavoriteAndCommentCountRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetVideoFavoriteAndCommentCountRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoFavoriteAndCommentCountRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{2}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetVideoFavoriteAndCommentCountRequest) GetVideoId() int64 {
@@ -161,13 +2491,15 @@ type GetVideoFavoriteAndCommentCountReply struct {
 	FavoriteCount int64                  `protobuf:"varint,1,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
 	CommentCount  int64                  `protobuf:"varint,2,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
 	UploadTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=uploadTime,proto3" json:"uploadTime,omitempty"`
+	ViewCount     int64                  `protobuf:"varint,4,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	ShareCount    int64                  `protobuf:"varint,5,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetVideoFavoriteAndCommentCountReply) Reset() {
 	*x = GetVideoFavoriteAndCommentCountReply{}
-	mi := &file_video_v1_video_proto_msgTypes[3]
+	mi := &file_video_v1_video_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -179,7 +2511,7 @@ func (x *GetVideoFavoriteAndCommentCountReply) String() string {
 func (*GetVideoFavoriteAndCommentCountReply) ProtoMessage() {}
 
 func (x *GetVideoFavoriteAndCommentCountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[3]
+	mi := &file_video_v1_video_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +2524,7 @@ func (x *GetVideoFavoriteAndCommentCountReply) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetVideoFavoriteAndCommentCountReply.ProtoReflect.Descriptor instead.
 func (*GetVideoFavoriteAndCommentCountReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{3}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetVideoFavoriteAndCommentCountReply) GetFavoriteCount() int64 {
@@ -216,107 +2548,16 @@ func (x *GetVideoFavoriteAndCommentCountReply) GetUploadTime() *timestamppb.Time
 	return nil
 }
 
-// 视频分数计算
-type CalcVideoScoreRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FavoriteCount int64                  `protobuf:"varint,1,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
-	CommentCount  int64                  `protobuf:"varint,2,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	UploadTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=uploadTime,proto3" json:"uploadTime,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CalcVideoScoreRequest) Reset() {
-	*x = CalcVideoScoreRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CalcVideoScoreRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CalcVideoScoreRequest) ProtoMessage() {}
-
-func (x *CalcVideoScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CalcVideoScoreRequest.ProtoReflect.Descriptor instead.
-func (*CalcVideoScoreRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *CalcVideoScoreRequest) GetFavoriteCount() int64 {
-	if x != nil {
-		return x.FavoriteCount
-	}
-	return 0
-}
-
-func (x *CalcVideoScoreRequest) GetCommentCount() int64 {
+func (x *GetVideoFavoriteAndCommentCountReply) GetViewCount() int64 {
 	if x != nil {
-		return x.CommentCount
+		return x.ViewCount
 	}
 	return 0
 }
 
-func (x *CalcVideoScoreRequest) GetUploadTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UploadTime
-	}
-	return nil
-}
-
-type CalcVideoScoreReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Score         float32                `protobuf:"fixed32,1,opt,name=score,proto3" json:"score,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CalcVideoScoreReply) Reset() {
-	*x = CalcVideoScoreReply{}
-	mi := &file_video_v1_video_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CalcVideoScoreReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CalcVideoScoreReply) ProtoMessage() {}
-
-func (x *CalcVideoScoreReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CalcVideoScoreReply.ProtoReflect.Descriptor instead.
-func (*CalcVideoScoreReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CalcVideoScoreReply) GetScore() float32 {
+func (x *GetVideoFavoriteAndCommentCountReply) GetShareCount() int64 {
 	if x != nil {
-		return x.Score
+		return x.ShareCount
 	}
 	return 0
 }
@@ -325,13 +2566,14 @@ func (x *CalcVideoScoreReply) GetScore() float32 {
 type CheckVideoExistsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // 查看者，0 为未登录
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckVideoExistsRequest) Reset() {
 	*x = CheckVideoExistsRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[6]
+	mi := &file_video_v1_video_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +2585,7 @@ func (x *CheckVideoExistsRequest) String() string {
 func (*CheckVideoExistsRequest) ProtoMessage() {}
 
 func (x *CheckVideoExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[6]
+	mi := &file_video_v1_video_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +2598,7 @@ func (x *CheckVideoExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckVideoExistsRequest.ProtoReflect.Descriptor instead.
 func (*CheckVideoExistsRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{6}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CheckVideoExistsRequest) GetVideoId() int64 {
@@ -366,16 +2608,24 @@ func (x *CheckVideoExistsRequest) GetVideoId() int64 {
 	return 0
 }
 
+func (x *CheckVideoExistsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
 type CheckVideoExistsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exist         bool                   `protobuf:"varint,1,opt,name=exist,proto3" json:"exist,omitempty"`
+	Visible       bool                   `protobuf:"varint,2,opt,name=visible,proto3" json:"visible,omitempty"` // 已发布、公开且未删除，或查看者是作者
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckVideoExistsReply) Reset() {
 	*x = CheckVideoExistsReply{}
-	mi := &file_video_v1_video_proto_msgTypes[7]
+	mi := &file_video_v1_video_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +2637,7 @@ func (x *CheckVideoExistsReply) String() string {
 func (*CheckVideoExistsReply) ProtoMessage() {}
 
 func (x *CheckVideoExistsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[7]
+	mi := &file_video_v1_video_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +2650,7 @@ func (x *CheckVideoExistsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckVideoExistsReply.ProtoReflect.Descriptor instead.
 func (*CheckVideoExistsReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{7}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CheckVideoExistsReply) GetExist() bool {
@@ -410,6 +2660,13 @@ func (x *CheckVideoExistsReply) GetExist() bool {
 	return false
 }
 
+func (x *CheckVideoExistsReply) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
 // 批量获取视频信息
 type BatchGetVideoInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -422,7 +2679,7 @@ type BatchGetVideoInfoRequest struct {
 
 func (x *BatchGetVideoInfoRequest) Reset() {
 	*x = BatchGetVideoInfoRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[8]
+	mi := &file_video_v1_video_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +2691,7 @@ func (x *BatchGetVideoInfoRequest) String() string {
 func (*BatchGetVideoInfoRequest) ProtoMessage() {}
 
 func (x *BatchGetVideoInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[8]
+	mi := &file_video_v1_video_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +2704,7 @@ func (x *BatchGetVideoInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetVideoInfoRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetVideoInfoRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{8}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *BatchGetVideoInfoRequest) GetIds() []int64 {
@@ -480,7 +2737,7 @@ type BatchGetVideoInfoReply struct {
 
 func (x *BatchGetVideoInfoReply) Reset() {
 	*x = BatchGetVideoInfoReply{}
-	mi := &file_video_v1_video_proto_msgTypes[9]
+	mi := &file_video_v1_video_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +2749,7 @@ func (x *BatchGetVideoInfoReply) String() string {
 func (*BatchGetVideoInfoReply) ProtoMessage() {}
 
 func (x *BatchGetVideoInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[9]
+	mi := &file_video_v1_video_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +2762,7 @@ func (x *BatchGetVideoInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetVideoInfoReply.ProtoReflect.Descriptor instead.
 func (*BatchGetVideoInfoReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{9}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *BatchGetVideoInfoReply) GetVideos() []*Video {
@@ -528,7 +2785,7 @@ type UploadVideoRequest struct {
 
 func (x *UploadVideoRequest) Reset() {
 	*x = UploadVideoRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[10]
+	mi := &file_video_v1_video_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +2797,7 @@ func (x *UploadVideoRequest) String() string {
 func (*UploadVideoRequest) ProtoMessage() {}
 
 func (x *UploadVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[10]
+	mi := &file_video_v1_video_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +2810,7 @@ func (x *UploadVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadVideoRequest.ProtoReflect.Descriptor instead.
 func (*UploadVideoRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{10}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UploadVideoRequest) GetData() []byte {
@@ -597,7 +2854,7 @@ type UploadVideoReply struct {
 
 func (x *UploadVideoReply) Reset() {
 	*x = UploadVideoReply{}
-	mi := &file_video_v1_video_proto_msgTypes[11]
+	mi := &file_video_v1_video_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +2866,7 @@ func (x *UploadVideoReply) String() string {
 func (*UploadVideoReply) ProtoMessage() {}
 
 func (x *UploadVideoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[11]
+	mi := &file_video_v1_video_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +2879,7 @@ func (x *UploadVideoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadVideoReply.ProtoReflect.Descriptor instead.
 func (*UploadVideoReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{11}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *UploadVideoReply) GetPlayUrl() string {
@@ -662,25 +2919,29 @@ func (x *UploadVideoReply) GetVideoId() int64 {
 
 // 创建视频信息
 type CreateVideoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	PlayUrl       string                 `protobuf:"bytes,3,opt,name=play_url,json=playUrl,proto3" json:"play_url,omitempty"`
-	CoverUrl      string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
-	Duration      float32                `protobuf:"fixed32,5,opt,name=duration,proto3" json:"duration,omitempty"`
-	Tags          string                 `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	IsOriginal    bool                   `protobuf:"varint,8,opt,name=is_original,json=isOriginal,proto3" json:"is_original,omitempty"`
-	SourceUrl     string                 `protobuf:"bytes,9,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"` // 原始视频来源（如转载，is_original 为 false 时使用）
-	Token         string                 `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`                         // JWT 或其它认证方式
-	RefreshToken  string                 `protobuf:"bytes,11,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PlayUrl         string                 `protobuf:"bytes,3,opt,name=play_url,json=playUrl,proto3" json:"play_url,omitempty"`
+	CoverUrl        string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Duration        float32                `protobuf:"fixed32,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Tags            string                 `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
+	IsPublic        bool                   `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	IsOriginal      bool                   `protobuf:"varint,8,opt,name=is_original,json=isOriginal,proto3" json:"is_original,omitempty"`
+	SourceUrl       string                 `protobuf:"bytes,9,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"` // 原始视频来源（如转载，is_original 为 false 时使用）
+	Token           string                 `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`                         // JWT 或其它认证方式
+	RefreshToken    string                 `protobuf:"bytes,11,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	IsDraft         bool                   `protobuf:"varint,12,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`                         // 保存为草稿，不发布
+	PublishAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`                    // 定时发布时间，为空时立即发布
+	Location        *Location              `protobuf:"bytes,14,opt,name=location,proto3" json:"location,omitempty"`                                       // 拍摄位置，location_consent 为 true 时才记录
+	LocationConsent bool                   `protobuf:"varint,15,opt,name=location_consent,json=locationConsent,proto3" json:"location_consent,omitempty"` // 用户是否同意公开粗略位置
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateVideoRequest) Reset() {
 	*x = CreateVideoRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[12]
+	mi := &file_video_v1_video_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +2953,7 @@ func (x *CreateVideoRequest) String() string {
 func (*CreateVideoRequest) ProtoMessage() {}
 
 func (x *CreateVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[12]
+	mi := &file_video_v1_video_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +2966,7 @@ func (x *CreateVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVideoRequest.ProtoReflect.Descriptor instead.
 func (*CreateVideoRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{12}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CreateVideoRequest) GetTitle() string {
@@ -785,6 +3046,95 @@ func (x *CreateVideoRequest) GetRefreshToken() string {
 	return ""
 }
 
+func (x *CreateVideoRequest) GetIsDraft() bool {
+	if x != nil {
+		return x.IsDraft
+	}
+	return false
+}
+
+func (x *CreateVideoRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreateVideoRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateVideoRequest) GetLocationConsent() bool {
+	if x != nil {
+		return x.LocationConsent
+	}
+	return false
+}
+
+// 粗略位置，坐标与城市代码至少填一项；坐标只保存模糊到约 1 公里网格中心后的值
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	CityCode      string                 `protobuf:"bytes,3,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"` // 城市代码，如行政区划代码 310100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_video_v1_video_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
 type CreateVideoReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
@@ -794,7 +3144,7 @@ type CreateVideoReply struct {
 
 func (x *CreateVideoReply) Reset() {
 	*x = CreateVideoReply{}
-	mi := &file_video_v1_video_proto_msgTypes[13]
+	mi := &file_video_v1_video_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +3156,7 @@ func (x *CreateVideoReply) String() string {
 func (*CreateVideoReply) ProtoMessage() {}
 
 func (x *CreateVideoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[13]
+	mi := &file_video_v1_video_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +3169,7 @@ func (x *CreateVideoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVideoReply.ProtoReflect.Descriptor instead.
 func (*CreateVideoReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{13}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CreateVideoReply) GetVideoId() int64 {
@@ -845,7 +3195,7 @@ type ListUserVideosRequest struct {
 
 func (x *ListUserVideosRequest) Reset() {
 	*x = ListUserVideosRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[14]
+	mi := &file_video_v1_video_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -857,7 +3207,7 @@ func (x *ListUserVideosRequest) String() string {
 func (*ListUserVideosRequest) ProtoMessage() {}
 
 func (x *ListUserVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[14]
+	mi := &file_video_v1_video_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +3220,7 @@ func (x *ListUserVideosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserVideosRequest.ProtoReflect.Descriptor instead.
 func (*ListUserVideosRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{14}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ListUserVideosRequest) GetUserId() int64 {
@@ -934,7 +3284,7 @@ type ListUserVideosReply struct {
 
 func (x *ListUserVideosReply) Reset() {
 	*x = ListUserVideosReply{}
-	mi := &file_video_v1_video_proto_msgTypes[15]
+	mi := &file_video_v1_video_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +3296,7 @@ func (x *ListUserVideosReply) String() string {
 func (*ListUserVideosReply) ProtoMessage() {}
 
 func (x *ListUserVideosReply) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[15]
+	mi := &file_video_v1_video_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +3309,7 @@ func (x *ListUserVideosReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserVideosReply.ProtoReflect.Descriptor instead.
 func (*ListUserVideosReply) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{15}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListUserVideosReply) GetVideos() []*Video {
@@ -1017,13 +3367,16 @@ type Video struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdateTime      *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	DeleteAt        *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=delete_at,json=deleteAt,proto3" json:"delete_at,omitempty"`
+	ViewCnt         int64                  `protobuf:"varint,26,opt,name=view_cnt,json=viewCnt,proto3" json:"view_cnt,omitempty"`
+	PublishStatus   int32                  `protobuf:"varint,27,opt,name=publish_status,json=publishStatus,proto3" json:"publish_status,omitempty"` // 0已发布 1草稿 2定时发布
+	PublishAt       *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Video) Reset() {
 	*x = Video{}
-	mi := &file_video_v1_video_proto_msgTypes[16]
+	mi := &file_video_v1_video_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +3388,7 @@ func (x *Video) String() string {
 func (*Video) ProtoMessage() {}
 
 func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[16]
+	mi := &file_video_v1_video_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +3401,7 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Video.ProtoReflect.Descriptor instead.
 func (*Video) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{16}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Video) GetId() int64 {
@@ -1226,35 +3579,212 @@ func (x *Video) GetDeleteAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Video) GetViewCnt() int64 {
+	if x != nil {
+		return x.ViewCnt
+	}
+	return 0
+}
+
+func (x *Video) GetPublishStatus() int32 {
+	if x != nil {
+		return x.PublishStatus
+	}
+	return 0
+}
+
+func (x *Video) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
 var File_video_v1_video_proto protoreflect.FileDescriptor
 
 const file_video_v1_video_proto_rawDesc = "" +
 	"\n" +
-	"\x14video/v1/video.proto\x12\x05video\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\x14video/v1/video.proto\x12\x05video\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x01\n" +
+	"\x13PublishVideoRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\"u\n" +
+	"\x11PublishVideoReply\x12%\n" +
+	"\x0epublish_status\x18\x01 \x01(\x05R\rpublishStatus\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"\x83\x01\n" +
+	"\x16ListDraftVideosRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"R\n" +
+	"\x14ListDraftVideosReply\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x97\x01\n" +
+	"\x11ShareVideoRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12-\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x13.video.ShareChannelR\achannel\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\"M\n" +
+	"\x0fShareVideoReply\x12\x1d\n" +
+	"\n" +
+	"share_code\x18\x01 \x01(\tR\tshareCode\x12\x1b\n" +
+	"\tshare_url\x18\x02 \x01(\tR\bshareUrl\"\x80\x01\n" +
+	"\x13ResolveShareRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x12\x1b\n" +
+	"\tdevice_id\x18\x04 \x01(\tR\bdeviceId\"~\n" +
+	"\x11ResolveShareReply\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x1f\n" +
+	"\vreferrer_id\x18\x02 \x01(\x03R\n" +
+	"referrerId\x12-\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x13.video.ShareChannelR\achannel\"\xbc\x01\n" +
+	"\x11ReportPlayRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x19\n" +
+	"\bwatch_ms\x18\x02 \x01(\x03R\awatchMs\x12\x1a\n" +
+	"\bfinished\x18\x03 \x01(\bR\bfinished\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x05 \x01(\tR\frefreshToken\x12\x1b\n" +
+	"\tdevice_id\x18\x06 \x01(\tR\bdeviceId\"+\n" +
+	"\x0fReportPlayReply\x12\x18\n" +
+	"\acounted\x18\x01 \x01(\bR\acounted\"\x8f\x01\n" +
+	"\x16UniversalSearchRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12!\n" +
+	"\fsection_size\x18\x02 \x01(\x05R\vsectionSize\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x04 \x01(\tR\frefreshToken\"\xac\x01\n" +
+	"\n" +
+	"SearchUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\x12%\n" +
+	"\x0efollower_count\x18\x05 \x01(\x05R\rfollowerCount\x12\x1d\n" +
+	"\n" +
+	"work_count\x18\x06 \x01(\x05R\tworkCount\"\xe7\x01\n" +
+	"\rSearchSection\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.video.SearchSectionTypeR\x04type\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12.\n" +
+	"\x06videos\x18\x03 \x03(\v2\x16.video.SearchVideoItemR\x06videos\x12'\n" +
+	"\x05users\x18\x04 \x03(\v2\x11.video.SearchUserR\x05users\x12\x1e\n" +
+	"\x04tags\x18\x05 \x03(\v2\n" +
+	".video.TagR\x04tags\x12\x19\n" +
+	"\bhas_more\x18\x06 \x01(\bR\ahasMore\"H\n" +
+	"\x14UniversalSearchReply\x120\n" +
+	"\bsections\x18\x01 \x03(\v2\x14.video.SearchSectionR\bsections\"E\n" +
+	"\x15SuggestQueriesRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"4\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"J\n" +
+	"\x13SuggestQueriesReply\x123\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x11.video.SuggestionR\vsuggestions\"T\n" +
+	"\x18ListSearchHistoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\"4\n" +
+	"\x16ListSearchHistoryReply\x12\x1a\n" +
+	"\bkeywords\x18\x01 \x03(\tR\bkeywords\"o\n" +
+	"\x19ClearSearchHistoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\"\x19\n" +
+	"\x17ClearSearchHistoryReply\".\n" +
+	"\x16ListHotSearchesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"S\n" +
+	"\tHotSearch\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\">\n" +
+	"\x14ListHotSearchesReply\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.video.HotSearchR\x05items\"\xb8\x01\n" +
+	"\x16ManageHotSearchRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12.\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x16.video.HotSearchActionR\x06action\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\"\x16\n" +
+	"\x14ManageHotSearchReply\"\xae\x03\n" +
+	"\x13SearchVideosRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12!\n" +
+	"\fmin_duration\x18\x02 \x01(\x02R\vminDuration\x12!\n" +
+	"\fmax_duration\x18\x03 \x01(\x02R\vmaxDuration\x12?\n" +
+	"\rpublish_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fpublishStart\x12;\n" +
+	"\vpublish_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"publishEnd\x12#\n" +
+	"\roriginal_only\x18\x06 \x01(\bR\foriginalOnly\x12%\n" +
+	"\x04sort\x18\a \x01(\x0e2\x11.video.SearchSortR\x04sort\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05token\x18\n" +
+	" \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\v \x01(\tR\frefreshToken\"\xbc\x01\n" +
+	"\x0fSearchVideoItem\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\x12F\n" +
+	"\n" +
+	"highlights\x18\x02 \x03(\v2&.video.SearchVideoItem.HighlightsEntryR\n" +
+	"highlights\x1a=\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"}\n" +
+	"\x11SearchVideosReply\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.video.SearchVideoItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x7f\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vvideo_count\x18\x03 \x01(\x03R\n" +
+	"videoCount\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x04 \x01(\x03R\tviewCount\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\"[\n" +
+	"\x16ListVideosByTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"p\n" +
+	"\x14ListVideosByTagReply\x12\x1c\n" +
+	"\x03tag\x18\x01 \x01(\v2\n" +
+	".video.TagR\x03tag\x12$\n" +
+	"\x06videos\x18\x02 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"%\n" +
+	"\x11GetTagInfoRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\"/\n" +
+	"\x0fGetTagInfoReply\x12\x1c\n" +
+	"\x03tag\x18\x01 \x01(\v2\n" +
+	".video.TagR\x03tag\"+\n" +
+	"\x13TrendingTagsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"3\n" +
+	"\x11TrendingTagsReply\x12\x1e\n" +
+	"\x04tags\x18\x01 \x03(\v2\n" +
+	".video.TagR\x04tags\".\n" +
 	"\x16GetVideoByTitleRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\"<\n" +
 	"\x14GetVideoByTitleReply\x12$\n" +
 	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\"C\n" +
 	"&GetVideoFavoriteAndCommentCountRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\"\xae\x01\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\"\xee\x01\n" +
 	"$GetVideoFavoriteAndCommentCountReply\x12%\n" +
 	"\x0efavorite_count\x18\x01 \x01(\x03R\rfavoriteCount\x12#\n" +
 	"\rcomment_count\x18\x02 \x01(\x03R\fcommentCount\x12:\n" +
 	"\n" +
 	"uploadTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"uploadTime\"\x9f\x01\n" +
-	"\x15CalcVideoScoreRequest\x12%\n" +
-	"\x0efavorite_count\x18\x01 \x01(\x03R\rfavoriteCount\x12#\n" +
-	"\rcomment_count\x18\x02 \x01(\x03R\fcommentCount\x12:\n" +
+	"uploadTime\x12\x1d\n" +
 	"\n" +
-	"uploadTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"uploadTime\"+\n" +
-	"\x13CalcVideoScoreReply\x12\x14\n" +
-	"\x05score\x18\x01 \x01(\x02R\x05score\"4\n" +
+	"view_count\x18\x04 \x01(\x03R\tviewCount\x12\x1f\n" +
+	"\vshare_count\x18\x05 \x01(\x03R\n" +
+	"shareCount\"Q\n" +
 	"\x17CheckVideoExistsRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\"-\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"G\n" +
 	"\x15CheckVideoExistsReply\x12\x14\n" +
-	"\x05exist\x18\x01 \x01(\bR\x05exist\"\\\n" +
+	"\x05exist\x18\x01 \x01(\bR\x05exist\x12\x18\n" +
+	"\avisible\x18\x02 \x01(\bR\avisible\"\\\n" +
 	"\x18BatchGetVideoInfoRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
@@ -1271,7 +3801,7 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"\tcover_url\x18\x02 \x01(\tR\bcoverUrl\x12\x1a\n" +
 	"\bduration\x18\x03 \x01(\x02R\bduration\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12\x19\n" +
-	"\bvideo_id\x18\x05 \x01(\x03R\avideoId\"\xcb\x02\n" +
+	"\bvideo_id\x18\x05 \x01(\x03R\avideoId\"\xf9\x03\n" +
 	"\x12CreateVideoRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
@@ -1286,7 +3816,16 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"source_url\x18\t \x01(\tR\tsourceUrl\x12\x14\n" +
 	"\x05token\x18\n" +
 	" \x01(\tR\x05token\x12\"\n" +
-	"\frefreshToken\x18\v \x01(\tR\frefreshToken\"-\n" +
+	"\frefreshToken\x18\v \x01(\tR\frefreshToken\x12\x19\n" +
+	"\bis_draft\x18\f \x01(\bR\aisDraft\x129\n" +
+	"\n" +
+	"publish_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12+\n" +
+	"\blocation\x18\x0e \x01(\v2\x0f.video.LocationR\blocation\x12)\n" +
+	"\x10location_consent\x18\x0f \x01(\bR\x0flocationConsent\"a\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\tcity_code\x18\x03 \x01(\tR\bcityCode\"-\n" +
 	"\x10CreateVideoReply\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\"\x8d\x02\n" +
 	"\x15ListUserVideosRequest\x12\x17\n" +
@@ -1302,7 +3841,7 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
 	"\fcurrent_page\x18\x03 \x01(\x05R\vcurrentPage\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc9\x06\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc6\a\n" +
 	"\x05Video\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
@@ -1338,17 +3877,60 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"created_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
 	"\vupdate_time\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"updateTime\x127\n" +
-	"\tdelete_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\bdeleteAt2\x8f\x06\n" +
+	"\tdelete_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\bdeleteAt\x12\x19\n" +
+	"\bview_cnt\x18\x1a \x01(\x03R\aviewCnt\x12%\n" +
+	"\x0epublish_status\x18\x1b \x01(\x05R\rpublishStatus\x129\n" +
+	"\n" +
+	"publish_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt*\xa3\x01\n" +
+	"\fShareChannel\x12\x16\n" +
+	"\x12SHARE_CHANNEL_LINK\x10\x00\x12\x18\n" +
+	"\x14SHARE_CHANNEL_WECHAT\x10\x01\x12\x19\n" +
+	"\x15SHARE_CHANNEL_MOMENTS\x10\x02\x12\x14\n" +
+	"\x10SHARE_CHANNEL_QQ\x10\x03\x12\x17\n" +
+	"\x13SHARE_CHANNEL_WEIBO\x10\x04\x12\x17\n" +
+	"\x13SHARE_CHANNEL_OTHER\x10\x05*^\n" +
+	"\x11SearchSectionType\x12\x18\n" +
+	"\x14SEARCH_SECTION_VIDEO\x10\x00\x12\x17\n" +
+	"\x13SEARCH_SECTION_USER\x10\x01\x12\x16\n" +
+	"\x12SEARCH_SECTION_TAG\x10\x02*\xa8\x01\n" +
+	"\x0fHotSearchAction\x12!\n" +
+	"\x1dHOT_SEARCH_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15HOT_SEARCH_ACTION_PIN\x10\x01\x12\x1b\n" +
+	"\x17HOT_SEARCH_ACTION_UNPIN\x10\x02\x12\x1b\n" +
+	"\x17HOT_SEARCH_ACTION_BLOCK\x10\x03\x12\x1d\n" +
+	"\x19HOT_SEARCH_ACTION_UNBLOCK\x10\x04*[\n" +
+	"\n" +
+	"SearchSort\x12\x19\n" +
+	"\x15SEARCH_SORT_RELEVANCE\x10\x00\x12\x16\n" +
+	"\x12SEARCH_SORT_NEWEST\x10\x01\x12\x1a\n" +
+	"\x16SEARCH_SORT_MOST_LIKED\x10\x022\x88\x12\n" +
 	"\fVideoService\x12_\n" +
 	"\vCreateVideo\x12\x19.video.CreateVideoRequest\x1a\x17.video.CreateVideoReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/video/create\x12^\n" +
 	"\x0eListUserVideos\x12\x1c.video.ListUserVideosRequest\x1a\x1a.video.ListUserVideosReply\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/api/video\x12_\n" +
 	"\vUploadVideo\x12\x19.video.UploadVideoRequest\x1a\x17.video.UploadVideoReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/video/upload\x12S\n" +
 	"\x11BatchGetVideoInfo\x12\x1f.video.BatchGetVideoInfoRequest\x1a\x1d.video.BatchGetVideoInfoReply\x12P\n" +
-	"\x10CheckVideoExists\x12\x1e.video.CheckVideoExistsRequest\x1a\x1c.video.CheckVideoExistsReply\x12J\n" +
-	"\x0eCalcVideoScore\x12\x1c.video.CalcVideoScoreRequest\x1a\x1a.video.CalcVideoScoreReply\x12}\n" +
+	"\x10CheckVideoExists\x12\x1e.video.CheckVideoExistsRequest\x1a\x1c.video.CheckVideoExistsReply\x12}\n" +
 	"\x1fGetVideoFavoriteAndCommentCount\x12-.video.GetVideoFavoriteAndCommentCountRequest\x1a+.video.GetVideoFavoriteAndCommentCountReply\x12k\n" +
-	"\x0fGetVideoByTitle\x12\x1d.video.GetVideoByTitleRequest\x1a\x1b.video.GetVideoByTitleReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/video/get/titleB\x10Z\x0euser/api/v1;v1b\x06proto3"
+	"\x0fGetVideoByTitle\x12\x1d.video.GetVideoByTitleRequest\x1a\x1b.video.GetVideoByTitleReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/video/get/title\x12l\n" +
+	"\x0fListVideosByTag\x12\x1d.video.ListVideosByTagRequest\x1a\x1b.video.ListVideosByTagReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/video/tag/videos\x12V\n" +
+	"\n" +
+	"GetTagInfo\x12\x18.video.GetTagInfoRequest\x1a\x16.video.GetTagInfoReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/video/tag\x12e\n" +
+	"\fTrendingTags\x12\x1a.video.TrendingTagsRequest\x1a\x18.video.TrendingTagsReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/video/tag/trending\x12_\n" +
+	"\fSearchVideos\x12\x1a.video.SearchVideosRequest\x1a\x18.video.SearchVideosReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/video/search\x12m\n" +
+	"\x0eSuggestQueries\x12\x1c.video.SuggestQueriesRequest\x1a\x1a.video.SuggestQueriesReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/video/search/suggest\x12v\n" +
+	"\x11ListSearchHistory\x12\x1f.video.ListSearchHistoryRequest\x1a\x1d.video.ListSearchHistoryReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/video/search/history\x12\x82\x01\n" +
+	"\x12ClearSearchHistory\x12 .video.ClearSearchHistoryRequest\x1a\x1e.video.ClearSearchHistoryReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/video/search/history/clear\x12l\n" +
+	"\x0fListHotSearches\x12\x1d.video.ListHotSearchesRequest\x1a\x1b.video.ListHotSearchesReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/video/search/hot\x12v\n" +
+	"\x0fManageHotSearch\x12\x1d.video.ManageHotSearchRequest\x1a\x1b.video.ManageHotSearchReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/video/search/hot/manage\x12l\n" +
+	"\x0fUniversalSearch\x12\x1d.video.UniversalSearchRequest\x1a\x1b.video.UniversalSearchReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/video/search/all\x12a\n" +
+	"\n" +
+	"ReportPlay\x12\x18.video.ReportPlayRequest\x1a\x16.video.ReportPlayReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/video/play/report\x12[\n" +
+	"\n" +
+	"ShareVideo\x12\x18.video.ShareVideoRequest\x1a\x16.video.ShareVideoReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/video/share\x12f\n" +
+	"\fResolveShare\x12\x1a.video.ResolveShareRequest\x1a\x18.video.ResolveShareReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/video/share/resolve\x12c\n" +
+	"\fPublishVideo\x12\x1a.video.PublishVideoRequest\x1a\x18.video.PublishVideoReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/video/publish\x12h\n" +
+	"\x0fListDraftVideos\x12\x1d.video.ListDraftVideosRequest\x1a\x1b.video.ListDraftVideosReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/video/draftsB\x10Z\x0euser/api/v1;v1b\x06proto3"
 
 var (
 	file_video_v1_video_proto_rawDescOnce sync.Once
@@ -1362,59 +3944,153 @@ func file_video_v1_video_proto_rawDescGZIP() []byte {
 	return file_video_v1_video_proto_rawDescData
 }
 
-var file_video_v1_video_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_video_v1_video_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_video_v1_video_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_video_v1_video_proto_goTypes = []any{
-	(*GetVideoByTitleRequest)(nil),                 // 0: video.GetVideoByTitleRequest
-	(*GetVideoByTitleReply)(nil),                   // 1: video.GetVideoByTitleReply
-	(*GetVideoFavoriteAndCommentCountRequest)(nil), // 2: video.GetVideoFavoriteAndCommentCountRequest
-	(*GetVideoFavoriteAndCommentCountReply)(nil),   // 3: video.GetVideoFavoriteAndCommentCountReply
-	(*CalcVideoScoreRequest)(nil),                  // 4: video.CalcVideoScoreRequest
-	(*CalcVideoScoreReply)(nil),                    // 5: video.CalcVideoScoreReply
-	(*CheckVideoExistsRequest)(nil),                // 6: video.CheckVideoExistsRequest
-	(*CheckVideoExistsReply)(nil),                  // 7: video.CheckVideoExistsReply
-	(*BatchGetVideoInfoRequest)(nil),               // 8: video.BatchGetVideoInfoRequest
-	(*BatchGetVideoInfoReply)(nil),                 // 9: video.BatchGetVideoInfoReply
-	(*UploadVideoRequest)(nil),                     // 10: video.UploadVideoRequest
-	(*UploadVideoReply)(nil),                       // 11: video.UploadVideoReply
-	(*CreateVideoRequest)(nil),                     // 12: video.CreateVideoRequest
-	(*CreateVideoReply)(nil),                       // 13: video.CreateVideoReply
-	(*ListUserVideosRequest)(nil),                  // 14: video.ListUserVideosRequest
-	(*ListUserVideosReply)(nil),                    // 15: video.ListUserVideosReply
-	(*Video)(nil),                                  // 16: video.Video
-	(*timestamppb.Timestamp)(nil),                  // 17: google.protobuf.Timestamp
+	(ShareChannel)(0),                              // 0: video.ShareChannel
+	(SearchSectionType)(0),                         // 1: video.SearchSectionType
+	(HotSearchAction)(0),                           // 2: video.HotSearchAction
+	(SearchSort)(0),                                // 3: video.SearchSort
+	(*PublishVideoRequest)(nil),                    // 4: video.PublishVideoRequest
+	(*PublishVideoReply)(nil),                      // 5: video.PublishVideoReply
+	(*ListDraftVideosRequest)(nil),                 // 6: video.ListDraftVideosRequest
+	(*ListDraftVideosReply)(nil),                   // 7: video.ListDraftVideosReply
+	(*ShareVideoRequest)(nil),                      // 8: video.ShareVideoRequest
+	(*ShareVideoReply)(nil),                        // 9: video.ShareVideoReply
+	(*ResolveShareRequest)(nil),                    // 10: video.ResolveShareRequest
+	(*ResolveShareReply)(nil),                      // 11: video.ResolveShareReply
+	(*ReportPlayRequest)(nil),                      // 12: video.ReportPlayRequest
+	(*ReportPlayReply)(nil),                        // 13: video.ReportPlayReply
+	(*UniversalSearchRequest)(nil),                 // 14: video.UniversalSearchRequest
+	(*SearchUser)(nil),                             // 15: video.SearchUser
+	(*SearchSection)(nil),                          // 16: video.SearchSection
+	(*UniversalSearchReply)(nil),                   // 17: video.UniversalSearchReply
+	(*SuggestQueriesRequest)(nil),                  // 18: video.SuggestQueriesRequest
+	(*Suggestion)(nil),                             // 19: video.Suggestion
+	(*SuggestQueriesReply)(nil),                    // 20: video.SuggestQueriesReply
+	(*ListSearchHistoryRequest)(nil),               // 21: video.ListSearchHistoryRequest
+	(*ListSearchHistoryReply)(nil),                 // 22: video.ListSearchHistoryReply
+	(*ClearSearchHistoryRequest)(nil),              // 23: video.ClearSearchHistoryRequest
+	(*ClearSearchHistoryReply)(nil),                // 24: video.ClearSearchHistoryReply
+	(*ListHotSearchesRequest)(nil),                 // 25: video.ListHotSearchesRequest
+	(*HotSearch)(nil),                              // 26: video.HotSearch
+	(*ListHotSearchesReply)(nil),                   // 27: video.ListHotSearchesReply
+	(*ManageHotSearchRequest)(nil),                 // 28: video.ManageHotSearchRequest
+	(*ManageHotSearchReply)(nil),                   // 29: video.ManageHotSearchReply
+	(*SearchVideosRequest)(nil),                    // 30: video.SearchVideosRequest
+	(*SearchVideoItem)(nil),                        // 31: video.SearchVideoItem
+	(*SearchVideosReply)(nil),                      // 32: video.SearchVideosReply
+	(*Tag)(nil),                                    // 33: video.Tag
+	(*ListVideosByTagRequest)(nil),                 // 34: video.ListVideosByTagRequest
+	(*ListVideosByTagReply)(nil),                   // 35: video.ListVideosByTagReply
+	(*GetTagInfoRequest)(nil),                      // 36: video.GetTagInfoRequest
+	(*GetTagInfoReply)(nil),                        // 37: video.GetTagInfoReply
+	(*TrendingTagsRequest)(nil),                    // 38: video.TrendingTagsRequest
+	(*TrendingTagsReply)(nil),                      // 39: video.TrendingTagsReply
+	(*GetVideoByTitleRequest)(nil),                 // 40: video.GetVideoByTitleRequest
+	(*GetVideoByTitleReply)(nil),                   // 41: video.GetVideoByTitleReply
+	(*GetVideoFavoriteAndCommentCountRequest)(nil), // 42: video.GetVideoFavoriteAndCommentCountRequest
+	(*GetVideoFavoriteAndCommentCountReply)(nil),   // 43: video.GetVideoFavoriteAndCommentCountReply
+	(*CheckVideoExistsRequest)(nil),                // 44: video.CheckVideoExistsRequest
+	(*CheckVideoExistsReply)(nil),                  // 45: video.CheckVideoExistsReply
+	(*BatchGetVideoInfoRequest)(nil),               // 46: video.BatchGetVideoInfoRequest
+	(*BatchGetVideoInfoReply)(nil),                 // 47: video.BatchGetVideoInfoReply
+	(*UploadVideoRequest)(nil),                     // 48: video.UploadVideoRequest
+	(*UploadVideoReply)(nil),                       // 49: video.UploadVideoReply
+	(*CreateVideoRequest)(nil),                     // 50: video.CreateVideoRequest
+	(*Location)(nil),                               // 51: video.Location
+	(*CreateVideoReply)(nil),                       // 52: video.CreateVideoReply
+	(*ListUserVideosRequest)(nil),                  // 53: video.ListUserVideosRequest
+	(*ListUserVideosReply)(nil),                    // 54: video.ListUserVideosReply
+	(*Video)(nil),                                  // 55: video.Video
+	nil,                                            // 56: video.SearchVideoItem.HighlightsEntry
+	(*timestamppb.Timestamp)(nil),                  // 57: google.protobuf.Timestamp
 }
 var file_video_v1_video_proto_depIdxs = []int32{
-	16, // 0: video.GetVideoByTitleReply.videos:type_name -> video.Video
-	17, // 1: video.GetVideoFavoriteAndCommentCountReply.uploadTime:type_name -> google.protobuf.Timestamp
-	17, // 2: video.CalcVideoScoreRequest.uploadTime:type_name -> google.protobuf.Timestamp
-	16, // 3: video.BatchGetVideoInfoReply.videos:type_name -> video.Video
-	17, // 4: video.ListUserVideosRequest.start_time:type_name -> google.protobuf.Timestamp
-	17, // 5: video.ListUserVideosRequest.end_time:type_name -> google.protobuf.Timestamp
-	16, // 6: video.ListUserVideosReply.videos:type_name -> video.Video
-	17, // 7: video.Video.created_at:type_name -> google.protobuf.Timestamp
-	17, // 8: video.Video.update_time:type_name -> google.protobuf.Timestamp
-	17, // 9: video.Video.delete_at:type_name -> google.protobuf.Timestamp
-	12, // 10: video.VideoService.CreateVideo:input_type -> video.CreateVideoRequest
-	14, // 11: video.VideoService.ListUserVideos:input_type -> video.ListUserVideosRequest
-	10, // 12: video.VideoService.UploadVideo:input_type -> video.UploadVideoRequest
-	8,  // 13: video.VideoService.BatchGetVideoInfo:input_type -> video.BatchGetVideoInfoRequest
-	6,  // 14: video.VideoService.CheckVideoExists:input_type -> video.CheckVideoExistsRequest
-	4,  // 15: video.VideoService.CalcVideoScore:input_type -> video.CalcVideoScoreRequest
-	2,  // 16: video.VideoService.GetVideoFavoriteAndCommentCount:input_type -> video.GetVideoFavoriteAndCommentCountRequest
-	0,  // 17: video.VideoService.GetVideoByTitle:input_type -> video.GetVideoByTitleRequest
-	13, // 18: video.VideoService.CreateVideo:output_type -> video.CreateVideoReply
-	15, // 19: video.VideoService.ListUserVideos:output_type -> video.ListUserVideosReply
-	11, // 20: video.VideoService.UploadVideo:output_type -> video.UploadVideoReply
-	9,  // 21: video.VideoService.BatchGetVideoInfo:output_type -> video.BatchGetVideoInfoReply
-	7,  // 22: video.VideoService.CheckVideoExists:output_type -> video.CheckVideoExistsReply
-	5,  // 23: video.VideoService.CalcVideoScore:output_type -> video.CalcVideoScoreReply
-	3,  // 24: video.VideoService.GetVideoFavoriteAndCommentCount:output_type -> video.GetVideoFavoriteAndCommentCountReply
-	1,  // 25: video.VideoService.GetVideoByTitle:output_type -> video.GetVideoByTitleReply
-	18, // [18:26] is the sub-list for method output_type
-	10, // [10:18] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	57, // 0: video.PublishVideoRequest.publish_at:type_name -> google.protobuf.Timestamp
+	57, // 1: video.PublishVideoReply.publish_at:type_name -> google.protobuf.Timestamp
+	55, // 2: video.ListDraftVideosReply.videos:type_name -> video.Video
+	0,  // 3: video.ShareVideoRequest.channel:type_name -> video.ShareChannel
+	0,  // 4: video.ResolveShareReply.channel:type_name -> video.ShareChannel
+	1,  // 5: video.SearchSection.type:type_name -> video.SearchSectionType
+	31, // 6: video.SearchSection.videos:type_name -> video.SearchVideoItem
+	15, // 7: video.SearchSection.users:type_name -> video.SearchUser
+	33, // 8: video.SearchSection.tags:type_name -> video.Tag
+	16, // 9: video.UniversalSearchReply.sections:type_name -> video.SearchSection
+	19, // 10: video.SuggestQueriesReply.suggestions:type_name -> video.Suggestion
+	26, // 11: video.ListHotSearchesReply.items:type_name -> video.HotSearch
+	2,  // 12: video.ManageHotSearchRequest.action:type_name -> video.HotSearchAction
+	57, // 13: video.SearchVideosRequest.publish_start:type_name -> google.protobuf.Timestamp
+	57, // 14: video.SearchVideosRequest.publish_end:type_name -> google.protobuf.Timestamp
+	3,  // 15: video.SearchVideosRequest.sort:type_name -> video.SearchSort
+	55, // 16: video.SearchVideoItem.video:type_name -> video.Video
+	56, // 17: video.SearchVideoItem.highlights:type_name -> video.SearchVideoItem.HighlightsEntry
+	31, // 18: video.SearchVideosReply.items:type_name -> video.SearchVideoItem
+	33, // 19: video.ListVideosByTagReply.tag:type_name -> video.Tag
+	55, // 20: video.ListVideosByTagReply.videos:type_name -> video.Video
+	33, // 21: video.GetTagInfoReply.tag:type_name -> video.Tag
+	33, // 22: video.TrendingTagsReply.tags:type_name -> video.Tag
+	55, // 23: video.GetVideoByTitleReply.videos:type_name -> video.Video
+	57, // 24: video.GetVideoFavoriteAndCommentCountReply.uploadTime:type_name -> google.protobuf.Timestamp
+	55, // 25: video.BatchGetVideoInfoReply.videos:type_name -> video.Video
+	57, // 26: video.CreateVideoRequest.publish_at:type_name -> google.protobuf.Timestamp
+	51, // 27: video.CreateVideoRequest.location:type_name -> video.Location
+	57, // 28: video.ListUserVideosRequest.start_time:type_name -> google.protobuf.Timestamp
+	57, // 29: video.ListUserVideosRequest.end_time:type_name -> google.protobuf.Timestamp
+	55, // 30: video.ListUserVideosReply.videos:type_name -> video.Video
+	57, // 31: video.Video.created_at:type_name -> google.protobuf.Timestamp
+	57, // 32: video.Video.update_time:type_name -> google.protobuf.Timestamp
+	57, // 33: video.Video.delete_at:type_name -> google.protobuf.Timestamp
+	57, // 34: video.Video.publish_at:type_name -> google.protobuf.Timestamp
+	50, // 35: video.VideoService.CreateVideo:input_type -> video.CreateVideoRequest
+	53, // 36: video.VideoService.ListUserVideos:input_type -> video.ListUserVideosRequest
+	48, // 37: video.VideoService.UploadVideo:input_type -> video.UploadVideoRequest
+	46, // 38: video.VideoService.BatchGetVideoInfo:input_type -> video.BatchGetVideoInfoRequest
+	44, // 39: video.VideoService.CheckVideoExists:input_type -> video.CheckVideoExistsRequest
+	42, // 40: video.VideoService.GetVideoFavoriteAndCommentCount:input_type -> video.GetVideoFavoriteAndCommentCountRequest
+	40, // 41: video.VideoService.GetVideoByTitle:input_type -> video.GetVideoByTitleRequest
+	34, // 42: video.VideoService.ListVideosByTag:input_type -> video.ListVideosByTagRequest
+	36, // 43: video.VideoService.GetTagInfo:input_type -> video.GetTagInfoRequest
+	38, // 44: video.VideoService.TrendingTags:input_type -> video.TrendingTagsRequest
+	30, // 45: video.VideoService.SearchVideos:input_type -> video.SearchVideosRequest
+	18, // 46: video.VideoService.SuggestQueries:input_type -> video.SuggestQueriesRequest
+	21, // 47: video.VideoService.ListSearchHistory:input_type -> video.ListSearchHistoryRequest
+	23, // 48: video.VideoService.ClearSearchHistory:input_type -> video.ClearSearchHistoryRequest
+	25, // 49: video.VideoService.ListHotSearches:input_type -> video.ListHotSearchesRequest
+	28, // 50: video.VideoService.ManageHotSearch:input_type -> video.ManageHotSearchRequest
+	14, // 51: video.VideoService.UniversalSearch:input_type -> video.UniversalSearchRequest
+	12, // 52: video.VideoService.ReportPlay:input_type -> video.ReportPlayRequest
+	8,  // 53: video.VideoService.ShareVideo:input_type -> video.ShareVideoRequest
+	10, // 54: video.VideoService.ResolveShare:input_type -> video.ResolveShareRequest
+	4,  // 55: video.VideoService.PublishVideo:input_type -> video.PublishVideoRequest
+	6,  // 56: video.VideoService.ListDraftVideos:input_type -> video.ListDraftVideosRequest
+	52, // 57: video.VideoService.CreateVideo:output_type -> video.CreateVideoReply
+	54, // 58: video.VideoService.ListUserVideos:output_type -> video.ListUserVideosReply
+	49, // 59: video.VideoService.UploadVideo:output_type -> video.UploadVideoReply
+	47, // 60: video.VideoService.BatchGetVideoInfo:output_type -> video.BatchGetVideoInfoReply
+	45, // 61: video.VideoService.CheckVideoExists:output_type -> video.CheckVideoExistsReply
+	43, // 62: video.VideoService.GetVideoFavoriteAndCommentCount:output_type -> video.GetVideoFavoriteAndCommentCountReply
+	41, // 63: video.VideoService.GetVideoByTitle:output_type -> video.GetVideoByTitleReply
+	35, // 64: video.VideoService.ListVideosByTag:output_type -> video.ListVideosByTagReply
+	37, // 65: video.VideoService.GetTagInfo:output_type -> video.GetTagInfoReply
+	39, // 66: video.VideoService.TrendingTags:output_type -> video.TrendingTagsReply
+	32, // 67: video.VideoService.SearchVideos:output_type -> video.SearchVideosReply
+	20, // 68: video.VideoService.SuggestQueries:output_type -> video.SuggestQueriesReply
+	22, // 69: video.VideoService.ListSearchHistory:output_type -> video.ListSearchHistoryReply
+	24, // 70: video.VideoService.ClearSearchHistory:output_type -> video.ClearSearchHistoryReply
+	27, // 71: video.VideoService.ListHotSearches:output_type -> video.ListHotSearchesReply
+	29, // 72: video.VideoService.ManageHotSearch:output_type -> video.ManageHotSearchReply
+	17, // 73: video.VideoService.UniversalSearch:output_type -> video.UniversalSearchReply
+	13, // 74: video.VideoService.ReportPlay:output_type -> video.ReportPlayReply
+	9,  // 75: video.VideoService.ShareVideo:output_type -> video.ShareVideoReply
+	11, // 76: video.VideoService.ResolveShare:output_type -> video.ResolveShareReply
+	5,  // 77: video.VideoService.PublishVideo:output_type -> video.PublishVideoReply
+	7,  // 78: video.VideoService.ListDraftVideos:output_type -> video.ListDraftVideosReply
+	57, // [57:79] is the sub-list for method output_type
+	35, // [35:57] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_video_v1_video_proto_init() }
@@ -1427,13 +4103,14 @@ func file_video_v1_video_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_video_v1_video_proto_rawDesc), len(file_video_v1_video_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      4,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_video_v1_video_proto_goTypes,
 		DependencyIndexes: file_video_v1_video_proto_depIdxs,
+		EnumInfos:         file_video_v1_video_proto_enumTypes,
 		MessageInfos:      file_video_v1_video_proto_msgTypes,
 	}.Build()
 	File_video_v1_video_proto = out.File
@@ -24,9 +24,23 @@ const (
 	VideoService_UploadVideo_FullMethodName                     = "/video.VideoService/UploadVideo"
 	VideoService_BatchGetVideoInfo_FullMethodName               = "/video.VideoService/BatchGetVideoInfo"
 	VideoService_CheckVideoExists_FullMethodName                = "/video.VideoService/CheckVideoExists"
-	VideoService_CalcVideoScore_FullMethodName                  = "/video.VideoService/CalcVideoScore"
 	VideoService_GetVideoFavoriteAndCommentCount_FullMethodName = "/video.VideoService/GetVideoFavoriteAndCommentCount"
 	VideoService_GetVideoByTitle_FullMethodName                 = "/video.VideoService/GetVideoByTitle"
+	VideoService_ListVideosByTag_FullMethodName                 = "/video.VideoService/ListVideosByTag"
+	VideoService_GetTagInfo_FullMethodName                      = "/video.VideoService/GetTagInfo"
+	VideoService_TrendingTags_FullMethodName                    = "/video.VideoService/TrendingTags"
+	VideoService_SearchVideos_FullMethodName                    = "/video.VideoService/SearchVideos"
+	VideoService_SuggestQueries_FullMethodName                  = "/video.VideoService/SuggestQueries"
+	VideoService_ListSearchHistory_FullMethodName               = "/video.VideoService/ListSearchHistory"
+	VideoService_ClearSearchHistory_FullMethodName              = "/video.VideoService/ClearSearchHistory"
+	VideoService_ListHotSearches_FullMethodName                 = "/video.VideoService/ListHotSearches"
+	VideoService_ManageHotSearch_FullMethodName                 = "/video.VideoService/ManageHotSearch"
+	VideoService_UniversalSearch_FullMethodName                 = "/video.VideoService/UniversalSearch"
+	VideoService_ReportPlay_FullMethodName                      = "/video.VideoService/ReportPlay"
+	VideoService_ShareVideo_FullMethodName                      = "/video.VideoService/ShareVideo"
+	VideoService_ResolveShare_FullMethodName                    = "/video.VideoService/ResolveShare"
+	VideoService_PublishVideo_FullMethodName                    = "/video.VideoService/PublishVideo"
+	VideoService_ListDraftVideos_FullMethodName                 = "/video.VideoService/ListDraftVideos"
 )
 
 // VideoServiceClient is the client API for VideoService service.
@@ -43,9 +57,38 @@ type VideoServiceClient interface {
 	BatchGetVideoInfo(ctx context.Context, in *BatchGetVideoInfoRequest, opts ...grpc.CallOption) (*BatchGetVideoInfoReply, error)
 	// 检查视频是否存在
 	CheckVideoExists(ctx context.Context, in *CheckVideoExistsRequest, opts ...grpc.CallOption) (*CheckVideoExistsReply, error)
-	CalcVideoScore(ctx context.Context, in *CalcVideoScoreRequest, opts ...grpc.CallOption) (*CalcVideoScoreReply, error)
 	GetVideoFavoriteAndCommentCount(ctx context.Context, in *GetVideoFavoriteAndCommentCountRequest, opts ...grpc.CallOption) (*GetVideoFavoriteAndCommentCountReply, error)
 	GetVideoByTitle(ctx context.Context, in *GetVideoByTitleRequest, opts ...grpc.CallOption) (*GetVideoByTitleReply, error)
+	// 根据话题获取视频列表
+	ListVideosByTag(ctx context.Context, in *ListVideosByTagRequest, opts ...grpc.CallOption) (*ListVideosByTagReply, error)
+	// 获取话题信息
+	GetTagInfo(ctx context.Context, in *GetTagInfoRequest, opts ...grpc.CallOption) (*GetTagInfoReply, error)
+	// 热门话题
+	TrendingTags(ctx context.Context, in *TrendingTagsRequest, opts ...grpc.CallOption) (*TrendingTagsReply, error)
+	// 搜索视频
+	SearchVideos(ctx context.Context, in *SearchVideosRequest, opts ...grpc.CallOption) (*SearchVideosReply, error)
+	// 搜索建议
+	SuggestQueries(ctx context.Context, in *SuggestQueriesRequest, opts ...grpc.CallOption) (*SuggestQueriesReply, error)
+	// 获取搜索历史
+	ListSearchHistory(ctx context.Context, in *ListSearchHistoryRequest, opts ...grpc.CallOption) (*ListSearchHistoryReply, error)
+	// 清除搜索历史
+	ClearSearchHistory(ctx context.Context, in *ClearSearchHistoryRequest, opts ...grpc.CallOption) (*ClearSearchHistoryReply, error)
+	// 热搜榜
+	ListHotSearches(ctx context.Context, in *ListHotSearchesRequest, opts ...grpc.CallOption) (*ListHotSearchesReply, error)
+	// 管理热搜词（置顶、屏蔽），仅管理员
+	ManageHotSearch(ctx context.Context, in *ManageHotSearchRequest, opts ...grpc.CallOption) (*ManageHotSearchReply, error)
+	// 综合搜索，同时搜索视频、用户、话题并分组返回
+	UniversalSearch(ctx context.Context, in *UniversalSearchRequest, opts ...grpc.CallOption) (*UniversalSearchReply, error)
+	// 上报播放，客户端播放结束或切走时调用
+	ReportPlay(ctx context.Context, in *ReportPlayRequest, opts ...grpc.CallOption) (*ReportPlayReply, error)
+	// 分享视频，生成分享短链
+	ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoReply, error)
+	// 解析分享码，app 通过分享链接打开或安装后首次启动时调用，用于归因
+	ResolveShare(ctx context.Context, in *ResolveShareRequest, opts ...grpc.CallOption) (*ResolveShareReply, error)
+	// 发布草稿或修改定时发布时间
+	PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...grpc.CallOption) (*PublishVideoReply, error)
+	// 获取当前用户的草稿与定时发布视频
+	ListDraftVideos(ctx context.Context, in *ListDraftVideosRequest, opts ...grpc.CallOption) (*ListDraftVideosReply, error)
 }
 
 type videoServiceClient struct {
@@ -106,30 +149,170 @@ func (c *videoServiceClient) CheckVideoExists(ctx context.Context, in *CheckVide
 	return out, nil
 }
 
-func (c *videoServiceClient) CalcVideoScore(ctx context.Context, in *CalcVideoScoreRequest, opts ...grpc.CallOption) (*CalcVideoScoreReply, error) {
+func (c *videoServiceClient) GetVideoFavoriteAndCommentCount(ctx context.Context, in *GetVideoFavoriteAndCommentCountRequest, opts ...grpc.CallOption) (*GetVideoFavoriteAndCommentCountReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CalcVideoScoreReply)
-	err := c.cc.Invoke(ctx, VideoService_CalcVideoScore_FullMethodName, in, out, cOpts...)
+	out := new(GetVideoFavoriteAndCommentCountReply)
+	err := c.cc.Invoke(ctx, VideoService_GetVideoFavoriteAndCommentCount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *videoServiceClient) GetVideoFavoriteAndCommentCount(ctx context.Context, in *GetVideoFavoriteAndCommentCountRequest, opts ...grpc.CallOption) (*GetVideoFavoriteAndCommentCountReply, error) {
+func (c *videoServiceClient) GetVideoByTitle(ctx context.Context, in *GetVideoByTitleRequest, opts ...grpc.CallOption) (*GetVideoByTitleReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVideoFavoriteAndCommentCountReply)
-	err := c.cc.Invoke(ctx, VideoService_GetVideoFavoriteAndCommentCount_FullMethodName, in, out, cOpts...)
+	out := new(GetVideoByTitleReply)
+	err := c.cc.Invoke(ctx, VideoService_GetVideoByTitle_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *videoServiceClient) GetVideoByTitle(ctx context.Context, in *GetVideoByTitleRequest, opts ...grpc.CallOption) (*GetVideoByTitleReply, error) {
+func (c *videoServiceClient) ListVideosByTag(ctx context.Context, in *ListVideosByTagRequest, opts ...grpc.CallOption) (*ListVideosByTagReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVideoByTitleReply)
-	err := c.cc.Invoke(ctx, VideoService_GetVideoByTitle_FullMethodName, in, out, cOpts...)
+	out := new(ListVideosByTagReply)
+	err := c.cc.Invoke(ctx, VideoService_ListVideosByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTagInfo(ctx context.Context, in *GetTagInfoRequest, opts ...grpc.CallOption) (*GetTagInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagInfoReply)
+	err := c.cc.Invoke(ctx, VideoService_GetTagInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) TrendingTags(ctx context.Context, in *TrendingTagsRequest, opts ...grpc.CallOption) (*TrendingTagsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingTagsReply)
+	err := c.cc.Invoke(ctx, VideoService_TrendingTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SearchVideos(ctx context.Context, in *SearchVideosRequest, opts ...grpc.CallOption) (*SearchVideosReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchVideosReply)
+	err := c.cc.Invoke(ctx, VideoService_SearchVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SuggestQueries(ctx context.Context, in *SuggestQueriesRequest, opts ...grpc.CallOption) (*SuggestQueriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestQueriesReply)
+	err := c.cc.Invoke(ctx, VideoService_SuggestQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListSearchHistory(ctx context.Context, in *ListSearchHistoryRequest, opts ...grpc.CallOption) (*ListSearchHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchHistoryReply)
+	err := c.cc.Invoke(ctx, VideoService_ListSearchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ClearSearchHistory(ctx context.Context, in *ClearSearchHistoryRequest, opts ...grpc.CallOption) (*ClearSearchHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearSearchHistoryReply)
+	err := c.cc.Invoke(ctx, VideoService_ClearSearchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListHotSearches(ctx context.Context, in *ListHotSearchesRequest, opts ...grpc.CallOption) (*ListHotSearchesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHotSearchesReply)
+	err := c.cc.Invoke(ctx, VideoService_ListHotSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ManageHotSearch(ctx context.Context, in *ManageHotSearchRequest, opts ...grpc.CallOption) (*ManageHotSearchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManageHotSearchReply)
+	err := c.cc.Invoke(ctx, VideoService_ManageHotSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UniversalSearch(ctx context.Context, in *UniversalSearchRequest, opts ...grpc.CallOption) (*UniversalSearchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UniversalSearchReply)
+	err := c.cc.Invoke(ctx, VideoService_UniversalSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReportPlay(ctx context.Context, in *ReportPlayRequest, opts ...grpc.CallOption) (*ReportPlayReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportPlayReply)
+	err := c.cc.Invoke(ctx, VideoService_ReportPlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareVideoReply)
+	err := c.cc.Invoke(ctx, VideoService_ShareVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ResolveShare(ctx context.Context, in *ResolveShareRequest, opts ...grpc.CallOption) (*ResolveShareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveShareReply)
+	err := c.cc.Invoke(ctx, VideoService_ResolveShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...grpc.CallOption) (*PublishVideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishVideoReply)
+	err := c.cc.Invoke(ctx, VideoService_PublishVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListDraftVideos(ctx context.Context, in *ListDraftVideosRequest, opts ...grpc.CallOption) (*ListDraftVideosReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDraftVideosReply)
+	err := c.cc.Invoke(ctx, VideoService_ListDraftVideos_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -150,9 +333,38 @@ type VideoServiceServer interface {
 	BatchGetVideoInfo(context.Context, *BatchGetVideoInfoRequest) (*BatchGetVideoInfoReply, error)
 	// 检查视频是否存在
 	CheckVideoExists(context.Context, *CheckVideoExistsRequest) (*CheckVideoExistsReply, error)
-	CalcVideoScore(context.Context, *CalcVideoScoreRequest) (*CalcVideoScoreReply, error)
 	GetVideoFavoriteAndCommentCount(context.Context, *GetVideoFavoriteAndCommentCountRequest) (*GetVideoFavoriteAndCommentCountReply, error)
 	GetVideoByTitle(context.Context, *GetVideoByTitleRequest) (*GetVideoByTitleReply, error)
+	// 根据话题获取视频列表
+	ListVideosByTag(context.Context, *ListVideosByTagRequest) (*ListVideosByTagReply, error)
+	// 获取话题信息
+	GetTagInfo(context.Context, *GetTagInfoRequest) (*GetTagInfoReply, error)
+	// 热门话题
+	TrendingTags(context.Context, *TrendingTagsRequest) (*TrendingTagsReply, error)
+	// 搜索视频
+	SearchVideos(context.Context, *SearchVideosRequest) (*SearchVideosReply, error)
+	// 搜索建议
+	SuggestQueries(context.Context, *SuggestQueriesRequest) (*SuggestQueriesReply, error)
+	// 获取搜索历史
+	ListSearchHistory(context.Context, *ListSearchHistoryRequest) (*ListSearchHistoryReply, error)
+	// 清除搜索历史
+	ClearSearchHistory(context.Context, *ClearSearchHistoryRequest) (*ClearSearchHistoryReply, error)
+	// 热搜榜
+	ListHotSearches(context.Context, *ListHotSearchesRequest) (*ListHotSearchesReply, error)
+	// 管理热搜词（置顶、屏蔽），仅管理员
+	ManageHotSearch(context.Context, *ManageHotSearchRequest) (*ManageHotSearchReply, error)
+	// 综合搜索，同时搜索视频、用户、话题并分组返回
+	UniversalSearch(context.Context, *UniversalSearchRequest) (*UniversalSearchReply, error)
+	// 上报播放，客户端播放结束或切走时调用
+	ReportPlay(context.Context, *ReportPlayRequest) (*ReportPlayReply, error)
+	// 分享视频，生成分享短链
+	ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoReply, error)
+	// 解析分享码，app 通过分享链接打开或安装后首次启动时调用，用于归因
+	ResolveShare(context.Context, *ResolveShareRequest) (*ResolveShareReply, error)
+	// 发布草稿或修改定时发布时间
+	PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoReply, error)
+	// 获取当前用户的草稿与定时发布视频
+	ListDraftVideos(context.Context, *ListDraftVideosRequest) (*ListDraftVideosReply, error)
 	mustEmbedUnimplementedVideoServiceServer()
 }
 
@@ -178,15 +390,57 @@ func (UnimplementedVideoServiceServer) BatchGetVideoInfo(context.Context, *Batch
 func (UnimplementedVideoServiceServer) CheckVideoExists(context.Context, *CheckVideoExistsRequest) (*CheckVideoExistsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckVideoExists not implemented")
 }
-func (UnimplementedVideoServiceServer) CalcVideoScore(context.Context, *CalcVideoScoreRequest) (*CalcVideoScoreReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcVideoScore not implemented")
-}
 func (UnimplementedVideoServiceServer) GetVideoFavoriteAndCommentCount(context.Context, *GetVideoFavoriteAndCommentCountRequest) (*GetVideoFavoriteAndCommentCountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoFavoriteAndCommentCount not implemented")
 }
 func (UnimplementedVideoServiceServer) GetVideoByTitle(context.Context, *GetVideoByTitleRequest) (*GetVideoByTitleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoByTitle not implemented")
 }
+func (UnimplementedVideoServiceServer) ListVideosByTag(context.Context, *ListVideosByTagRequest) (*ListVideosByTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVideosByTag not implemented")
+}
+func (UnimplementedVideoServiceServer) GetTagInfo(context.Context, *GetTagInfoRequest) (*GetTagInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagInfo not implemented")
+}
+func (UnimplementedVideoServiceServer) TrendingTags(context.Context, *TrendingTagsRequest) (*TrendingTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrendingTags not implemented")
+}
+func (UnimplementedVideoServiceServer) SearchVideos(context.Context, *SearchVideosRequest) (*SearchVideosReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) SuggestQueries(context.Context, *SuggestQueriesRequest) (*SuggestQueriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestQueries not implemented")
+}
+func (UnimplementedVideoServiceServer) ListSearchHistory(context.Context, *ListSearchHistoryRequest) (*ListSearchHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSearchHistory not implemented")
+}
+func (UnimplementedVideoServiceServer) ClearSearchHistory(context.Context, *ClearSearchHistoryRequest) (*ClearSearchHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSearchHistory not implemented")
+}
+func (UnimplementedVideoServiceServer) ListHotSearches(context.Context, *ListHotSearchesRequest) (*ListHotSearchesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHotSearches not implemented")
+}
+func (UnimplementedVideoServiceServer) ManageHotSearch(context.Context, *ManageHotSearchRequest) (*ManageHotSearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageHotSearch not implemented")
+}
+func (UnimplementedVideoServiceServer) UniversalSearch(context.Context, *UniversalSearchRequest) (*UniversalSearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UniversalSearch not implemented")
+}
+func (UnimplementedVideoServiceServer) ReportPlay(context.Context, *ReportPlayRequest) (*ReportPlayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPlay not implemented")
+}
+func (UnimplementedVideoServiceServer) ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ResolveShare(context.Context, *ResolveShareRequest) (*ResolveShareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShare not implemented")
+}
+func (UnimplementedVideoServiceServer) PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ListDraftVideos(context.Context, *ListDraftVideosRequest) (*ListDraftVideosReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDraftVideos not implemented")
+}
 func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
 func (UnimplementedVideoServiceServer) testEmbeddedByValue()                      {}
 
@@ -298,56 +552,308 @@ func _VideoService_CheckVideoExists_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_CalcVideoScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalcVideoScoreRequest)
+func _VideoService_GetVideoFavoriteAndCommentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoFavoriteAndCommentCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).CalcVideoScore(ctx, in)
+		return srv.(VideoServiceServer).GetVideoFavoriteAndCommentCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_CalcVideoScore_FullMethodName,
+		FullMethod: VideoService_GetVideoFavoriteAndCommentCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).CalcVideoScore(ctx, req.(*CalcVideoScoreRequest))
+		return srv.(VideoServiceServer).GetVideoFavoriteAndCommentCount(ctx, req.(*GetVideoFavoriteAndCommentCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideoFavoriteAndCommentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideoFavoriteAndCommentCountRequest)
+func _VideoService_GetVideoByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoByTitleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideoFavoriteAndCommentCount(ctx, in)
+		return srv.(VideoServiceServer).GetVideoByTitle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_GetVideoFavoriteAndCommentCount_FullMethodName,
+		FullMethod: VideoService_GetVideoByTitle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideoFavoriteAndCommentCount(ctx, req.(*GetVideoFavoriteAndCommentCountRequest))
+		return srv.(VideoServiceServer).GetVideoByTitle(ctx, req.(*GetVideoByTitleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideoByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideoByTitleRequest)
+func _VideoService_ListVideosByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideosByTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideoByTitle(ctx, in)
+		return srv.(VideoServiceServer).ListVideosByTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_GetVideoByTitle_FullMethodName,
+		FullMethod: VideoService_ListVideosByTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideoByTitle(ctx, req.(*GetVideoByTitleRequest))
+		return srv.(VideoServiceServer).ListVideosByTag(ctx, req.(*ListVideosByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetTagInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTagInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetTagInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTagInfo(ctx, req.(*GetTagInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_TrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).TrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_TrendingTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).TrendingTags(ctx, req.(*TrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SearchVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SearchVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SearchVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SearchVideos(ctx, req.(*SearchVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SuggestQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SuggestQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SuggestQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SuggestQueries(ctx, req.(*SuggestQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListSearchHistory(ctx, req.(*ListSearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ClearSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ClearSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ClearSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ClearSearchHistory(ctx, req.(*ClearSearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListHotSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHotSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListHotSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListHotSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListHotSearches(ctx, req.(*ListHotSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ManageHotSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageHotSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ManageHotSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ManageHotSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ManageHotSearch(ctx, req.(*ManageHotSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UniversalSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniversalSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UniversalSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_UniversalSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UniversalSearch(ctx, req.(*UniversalSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ReportPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReportPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ReportPlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReportPlay(ctx, req.(*ReportPlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ShareVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ShareVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ShareVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ShareVideo(ctx, req.(*ShareVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ResolveShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ResolveShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ResolveShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ResolveShare(ctx, req.(*ResolveShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_PublishVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).PublishVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_PublishVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).PublishVideo(ctx, req.(*PublishVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListDraftVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListDraftVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListDraftVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListDraftVideos(ctx, req.(*ListDraftVideosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -379,10 +885,6 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckVideoExists",
 			Handler:    _VideoService_CheckVideoExists_Handler,
 		},
-		{
-			MethodName: "CalcVideoScore",
-			Handler:    _VideoService_CalcVideoScore_Handler,
-		},
 		{
 			MethodName: "GetVideoFavoriteAndCommentCount",
 			Handler:    _VideoService_GetVideoFavoriteAndCommentCount_Handler,
@@ -391,6 +893,66 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVideoByTitle",
 			Handler:    _VideoService_GetVideoByTitle_Handler,
 		},
+		{
+			MethodName: "ListVideosByTag",
+			Handler:    _VideoService_ListVideosByTag_Handler,
+		},
+		{
+			MethodName: "GetTagInfo",
+			Handler:    _VideoService_GetTagInfo_Handler,
+		},
+		{
+			MethodName: "TrendingTags",
+			Handler:    _VideoService_TrendingTags_Handler,
+		},
+		{
+			MethodName: "SearchVideos",
+			Handler:    _VideoService_SearchVideos_Handler,
+		},
+		{
+			MethodName: "SuggestQueries",
+			Handler:    _VideoService_SuggestQueries_Handler,
+		},
+		{
+			MethodName: "ListSearchHistory",
+			Handler:    _VideoService_ListSearchHistory_Handler,
+		},
+		{
+			MethodName: "ClearSearchHistory",
+			Handler:    _VideoService_ClearSearchHistory_Handler,
+		},
+		{
+			MethodName: "ListHotSearches",
+			Handler:    _VideoService_ListHotSearches_Handler,
+		},
+		{
+			MethodName: "ManageHotSearch",
+			Handler:    _VideoService_ManageHotSearch_Handler,
+		},
+		{
+			MethodName: "UniversalSearch",
+			Handler:    _VideoService_UniversalSearch_Handler,
+		},
+		{
+			MethodName: "ReportPlay",
+			Handler:    _VideoService_ReportPlay_Handler,
+		},
+		{
+			MethodName: "ShareVideo",
+			Handler:    _VideoService_ShareVideo_Handler,
+		},
+		{
+			MethodName: "ResolveShare",
+			Handler:    _VideoService_ResolveShare_Handler,
+		},
+		{
+			MethodName: "PublishVideo",
+			Handler:    _VideoService_PublishVideo_Handler,
+		},
+		{
+			MethodName: "ListDraftVideos",
+			Handler:    _VideoService_ListDraftVideos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "video/v1/video.proto",
@@ -19,17 +19,62 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationVideoServiceClearSearchHistory = "/video.VideoService/ClearSearchHistory"
 const OperationVideoServiceCreateVideo = "/video.VideoService/CreateVideo"
+const OperationVideoServiceGetTagInfo = "/video.VideoService/GetTagInfo"
 const OperationVideoServiceGetVideoByTitle = "/video.VideoService/GetVideoByTitle"
+const OperationVideoServiceListDraftVideos = "/video.VideoService/ListDraftVideos"
+const OperationVideoServiceListHotSearches = "/video.VideoService/ListHotSearches"
+const OperationVideoServiceListSearchHistory = "/video.VideoService/ListSearchHistory"
 const OperationVideoServiceListUserVideos = "/video.VideoService/ListUserVideos"
+const OperationVideoServiceListVideosByTag = "/video.VideoService/ListVideosByTag"
+const OperationVideoServiceManageHotSearch = "/video.VideoService/ManageHotSearch"
+const OperationVideoServicePublishVideo = "/video.VideoService/PublishVideo"
+const OperationVideoServiceReportPlay = "/video.VideoService/ReportPlay"
+const OperationVideoServiceResolveShare = "/video.VideoService/ResolveShare"
+const OperationVideoServiceSearchVideos = "/video.VideoService/SearchVideos"
+const OperationVideoServiceShareVideo = "/video.VideoService/ShareVideo"
+const OperationVideoServiceSuggestQueries = "/video.VideoService/SuggestQueries"
+const OperationVideoServiceTrendingTags = "/video.VideoService/TrendingTags"
+const OperationVideoServiceUniversalSearch = "/video.VideoService/UniversalSearch"
 const OperationVideoServiceUploadVideo = "/video.VideoService/UploadVideo"
 
 type VideoServiceHTTPServer interface {
+	// ClearSearchHistory 清除搜索历史
+	ClearSearchHistory(context.Context, *ClearSearchHistoryRequest) (*ClearSearchHistoryReply, error)
 	// CreateVideo 上传视频信息
 	CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoReply, error)
+	// GetTagInfo 获取话题信息
+	GetTagInfo(context.Context, *GetTagInfoRequest) (*GetTagInfoReply, error)
 	GetVideoByTitle(context.Context, *GetVideoByTitleRequest) (*GetVideoByTitleReply, error)
+	// ListDraftVideos 获取当前用户的草稿与定时发布视频
+	ListDraftVideos(context.Context, *ListDraftVideosRequest) (*ListDraftVideosReply, error)
+	// ListHotSearches 热搜榜
+	ListHotSearches(context.Context, *ListHotSearchesRequest) (*ListHotSearchesReply, error)
+	// ListSearchHistory 获取搜索历史
+	ListSearchHistory(context.Context, *ListSearchHistoryRequest) (*ListSearchHistoryReply, error)
 	// ListUserVideos 获取用户视频列表
 	ListUserVideos(context.Context, *ListUserVideosRequest) (*ListUserVideosReply, error)
+	// ListVideosByTag 根据话题获取视频列表
+	ListVideosByTag(context.Context, *ListVideosByTagRequest) (*ListVideosByTagReply, error)
+	// ManageHotSearch 管理热搜词（置顶、屏蔽），仅管理员
+	ManageHotSearch(context.Context, *ManageHotSearchRequest) (*ManageHotSearchReply, error)
+	// PublishVideo 发布草稿或修改定时发布时间
+	PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoReply, error)
+	// ReportPlay 上报播放，客户端播放结束或切走时调用
+	ReportPlay(context.Context, *ReportPlayRequest) (*ReportPlayReply, error)
+	// ResolveShare 解析分享码，app 通过分享链接打开或安装后首次启动时调用，用于归因
+	ResolveShare(context.Context, *ResolveShareRequest) (*ResolveShareReply, error)
+	// SearchVideos 搜索视频
+	SearchVideos(context.Context, *SearchVideosRequest) (*SearchVideosReply, error)
+	// ShareVideo 分享视频，生成分享短链
+	ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoReply, error)
+	// SuggestQueries 搜索建议
+	SuggestQueries(context.Context, *SuggestQueriesRequest) (*SuggestQueriesReply, error)
+	// TrendingTags 热门话题
+	TrendingTags(context.Context, *TrendingTagsRequest) (*TrendingTagsReply, error)
+	// UniversalSearch 综合搜索，同时搜索视频、用户、话题并分组返回
+	UniversalSearch(context.Context, *UniversalSearchRequest) (*UniversalSearchReply, error)
 	// UploadVideo 上传视频
 	UploadVideo(context.Context, *UploadVideoRequest) (*UploadVideoReply, error)
 }
@@ -40,6 +85,21 @@ func RegisterVideoServiceHTTPServer(s *http.Server, srv VideoServiceHTTPServer)
 	r.GET("/api/video", _VideoService_ListUserVideos0_HTTP_Handler(srv))
 	r.POST("/api/video/upload", _VideoService_UploadVideo0_HTTP_Handler(srv))
 	r.GET("/api/video/get/title", _VideoService_GetVideoByTitle0_HTTP_Handler(srv))
+	r.GET("/api/video/tag/videos", _VideoService_ListVideosByTag0_HTTP_Handler(srv))
+	r.GET("/api/video/tag", _VideoService_GetTagInfo0_HTTP_Handler(srv))
+	r.GET("/api/video/tag/trending", _VideoService_TrendingTags0_HTTP_Handler(srv))
+	r.GET("/api/video/search", _VideoService_SearchVideos0_HTTP_Handler(srv))
+	r.GET("/api/video/search/suggest", _VideoService_SuggestQueries0_HTTP_Handler(srv))
+	r.GET("/api/video/search/history", _VideoService_ListSearchHistory0_HTTP_Handler(srv))
+	r.POST("/api/video/search/history/clear", _VideoService_ClearSearchHistory0_HTTP_Handler(srv))
+	r.GET("/api/video/search/hot", _VideoService_ListHotSearches0_HTTP_Handler(srv))
+	r.POST("/api/video/search/hot/manage", _VideoService_ManageHotSearch0_HTTP_Handler(srv))
+	r.GET("/api/video/search/all", _VideoService_UniversalSearch0_HTTP_Handler(srv))
+	r.POST("/api/video/play/report", _VideoService_ReportPlay0_HTTP_Handler(srv))
+	r.POST("/api/video/share", _VideoService_ShareVideo0_HTTP_Handler(srv))
+	r.GET("/api/video/share/resolve", _VideoService_ResolveShare0_HTTP_Handler(srv))
+	r.POST("/api/video/publish", _VideoService_PublishVideo0_HTTP_Handler(srv))
+	r.GET("/api/video/drafts", _VideoService_ListDraftVideos0_HTTP_Handler(srv))
 }
 
 func _VideoService_CreateVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
@@ -124,10 +184,325 @@ func _VideoService_GetVideoByTitle0_HTTP_Handler(srv VideoServiceHTTPServer) fun
 	}
 }
 
+func _VideoService_ListVideosByTag0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVideosByTagRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListVideosByTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVideosByTag(ctx, req.(*ListVideosByTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVideosByTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_GetTagInfo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTagInfoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceGetTagInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTagInfo(ctx, req.(*GetTagInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTagInfoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_TrendingTags0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TrendingTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceTrendingTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TrendingTags(ctx, req.(*TrendingTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TrendingTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SearchVideos0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchVideosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSearchVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchVideos(ctx, req.(*SearchVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchVideosReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SuggestQueries0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestQueriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSuggestQueries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestQueries(ctx, req.(*SuggestQueriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestQueriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListSearchHistory0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSearchHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListSearchHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSearchHistory(ctx, req.(*ListSearchHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSearchHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ClearSearchHistory0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClearSearchHistoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceClearSearchHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ClearSearchHistory(ctx, req.(*ClearSearchHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClearSearchHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListHotSearches0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListHotSearchesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListHotSearches)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListHotSearches(ctx, req.(*ListHotSearchesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListHotSearchesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ManageHotSearch0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ManageHotSearchRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceManageHotSearch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ManageHotSearch(ctx, req.(*ManageHotSearchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ManageHotSearchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_UniversalSearch0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UniversalSearchRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceUniversalSearch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UniversalSearch(ctx, req.(*UniversalSearchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UniversalSearchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ReportPlay0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportPlayRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceReportPlay)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportPlay(ctx, req.(*ReportPlayRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportPlayReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ShareVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ShareVideoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceShareVideo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ShareVideo(ctx, req.(*ShareVideoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ShareVideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ResolveShare0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveShareRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceResolveShare)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveShare(ctx, req.(*ResolveShareRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveShareReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_PublishVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishVideoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServicePublishVideo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishVideo(ctx, req.(*PublishVideoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublishVideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListDraftVideos0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDraftVideosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListDraftVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDraftVideos(ctx, req.(*ListDraftVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDraftVideosReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type VideoServiceHTTPClient interface {
+	ClearSearchHistory(ctx context.Context, req *ClearSearchHistoryRequest, opts ...http.CallOption) (rsp *ClearSearchHistoryReply, err error)
 	CreateVideo(ctx context.Context, req *CreateVideoRequest, opts ...http.CallOption) (rsp *CreateVideoReply, err error)
+	GetTagInfo(ctx context.Context, req *GetTagInfoRequest, opts ...http.CallOption) (rsp *GetTagInfoReply, err error)
 	GetVideoByTitle(ctx context.Context, req *GetVideoByTitleRequest, opts ...http.CallOption) (rsp *GetVideoByTitleReply, err error)
+	ListDraftVideos(ctx context.Context, req *ListDraftVideosRequest, opts ...http.CallOption) (rsp *ListDraftVideosReply, err error)
+	ListHotSearches(ctx context.Context, req *ListHotSearchesRequest, opts ...http.CallOption) (rsp *ListHotSearchesReply, err error)
+	ListSearchHistory(ctx context.Context, req *ListSearchHistoryRequest, opts ...http.CallOption) (rsp *ListSearchHistoryReply, err error)
 	ListUserVideos(ctx context.Context, req *ListUserVideosRequest, opts ...http.CallOption) (rsp *ListUserVideosReply, err error)
+	ListVideosByTag(ctx context.Context, req *ListVideosByTagRequest, opts ...http.CallOption) (rsp *ListVideosByTagReply, err error)
+	ManageHotSearch(ctx context.Context, req *ManageHotSearchRequest, opts ...http.CallOption) (rsp *ManageHotSearchReply, err error)
+	PublishVideo(ctx context.Context, req *PublishVideoRequest, opts ...http.CallOption) (rsp *PublishVideoReply, err error)
+	ReportPlay(ctx context.Context, req *ReportPlayRequest, opts ...http.CallOption) (rsp *ReportPlayReply, err error)
+	ResolveShare(ctx context.Context, req *ResolveShareRequest, opts ...http.CallOption) (rsp *ResolveShareReply, err error)
+	SearchVideos(ctx context.Context, req *SearchVideosRequest, opts ...http.CallOption) (rsp *SearchVideosReply, err error)
+	ShareVideo(ctx context.Context, req *ShareVideoRequest, opts ...http.CallOption) (rsp *ShareVideoReply, err error)
+	SuggestQueries(ctx context.Context, req *SuggestQueriesRequest, opts ...http.CallOption) (rsp *SuggestQueriesReply, err error)
+	TrendingTags(ctx context.Context, req *TrendingTagsRequest, opts ...http.CallOption) (rsp *TrendingTagsReply, err error)
+	UniversalSearch(ctx context.Context, req *UniversalSearchRequest, opts ...http.CallOption) (rsp *UniversalSearchReply, err error)
 	UploadVideo(ctx context.Context, req *UploadVideoRequest, opts ...http.CallOption) (rsp *UploadVideoReply, err error)
 }
 
@@ -139,6 +514,19 @@ func NewVideoServiceHTTPClient(client *http.Client) VideoServiceHTTPClient {
 	return &VideoServiceHTTPClientImpl{client}
 }
 
+func (c *VideoServiceHTTPClientImpl) ClearSearchHistory(ctx context.Context, in *ClearSearchHistoryRequest, opts ...http.CallOption) (*ClearSearchHistoryReply, error) {
+	var out ClearSearchHistoryReply
+	pattern := "/api/video/search/history/clear"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceClearSearchHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) CreateVideo(ctx context.Context, in *CreateVideoRequest, opts ...http.CallOption) (*CreateVideoReply, error) {
 	var out CreateVideoReply
 	pattern := "/api/video/create"
@@ -152,6 +540,19 @@ func (c *VideoServiceHTTPClientImpl) CreateVideo(ctx context.Context, in *Create
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) GetTagInfo(ctx context.Context, in *GetTagInfoRequest, opts ...http.CallOption) (*GetTagInfoReply, error) {
+	var out GetTagInfoReply
+	pattern := "/api/video/tag"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceGetTagInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) GetVideoByTitle(ctx context.Context, in *GetVideoByTitleRequest, opts ...http.CallOption) (*GetVideoByTitleReply, error) {
 	var out GetVideoByTitleReply
 	pattern := "/api/video/get/title"
@@ -165,6 +566,45 @@ func (c *VideoServiceHTTPClientImpl) GetVideoByTitle(ctx context.Context, in *Ge
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) ListDraftVideos(ctx context.Context, in *ListDraftVideosRequest, opts ...http.CallOption) (*ListDraftVideosReply, error) {
+	var out ListDraftVideosReply
+	pattern := "/api/video/drafts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListDraftVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ListHotSearches(ctx context.Context, in *ListHotSearchesRequest, opts ...http.CallOption) (*ListHotSearchesReply, error) {
+	var out ListHotSearchesReply
+	pattern := "/api/video/search/hot"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListHotSearches))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ListSearchHistory(ctx context.Context, in *ListSearchHistoryRequest, opts ...http.CallOption) (*ListSearchHistoryReply, error) {
+	var out ListSearchHistoryReply
+	pattern := "/api/video/search/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListSearchHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) ListUserVideos(ctx context.Context, in *ListUserVideosRequest, opts ...http.CallOption) (*ListUserVideosReply, error) {
 	var out ListUserVideosReply
 	pattern := "/api/video"
@@ -178,6 +618,136 @@ func (c *VideoServiceHTTPClientImpl) ListUserVideos(ctx context.Context, in *Lis
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) ListVideosByTag(ctx context.Context, in *ListVideosByTagRequest, opts ...http.CallOption) (*ListVideosByTagReply, error) {
+	var out ListVideosByTagReply
+	pattern := "/api/video/tag/videos"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListVideosByTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ManageHotSearch(ctx context.Context, in *ManageHotSearchRequest, opts ...http.CallOption) (*ManageHotSearchReply, error) {
+	var out ManageHotSearchReply
+	pattern := "/api/video/search/hot/manage"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceManageHotSearch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...http.CallOption) (*PublishVideoReply, error) {
+	var out PublishVideoReply
+	pattern := "/api/video/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServicePublishVideo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ReportPlay(ctx context.Context, in *ReportPlayRequest, opts ...http.CallOption) (*ReportPlayReply, error) {
+	var out ReportPlayReply
+	pattern := "/api/video/play/report"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceReportPlay))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ResolveShare(ctx context.Context, in *ResolveShareRequest, opts ...http.CallOption) (*ResolveShareReply, error) {
+	var out ResolveShareReply
+	pattern := "/api/video/share/resolve"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceResolveShare))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SearchVideos(ctx context.Context, in *SearchVideosRequest, opts ...http.CallOption) (*SearchVideosReply, error) {
+	var out SearchVideosReply
+	pattern := "/api/video/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceSearchVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...http.CallOption) (*ShareVideoReply, error) {
+	var out ShareVideoReply
+	pattern := "/api/video/share"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceShareVideo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SuggestQueries(ctx context.Context, in *SuggestQueriesRequest, opts ...http.CallOption) (*SuggestQueriesReply, error) {
+	var out SuggestQueriesReply
+	pattern := "/api/video/search/suggest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceSuggestQueries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) TrendingTags(ctx context.Context, in *TrendingTagsRequest, opts ...http.CallOption) (*TrendingTagsReply, error) {
+	var out TrendingTagsReply
+	pattern := "/api/video/tag/trending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceTrendingTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) UniversalSearch(ctx context.Context, in *UniversalSearchRequest, opts ...http.CallOption) (*UniversalSearchReply, error) {
+	var out UniversalSearchReply
+	pattern := "/api/video/search/all"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceUniversalSearch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) UploadVideo(ctx context.Context, in *UploadVideoRequest, opts ...http.CallOption) (*UploadVideoReply, error) {
 	var out UploadVideoReply
 	pattern := "/api/video/upload"
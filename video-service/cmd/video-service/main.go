@@ -41,7 +41,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../configs", "config path, eg: -conf config_doc.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, gc *server.BlobGCServer, ve *server.VideoEventServer, reg registry.Registrar) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -51,6 +51,8 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, reg registry.Re
 		kratos.Server(
 			gs,
 			hs,
+			gc,
+			ve,
 		),
 		kratos.Registrar(reg),
 	)
@@ -60,13 +62,15 @@ func newAppWithService(
 	logger log.Logger,
 	gs *grpc.Server,
 	hs *http.Server,
+	gc *server.BlobGCServer,
+	ve *server.VideoEventServer,
 	videoService *service.VideoService,
 	reg registry.Registrar,
 ) (*kratos.App, func(), error) {
 	// 绑定可供 Gin 使用的全局 VideoService
 	service.BindVideoService(videoService)
 
-	app := newApp(logger, gs, hs, reg)
+	app := newApp(logger, gs, hs, gc, ve, reg)
 	cleanup := func() {
 		log.NewHelper(logger).Info("cleanup called")
 	}
@@ -115,7 +119,7 @@ func main() {
 		}
 	}()
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger, bc.Jwt, bc.Data.Minio, bc.IdGen, bc.Registry, bc.Elasticsearch, bc.OpenTelemetry)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, logger, bc.Jwt, bc.IdGen, bc.Registry, bc.Elasticsearch, bc.OpenTelemetry, bc.Search, bc.Share, bc.BlobGc)
 	if err != nil {
 		panic(err)
 	}
@@ -19,6 +19,6 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, log.Logger, *conf.JWT, *conf.Data_MinIO, *conf.IDGen, *conf.Registry, *conf.Elasticsearch, *conf.OpenTelemetry) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, log.Logger, *conf.JWT, *conf.IDGen, *conf.Registry, *conf.Elasticsearch, *conf.OpenTelemetry, *conf.Search, *conf.Share, *conf.BlobGC) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, pkg.ProviderSet, newAppWithService))
 }
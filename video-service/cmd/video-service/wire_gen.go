@@ -24,9 +24,12 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, jwt *conf.JWT, data_MinIO *conf.Data_MinIO, idGen *conf.IDGen, registry *conf.Registry, elasticsearch *conf.Elasticsearch, openTelemetry *conf.OpenTelemetry) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, jwt *conf.JWT, idGen *conf.IDGen, registry *conf.Registry, elasticsearch *conf.Elasticsearch, openTelemetry *conf.OpenTelemetry, search *conf.Search, share *conf.Share, blobGC *conf.BlobGC) (*kratos.App, func(), error) {
 	jwtManager := pkg.NewJWTManagerProvider(jwt)
-	minioUploader := pkg.NewMinioUploaderProvider(data_MinIO)
+	blobStore, err := pkg.NewBlobStore(confData)
+	if err != nil {
+		return nil, nil, err
+	}
 	db, err := data.NewDB(confData)
 	if err != nil {
 		return nil, nil, err
@@ -39,17 +42,32 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, jw
 	if err != nil {
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(confData, logger, jwtManager, minioUploader, db, client, idGenerator, userServiceClient, elasticsearch, typedClient)
+	writer := data.NewPlayWriter(confData)
+	dataData, cleanup, err := data.NewData(confData, logger, jwtManager, blobStore, db, client, idGenerator, userServiceClient, elasticsearch, typedClient, writer)
 	if err != nil {
 		return nil, nil, err
 	}
 	videoRepo := data.NewVideoRepo(dataData, logger)
 	videoUsecase := biz.NewVideoUsecase(videoRepo, logger)
-	videoService := service.NewVideoService(videoUsecase)
+	tagRepo := data.NewTagRepo(dataData, logger)
+	tagUsecase := biz.NewTagUsecase(tagRepo, logger)
+	searchRepo := data.NewSearchRepo(dataData, logger)
+	searchUsecase := biz.NewSearchUsecase(searchRepo, search, logger)
+	playRepo := data.NewPlayRepo(dataData, logger)
+	playUsecase := biz.NewPlayUsecase(playRepo, logger)
+	shareRepo := data.NewShareRepo(dataData, logger)
+	shareUsecase := biz.NewShareUsecase(shareRepo, videoRepo, share, logger)
+	videoService := service.NewVideoService(videoUsecase, tagUsecase, searchUsecase, playUsecase, shareUsecase)
 	grpcServer := server.NewGRPCServer(confServer, videoService, logger)
-	httpServer := server.NewHTTPServer(confServer, videoService, logger)
+	httpServer := server.NewHTTPServer(confServer, videoService, blobStore, logger)
+	blobGCRepo := data.NewBlobGCRepo(dataData, logger)
+	blobGCUsecase := biz.NewBlobGCUsecase(blobGCRepo, blobGC, logger)
+	blobGCServer := server.NewBlobGCServer(blobGC, blobGCUsecase, logger)
+	videoEventRepo := data.NewVideoEventRepo(dataData, logger)
+	videoEventUsecase := biz.NewVideoEventUsecase(videoEventRepo, logger)
+	videoEventServer := server.NewVideoEventServer(confData, videoEventUsecase, logger)
 	registrar := server.NewRegistry(registry)
-	app, cleanup2, err := newAppWithService(logger, grpcServer, httpServer, videoService, registrar)
+	app, cleanup2, err := newAppWithService(logger, grpcServer, httpServer, blobGCServer, videoEventServer, videoService, registrar)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewVideoUsecase)
+var ProviderSet = wire.NewSet(NewVideoUsecase, NewTagUsecase, NewSearchUsecase, NewPlayUsecase, NewShareUsecase, NewBlobGCUsecase, NewVideoEventUsecase)
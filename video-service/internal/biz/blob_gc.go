@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+	"video-service/internal/biz/params"
+	"video-service/internal/conf"
+)
+
+const (
+	// 报告中最多列出的未引用对象数
+	blobGCSampleLimit = 100
+	blobGCBatchSize   = 500
+	blobGCGracePeriod = 24 * time.Hour
+)
+
+// BlobGCRepo 上传对象清理
+type BlobGCRepo interface {
+	// TryLock 多实例部署时只允许一个实例执行清理
+	TryLock(ctx context.Context, ttl time.Duration) (bool, error)
+	ListObjects(ctx context.Context, prefix string, fn func(params.BlobObject) error) error
+	// ReferencedKeys 返回仍被未删除视频的 play_url、cover_url 引用的 key
+	ReferencedKeys(ctx context.Context, keys []string) (map[string]bool, error)
+	DeleteObject(ctx context.Context, key string) error
+}
+
+// BlobGCUsecase is a BlobGC usecase.
+type BlobGCUsecase struct {
+	repo BlobGCRepo
+	conf *conf.BlobGC
+	log  *log.Helper
+}
+
+// NewBlobGCUsecase new a BlobGC usecase.
+func NewBlobGCUsecase(repo BlobGCRepo, c *conf.BlobGC, logger log.Logger) *BlobGCUsecase {
+	return &BlobGCUsecase{repo: repo, conf: c, log: log.NewHelper(logger)}
+}
+
+// Reconcile 扫描配置的前缀，清理超过宽限期且未被视频引用的对象；未拿到锁时返回 nil
+func (uc *BlobGCUsecase) Reconcile(ctx context.Context, lockTTL time.Duration) (*params.BlobGCReport, error) {
+	ok, err := uc.repo.TryLock(ctx, lockTTL)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	grace := blobGCGracePeriod
+	if uc.conf.GetGracePeriod() != nil && uc.conf.GetGracePeriod().AsDuration() > 0 {
+		grace = uc.conf.GetGracePeriod().AsDuration()
+	}
+	batchSize := blobGCBatchSize
+	if uc.conf.GetBatchSize() > 0 {
+		batchSize = int(uc.conf.GetBatchSize())
+	}
+	deadline := time.Now().Add(-grace)
+	report := &params.BlobGCReport{DryRun: uc.conf.GetDryRun()}
+
+	// 1. 按批核对引用，收集未被引用的对象
+	var orphans []string
+	batch := make([]params.BlobObject, 0, batchSize)
+	check := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		found, err := uc.orphans(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for _, obj := range found {
+			report.Orphaned++
+			report.OrphanedBytes += obj.Size
+			if len(report.Samples) < blobGCSampleLimit {
+				report.Samples = append(report.Samples, obj.Key)
+			}
+			orphans = append(orphans, obj.Key)
+		}
+		batch = batch[:0]
+		return nil
+	}
+	for _, prefix := range uc.conf.GetPrefixes() {
+		err := uc.repo.ListObjects(ctx, prefix, func(obj params.BlobObject) error {
+			report.Scanned++
+			if obj.LastModified.After(deadline) {
+				report.Skipped++
+				return nil
+			}
+			batch = append(batch, obj)
+			if len(batch) < batchSize {
+				return nil
+			}
+			return check()
+		})
+		if err != nil {
+			return report, err
+		}
+		if err := check(); err != nil {
+			return report, err
+		}
+	}
+
+	// 2. 删除数量异常时只输出报告，通常是存储地址配置变更导致引用全部匹配不上
+	if !report.DryRun && uc.conf.GetMaxDeletes() > 0 && report.Orphaned > int64(uc.conf.GetMaxDeletes()) {
+		uc.log.WithContext(ctx).Warnf("blob gc found %d orphans, exceeds max_deletes %d, skip deleting", report.Orphaned, uc.conf.GetMaxDeletes())
+		report.DryRun = true
+	}
+	if report.DryRun {
+		return report, nil
+	}
+
+	// 3. 删除前再核对一次，避免扫描期间被新视频引用
+	for start := 0; start < len(orphans); start += batchSize {
+		end := min(start+batchSize, len(orphans))
+		refs, err := uc.repo.ReferencedKeys(ctx, orphans[start:end])
+		if err != nil {
+			return report, err
+		}
+		for _, key := range orphans[start:end] {
+			if refs[key] {
+				continue
+			}
+			if err := uc.repo.DeleteObject(ctx, key); err != nil {
+				uc.log.WithContext(ctx).Errorf("delete blob %s failed: %v", key, err)
+				continue
+			}
+			report.Deleted++
+		}
+	}
+	return report, nil
+}
+
+// orphans 返回批次中未被引用的对象
+func (uc *BlobGCUsecase) orphans(ctx context.Context, batch []params.BlobObject) ([]params.BlobObject, error) {
+	keys := make([]string, 0, len(batch))
+	for _, obj := range batch {
+		keys = append(keys, obj.Key)
+	}
+	refs, err := uc.repo.ReferencedKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]params.BlobObject, 0)
+	for _, obj := range batch {
+		if !refs[obj.Key] {
+			res = append(res, obj)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+)
+
+// normalizeLocation 校验位置并把坐标模糊到网格中心，原始坐标不落库；坐标与城市代码都为空时返回 nil
+func normalizeLocation(loc *params.Location) (*params.Location, error) {
+	if loc == nil {
+		return nil, nil
+	}
+	res := &params.Location{CityCode: strings.TrimSpace(loc.CityCode)}
+	if len(res.CityCode) > consts.CityCodeMaxLen || strings.IndexFunc(res.CityCode, func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsDigit(r) || unicode.IsLetter(r))
+	}) >= 0 {
+		return nil, errors.BadRequest("INVALID_LOCATION", "城市代码不合法")
+	}
+	if loc.HasCoord {
+		if loc.Latitude < -90 || loc.Latitude > 90 || loc.Longitude < -180 || loc.Longitude > 180 {
+			return nil, errors.BadRequest("INVALID_LOCATION", "坐标超出范围")
+		}
+		res.Latitude = fuzzCoord(loc.Latitude, 90)
+		res.Longitude = fuzzCoord(loc.Longitude, 180)
+		res.HasCoord = true
+	}
+	if !res.HasCoord && res.CityCode == "" {
+		return nil, nil
+	}
+	return res, nil
+}
+
+// fuzzCoord 取坐标所在网格的中心，同一网格内的位置无法区分
+func fuzzCoord(v, limit float64) float64 {
+	center := (math.Floor(v/consts.GeoGridDegrees) + 0.5) * consts.GeoGridDegrees
+	// 去掉浮点误差，网格中心最多保留 6 位小数
+	center = math.Round(center*1e6) / 1e6
+	return math.Max(-limit, math.Min(limit, center))
+}
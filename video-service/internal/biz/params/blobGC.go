@@ -0,0 +1,21 @@
+package params
+
+import "time"
+
+// BlobObject 对象存储中的对象
+type BlobObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// BlobGCReport 一次清理的统计
+type BlobGCReport struct {
+	DryRun        bool
+	Scanned       int64    // 扫描的对象数
+	Skipped       int64    // 未过宽限期的对象数
+	Orphaned      int64    // 未被引用的对象数
+	OrphanedBytes int64    // 未被引用的对象大小
+	Deleted       int64    // 实际删除数
+	Samples       []string // 部分未被引用的对象 key
+}
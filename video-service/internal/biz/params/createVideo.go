@@ -1,5 +1,7 @@
 package params
 
+import "time"
+
 type CreateVideoReq struct {
 	Title       string
 	Description string
@@ -7,10 +9,24 @@ type CreateVideoReq struct {
 	CoverUrl    string
 	Duration    float32
 	Tags        string
+	TagList     []string // 规范化后的话题
 	IsPublic    bool
 	IsOriginal  bool
 	SourceUrl   string
 	UserID      int64
+	IsDraft     bool
+	PublishAt   time.Time // 定时发布时间，为零值时立即发布
+	Location    *Location // 用户授权的粗略位置，为空时不记录
+	// 由 usecase 根据 IsDraft、PublishAt 计算
+	PublishStatus int32
+}
+
+// Location 粗略位置，HasCoord 为 false 时只有城市代码
+type Location struct {
+	Latitude  float64
+	Longitude float64
+	HasCoord  bool
+	CityCode  string
 }
 
 type CreateVideoReply struct {
@@ -1,5 +1,7 @@
 package params
 
+import "time"
+
 type ListUserVideosRequest struct {
 	FUserId  int64
 	Page     int32
@@ -27,4 +29,11 @@ type Video struct {
 	CommentCnt  int32
 	ShareCnt    int32
 	CollectCnt  int32
+	ViewCnt     int64
+
+	PublishStatus int32
+	PublishAt     time.Time
+	UpdateTime    time.Time
+	IsPublic      bool
+	Deleted       bool
 }
@@ -0,0 +1,20 @@
+package params
+
+// PlayEvent 播放事件，写入 kafka 后由 job-service 批量汇总
+type PlayEvent struct {
+	VideoID  int64  `json:"video_id"`
+	UserID   int64  `json:"user_id"`
+	DeviceID string `json:"device_id"`
+	WatchMs  int64  `json:"watch_ms"`
+	Finished bool   `json:"finished"`
+	Unique   bool   `json:"unique"` // 是否为当天该视频的新观众，只有新观众计入 view_cnt
+	Ts       int64  `json:"ts"`     // 毫秒时间戳
+}
+
+type ReportPlayRequest struct {
+	VideoID  int64
+	UserID   int64
+	DeviceID string
+	WatchMs  int64
+	Finished bool
+}
@@ -0,0 +1,12 @@
+package params
+
+import "time"
+
+// VideoStats 视频的互动计数
+type VideoStats struct {
+	FavoriteCnt int64
+	CommentCnt  int64
+	ViewCnt     int64
+	ShareCnt    int64
+	UploadTime  time.Time
+}
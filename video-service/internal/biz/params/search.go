@@ -0,0 +1,59 @@
+package params
+
+import (
+	"time"
+	v1 "video-service/api/video/v1"
+)
+
+type SearchVideosRequest struct {
+	Keyword      string
+	MinDuration  float32
+	MaxDuration  float32
+	PublishStart time.Time
+	PublishEnd   time.Time
+	OriginalOnly bool
+	Sort         v1.SearchSort
+	Cursor       string
+	PageSize     int32
+	UserID       int64 // 0 表示未登录
+}
+
+type SearchVideoItem struct {
+	Video      *v1.Video
+	Highlights map[string]string
+}
+
+type SearchVideosReply struct {
+	Items      []*SearchVideoItem
+	NextCursor string
+	HasMore    bool
+}
+
+type Suggestion struct {
+	Text string
+	Type string
+}
+
+type HotSearch struct {
+	Keyword string
+	Score   float64
+	Pinned  bool
+}
+
+type SearchUser struct {
+	Id            int64
+	Name          string
+	Avatar        string
+	Signature     string
+	FollowerCount int32
+	WorkCount     int32
+}
+
+type SearchSection struct {
+	Type    v1.SearchSectionType
+	Score   float64
+	Videos  []*SearchVideoItem
+	Users   []*SearchUser
+	Tags    []*Tag
+	HasMore bool
+}
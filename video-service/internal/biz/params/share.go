@@ -0,0 +1,20 @@
+package params
+
+import "time"
+
+type Share struct {
+	ID        int64
+	Code      string
+	VideoID   int64
+	UserID    int64
+	Channel   int32
+	ClickCnt  int64
+	CreatedAt time.Time
+}
+
+// ShareClick 分享链接点击
+type ShareClick struct {
+	VisitorID int64  // 登录访客的用户id
+	Visitor   string // 访客标识，用于去重
+	Source    string // web、app
+}
@@ -0,0 +1,21 @@
+package params
+
+type Tag struct {
+	Id         int64
+	Name       string
+	VideoCount int64
+	ViewCount  int64
+	Score      float64
+}
+
+type ListVideosByTagRequest struct {
+	Tag      string
+	Page     int32
+	PageSize int32
+}
+
+type ListVideosByTagReply struct {
+	Tag    *Tag
+	Videos []*Video
+	Total  int32
+}
@@ -0,0 +1,13 @@
+package params
+
+import "time"
+
+// VideoEvent 点赞、评论等影响视频计数的事件
+type VideoEvent struct {
+	EventID    string
+	Type       string
+	OccurredAt time.Time
+	VideoID    int64
+	UserID     int64
+	CommentID  int64
+}
@@ -0,0 +1,78 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+)
+
+// PlayRepo 播放统计仓储
+type PlayRepo interface {
+	CheckVideoPlayable(ctx context.Context, videoID int64) (bool, error)
+	AddDailyViewer(ctx context.Context, videoID int64, viewer string, day time.Time) (bool, error)
+	SendPlayEvent(ctx context.Context, event *params.PlayEvent) error
+}
+
+// PlayUsecase is a Play usecase.
+type PlayUsecase struct {
+	repo PlayRepo
+	log  *log.Helper
+}
+
+// NewPlayUsecase new a Play usecase.
+func NewPlayUsecase(repo PlayRepo, logger log.Logger) *PlayUsecase {
+	return &PlayUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// ReportPlay 上报播放，返回是否为当天该视频的新观众
+func (uc *PlayUsecase) ReportPlay(ctx context.Context, p params.ReportPlayRequest) (bool, error) {
+	// 登录用户按用户id去重，未登录用户按设备id去重
+	viewer := fmt.Sprintf("u:%d", p.UserID)
+	if p.UserID == 0 {
+		if p.DeviceID == "" {
+			return false, errors.BadRequest("INVALID_VIEWER", "未登录时 device_id 不能为空")
+		}
+		viewer = "d:" + p.DeviceID
+	}
+	if p.WatchMs < 0 {
+		p.WatchMs = 0
+	}
+	if p.WatchMs > consts.PlayMaxWatchMs {
+		p.WatchMs = consts.PlayMaxWatchMs
+	}
+
+	ok, err := uc.repo.CheckVideoPlayable(ctx, p.VideoID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("check video playable error: %v", err)
+		return false, errors.InternalServer("QUERY_ERROR", err.Error())
+	}
+	if !ok {
+		return false, errors.NotFound("VIDEO_NOT_FOUND", "视频不存在")
+	}
+
+	now := time.Now()
+	unique, err := uc.repo.AddDailyViewer(ctx, p.VideoID, viewer, now)
+	if err != nil {
+		// 去重失败时不计入播放数，但仍然记录观看时长
+		uc.log.WithContext(ctx).Errorf("add daily viewer error: %v", err)
+	}
+
+	event := &params.PlayEvent{
+		VideoID:  p.VideoID,
+		UserID:   p.UserID,
+		DeviceID: p.DeviceID,
+		WatchMs:  p.WatchMs,
+		Finished: p.Finished,
+		Unique:   unique,
+		Ts:       now.UnixMilli(),
+	}
+	if err := uc.repo.SendPlayEvent(ctx, event); err != nil {
+		uc.log.WithContext(ctx).Errorf("send play event error: %v", err)
+		return false, errors.InternalServer("REPORT_PLAY_FAILED", err.Error())
+	}
+	return unique, nil
+}
@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+	v1 "video-service/api/video/v1"
+	"video-service/internal/biz/params"
+	"video-service/internal/conf"
+	"video-service/internal/pkg/consts"
+	"video-service/internal/pkg/hashtag"
+)
+
+// universalSearchTimeout 综合搜索单个分组的超时时间，超时的分组不返回
+const universalSearchTimeout = 800 * time.Millisecond
+
+// ErrInvalidCursor 分页游标不合法
+var ErrInvalidCursor = errors.BadRequest("INVALID_CURSOR", "cursor 不合法")
+
+// SearchRepo 搜索仓储
+type SearchRepo interface {
+	SearchVideos(ctx context.Context, p *params.SearchVideosRequest) (*params.SearchVideosReply, error)
+	SuggestQueries(ctx context.Context, prefix string, limit int) ([]*params.Suggestion, error)
+	RecordSearch(ctx context.Context, userID int64, keyword string) error
+	ListSearchHistory(ctx context.Context, userID int64) ([]string, error)
+	ClearSearchHistory(ctx context.Context, userID int64, keyword string) error
+	ListHotSearches(ctx context.Context, limit int64) ([]*params.HotSearch, error)
+	PinHotSearch(ctx context.Context, keyword string, position int32) error
+	UnpinHotSearch(ctx context.Context, keyword string) error
+	BlockHotSearch(ctx context.Context, keyword string) error
+	UnblockHotSearch(ctx context.Context, keyword string) error
+	SearchUsers(ctx context.Context, keyword string, size int32) ([]*params.SearchUser, bool, error)
+	SearchTags(ctx context.Context, keyword string, size int32) ([]*params.Tag, bool, error)
+}
+
+// SearchUsecase is a Search usecase.
+type SearchUsecase struct {
+	repo   SearchRepo
+	admins map[int64]struct{}
+	log    *log.Helper
+}
+
+// NewSearchUsecase new a Search usecase.
+func NewSearchUsecase(repo SearchRepo, c *conf.Search, logger log.Logger) *SearchUsecase {
+	admins := make(map[int64]struct{}, len(c.GetAdminIds()))
+	for _, id := range c.GetAdminIds() {
+		admins[id] = struct{}{}
+	}
+	return &SearchUsecase{repo: repo, admins: admins, log: log.NewHelper(logger)}
+}
+
+// NormalizeSearchTerm 规范化搜索词：去除首尾空白、合并连续空白、转小写并截断
+func NormalizeSearchTerm(s string) string {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if utf8.RuneCountInString(s) > consts.SearchTermMaxLen {
+		s = string([]rune(s)[:consts.SearchTermMaxLen])
+	}
+	return s
+}
+
+// SearchVideos 搜索视频
+func (uc *SearchUsecase) SearchVideos(ctx context.Context, p params.SearchVideosRequest) (*params.SearchVideosReply, error) {
+	if p.MaxDuration > 0 && p.MinDuration > p.MaxDuration {
+		return nil, errors.BadRequest("INVALID_DURATION", "时长范围不合法")
+	}
+	if !p.PublishStart.IsZero() && !p.PublishEnd.IsZero() && p.PublishStart.After(p.PublishEnd) {
+		return nil, errors.BadRequest("INVALID_PUBLISH_TIME", "发布时间范围不合法")
+	}
+
+	res, err := uc.repo.SearchVideos(ctx, &p)
+	if err != nil {
+		if errors.IsBadRequest(err) {
+			return nil, err
+		}
+		uc.log.WithContext(ctx).Errorf("search videos error: %v", err)
+		return nil, errors.InternalServer("SEARCH_VIDEOS_FAILED", err.Error())
+	}
+
+	// 只在首页记录搜索历史和热搜，翻页不重复计数
+	if p.Cursor == "" {
+		if err := uc.repo.RecordSearch(ctx, p.UserID, NormalizeSearchTerm(p.Keyword)); err != nil {
+			uc.log.WithContext(ctx).Errorf("record search error: %v", err)
+		}
+	}
+	return res, nil
+}
+
+// SuggestQueries 搜索建议
+func (uc *SearchUsecase) SuggestQueries(ctx context.Context, prefix string, limit int) ([]*params.Suggestion, error) {
+	prefix = NormalizeSearchTerm(prefix)
+	if prefix == "" {
+		return []*params.Suggestion{}, nil
+	}
+	res, err := uc.repo.SuggestQueries(ctx, prefix, limit)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("suggest queries error: %v", err)
+		return nil, errors.InternalServer("SUGGEST_QUERIES_FAILED", err.Error())
+	}
+	return res, nil
+}
+
+// ListSearchHistory 获取搜索历史
+func (uc *SearchUsecase) ListSearchHistory(ctx context.Context, userID int64) ([]string, error) {
+	res, err := uc.repo.ListSearchHistory(ctx, userID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list search history error: %v", err)
+		return nil, errors.InternalServer("LIST_SEARCH_HISTORY_FAILED", err.Error())
+	}
+	return res, nil
+}
+
+// ClearSearchHistory 清除搜索历史，keyword 为空时清除全部
+func (uc *SearchUsecase) ClearSearchHistory(ctx context.Context, userID int64, keyword string) error {
+	if err := uc.repo.ClearSearchHistory(ctx, userID, NormalizeSearchTerm(keyword)); err != nil {
+		uc.log.WithContext(ctx).Errorf("clear search history error: %v", err)
+		return errors.InternalServer("CLEAR_SEARCH_HISTORY_FAILED", err.Error())
+	}
+	return nil
+}
+
+// ListHotSearches 热搜榜，置顶词在前，屏蔽词不展示
+func (uc *SearchUsecase) ListHotSearches(ctx context.Context, limit int64) ([]*params.HotSearch, error) {
+	res, err := uc.repo.ListHotSearches(ctx, limit)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list hot searches error: %v", err)
+		return nil, errors.InternalServer("LIST_HOT_SEARCHES_FAILED", err.Error())
+	}
+	return res, nil
+}
+
+// ManageHotSearch 置顶、屏蔽热搜词
+func (uc *SearchUsecase) ManageHotSearch(ctx context.Context, userID int64, keyword string, action v1.HotSearchAction, position int32) error {
+	if _, ok := uc.admins[userID]; !ok {
+		return errors.Forbidden("PERMISSION_DENIED", "无权限管理热搜")
+	}
+	keyword = NormalizeSearchTerm(keyword)
+	if keyword == "" {
+		return errors.BadRequest("INVALID_KEYWORD", "keyword 不能为空")
+	}
+
+	var err error
+	switch action {
+	case v1.HotSearchAction_HOT_SEARCH_ACTION_PIN:
+		if position <= 0 {
+			return errors.BadRequest("INVALID_POSITION", "置顶位置不合法")
+		}
+		err = uc.repo.PinHotSearch(ctx, keyword, position)
+	case v1.HotSearchAction_HOT_SEARCH_ACTION_UNPIN:
+		err = uc.repo.UnpinHotSearch(ctx, keyword)
+	case v1.HotSearchAction_HOT_SEARCH_ACTION_BLOCK:
+		err = uc.repo.BlockHotSearch(ctx, keyword)
+	case v1.HotSearchAction_HOT_SEARCH_ACTION_UNBLOCK:
+		err = uc.repo.UnblockHotSearch(ctx, keyword)
+	default:
+		return errors.BadRequest("INVALID_ACTION", "不支持的操作")
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("manage hot search error: %v", err)
+		return errors.InternalServer("MANAGE_HOT_SEARCH_FAILED", err.Error())
+	}
+	uc.log.WithContext(ctx).Infof("user %d %s hot search %q", userID, action, keyword)
+	return nil
+}
+
+// UniversalSearch 综合搜索，并发搜索视频、用户、话题，单个分组失败不影响其他分组
+func (uc *SearchUsecase) UniversalSearch(ctx context.Context, keyword string, size int32, userID int64) ([]*params.SearchSection, error) {
+	term := NormalizeSearchTerm(keyword)
+	if term == "" {
+		return nil, errors.BadRequest("INVALID_KEYWORD", "keyword 不能为空")
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		sections = make([]*params.SearchSection, 0, 3)
+	)
+	add := func(section *params.SearchSection) {
+		mu.Lock()
+		defer mu.Unlock()
+		sections = append(sections, section)
+	}
+	run := func(name string, fn func(ctx context.Context) (*params.SearchSection, error)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, universalSearchTimeout)
+			defer cancel()
+			section, err := fn(ctx)
+			if err != nil {
+				uc.log.WithContext(ctx).Errorf("universal search %s error: %v", name, err)
+				return
+			}
+			if section != nil {
+				add(section)
+			}
+		}()
+	}
+
+	run("videos", func(ctx context.Context) (*params.SearchSection, error) {
+		res, err := uc.repo.SearchVideos(ctx, &params.SearchVideosRequest{Keyword: keyword, PageSize: size})
+		if err != nil || len(res.Items) == 0 {
+			return nil, err
+		}
+		// 视频是主要内容，默认排在最前
+		return &params.SearchSection{Type: v1.SearchSectionType_SEARCH_SECTION_VIDEO, Score: 2, Videos: res.Items, HasMore: res.HasMore}, nil
+	})
+	run("users", func(ctx context.Context) (*params.SearchSection, error) {
+		users, hasMore, err := uc.repo.SearchUsers(ctx, keyword, size)
+		if err != nil || len(users) == 0 {
+			return nil, err
+		}
+		score := 1.0
+		// 精确命中用户名时优先展示用户
+		if strings.ToLower(users[0].Name) == term {
+			score += 2
+		}
+		return &params.SearchSection{Type: v1.SearchSectionType_SEARCH_SECTION_USER, Score: score, Users: users, HasMore: hasMore}, nil
+	})
+	run("tags", func(ctx context.Context) (*params.SearchSection, error) {
+		tag := hashtag.Normalize(keyword)
+		if tag == "" {
+			return nil, nil
+		}
+		tags, hasMore, err := uc.repo.SearchTags(ctx, tag, size)
+		if err != nil || len(tags) == 0 {
+			return nil, err
+		}
+		score := 1.0
+		// 精确命中话题时优先展示话题
+		if tags[0].Name == tag {
+			score += 1.5
+		}
+		return &params.SearchSection{Type: v1.SearchSectionType_SEARCH_SECTION_TAG, Score: score, Tags: tags, HasMore: hasMore}, nil
+	})
+	wg.Wait()
+
+	sort.SliceStable(sections, func(i, j int) bool {
+		if sections[i].Score != sections[j].Score {
+			return sections[i].Score > sections[j].Score
+		}
+		return sections[i].Type < sections[j].Type
+	})
+
+	if err := uc.repo.RecordSearch(ctx, userID, term); err != nil {
+		uc.log.WithContext(ctx).Errorf("record search error: %v", err)
+	}
+	return sections, nil
+}
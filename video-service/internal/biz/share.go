@@ -0,0 +1,78 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"video-service/internal/biz/params"
+	"video-service/internal/conf"
+)
+
+// ShareRepo 分享仓储
+type ShareRepo interface {
+	// GetOrCreateShare 同一用户在同一渠道分享同一视频复用分享码，created 表示是否新生成
+	GetOrCreateShare(ctx context.Context, videoID, userID int64, channel int32) (share *params.Share, created bool, err error)
+	GetShareByCode(ctx context.Context, code string) (*params.Share, error)
+	// RecordShareClick 记录点击，同一访客在去重窗口内只记录一次
+	RecordShareClick(ctx context.Context, share *params.Share, click params.ShareClick) error
+}
+
+// ShareUsecase is a Share usecase.
+type ShareUsecase struct {
+	repo  ShareRepo
+	vrepo VideoRepo
+	conf  *conf.Share
+	log   *log.Helper
+}
+
+// NewShareUsecase new a Share usecase.
+func NewShareUsecase(repo ShareRepo, vrepo VideoRepo, c *conf.Share, logger log.Logger) *ShareUsecase {
+	return &ShareUsecase{repo: repo, vrepo: vrepo, conf: c, log: log.NewHelper(logger)}
+}
+
+// ShareVideo 分享视频，返回分享码与短链
+func (uc *ShareUsecase) ShareVideo(ctx context.Context, userID, videoID int64, channel int32) (*params.Share, string, error) {
+	exist, err := uc.vrepo.CheckVideoExistsByID(ctx, videoID)
+	if err != nil {
+		return nil, "", errors.InternalServer("QUERY_ERROR", err.Error())
+	}
+	if !exist {
+		return nil, "", errors.NotFound("VIDEO_NOT_FOUND", "视频不存在")
+	}
+
+	share, created, err := uc.repo.GetOrCreateShare(ctx, videoID, userID, channel)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("create share error: %v", err)
+		return nil, "", errors.InternalServer("SHARE_VIDEO_FAILED", err.Error())
+	}
+	uc.log.WithContext(ctx).Infof("ShareVideo: user_id=%d video_id=%d channel=%d code=%s created=%v", userID, videoID, channel, share.Code, created)
+	return share, uc.conf.GetBaseUrl() + share.Code, nil
+}
+
+// ResolveShare 解析分享码并记录点击
+func (uc *ShareUsecase) ResolveShare(ctx context.Context, code string, click params.ShareClick) (*params.Share, error) {
+	share, err := uc.repo.GetShareByCode(ctx, code)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("get share error: %v", err)
+		return nil, errors.InternalServer("QUERY_ERROR", err.Error())
+	}
+	if share == nil {
+		return nil, errors.NotFound("SHARE_NOT_FOUND", "分享链接不存在")
+	}
+
+	// 分享者自己点击不计入
+	if click.VisitorID != 0 && click.VisitorID == share.UserID {
+		return share, nil
+	}
+	// 点击记录失败不影响跳转
+	if err := uc.repo.RecordShareClick(ctx, share, click); err != nil {
+		uc.log.WithContext(ctx).Errorf("record share click error: %v", err)
+	}
+	return share, nil
+}
+
+// LandingURL 分享短链跳转的落地页
+func (uc *ShareUsecase) LandingURL(share *params.Share) string {
+	return fmt.Sprintf(uc.conf.GetLandingUrl(), share.VideoID, share.Code)
+}
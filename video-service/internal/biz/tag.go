@@ -0,0 +1,68 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/hashtag"
+)
+
+// TagRepo 话题仓储
+type TagRepo interface {
+	GetTagByName(ctx context.Context, name string) (*params.Tag, error)
+	ListVideosByTag(ctx context.Context, tagID int64, page, pageSize int32) ([]*params.Video, int32, error)
+	TrendingTags(ctx context.Context, limit int64) ([]*params.Tag, error)
+}
+
+// TagUsecase is a Tag usecase.
+type TagUsecase struct {
+	repo TagRepo
+	log  *log.Helper
+}
+
+// NewTagUsecase new a Tag usecase.
+func NewTagUsecase(repo TagRepo, logger log.Logger) *TagUsecase {
+	return &TagUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// GetTagInfo 获取话题信息
+func (uc *TagUsecase) GetTagInfo(ctx context.Context, name string) (*params.Tag, error) {
+	name = hashtag.Normalize(name)
+	if name == "" {
+		return nil, errors.BadRequest("INVALID_TAG", "话题不合法")
+	}
+	tag, err := uc.repo.GetTagByName(ctx, name)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("get tag error: %v", err)
+		return nil, errors.InternalServer("GET_TAG_FAILED", err.Error())
+	}
+	if tag == nil {
+		return nil, errors.NotFound("TAG_NOT_FOUND", "话题不存在")
+	}
+	return tag, nil
+}
+
+// ListVideosByTag 根据话题获取视频列表
+func (uc *TagUsecase) ListVideosByTag(ctx context.Context, p params.ListVideosByTagRequest) (params.ListVideosByTagReply, error) {
+	tag, err := uc.GetTagInfo(ctx, p.Tag)
+	if err != nil {
+		return params.ListVideosByTagReply{}, err
+	}
+	videos, total, err := uc.repo.ListVideosByTag(ctx, tag.Id, p.Page, p.PageSize)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list videos by tag error: %v", err)
+		return params.ListVideosByTagReply{}, errors.InternalServer("LIST_TAG_VIDEOS_FAILED", err.Error())
+	}
+	return params.ListVideosByTagReply{Tag: tag, Videos: videos, Total: total}, nil
+}
+
+// TrendingTags 热门话题
+func (uc *TagUsecase) TrendingTags(ctx context.Context, limit int64) ([]*params.Tag, error) {
+	tags, err := uc.repo.TrendingTags(ctx, limit)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("trending tags error: %v", err)
+		return nil, errors.InternalServer("TRENDING_TAGS_FAILED", err.Error())
+	}
+	return tags, nil
+}
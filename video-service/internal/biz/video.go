@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"google.golang.org/protobuf/types/known/timestamppb"
 	"io"
 	"time"
 	pbUser "video-service/api/user/v1"
 	v1 "video-service/api/video/v1"
 	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+	"video-service/internal/pkg/hashtag"
 )
 
 // GreeterRepo is a Greater repo.
@@ -24,9 +25,12 @@ type VideoRepo interface {
 	CheckUserExistByUserID(context.Context, int64) (*pbUser.CheckUserExistByUserIDReply, error)
 	BatchGetVideoInfo(context.Context, []int64, int64, int64) ([]*v1.Video, error)
 	CheckVideoExistsByID(ctx context.Context, videoID int64) (bool, error)
-	CalcVideoScore(ctx context.Context, count int64, count2 int64, time *timestamppb.Timestamp) float64
-	GetVideoFavoriteAndCommentCount(ctx context.Context, videoID int64) (int64, int64, time.Time, error)
+	GetVideoFavoriteAndCommentCount(ctx context.Context, videoID int64) (*params.VideoStats, error)
 	GetVideoByTitle(ctx context.Context, title string) ([]*v1.Video, error)
+	GetVideoByID(ctx context.Context, videoID int64) (*params.Video, error)
+	PublishVideo(ctx context.Context, videoID int64) (bool, error)
+	ScheduleVideo(ctx context.Context, videoID int64, publishAt time.Time) error
+	ListDraftVideos(ctx context.Context, userID int64, page, pageSize int32) ([]*params.Video, int32, error)
 }
 
 // VideoUsecase is a Video usecase.
@@ -74,6 +78,19 @@ func (uc *VideoUsecase) CreateVideo(ctx context.Context, params params.CreateVid
 		return 0, errors.BadRequest("VIDEO_ALREADY_EXIST", "video already exists")
 	}
 	// TODO 敏感词汇检测过滤
+	// 解析标题、描述中的 #话题 以及手填的 tags
+	params.TagList = hashtag.Parse(params.Title, params.Description, params.Tags)
+	params.Tags = hashtag.Join(params.TagList)
+	// 草稿、定时发布
+	status, publishAt, err := resolvePublish(params.IsDraft, params.PublishAt)
+	if err != nil {
+		return 0, err
+	}
+	params.PublishStatus, params.PublishAt = status, publishAt
+	// 位置只保存模糊后的坐标
+	if params.Location, err = normalizeLocation(params.Location); err != nil {
+		return 0, err
+	}
 	// 2. 雪花算法生成videoID
 	// 3. 上传视频信息
 	videoID, err := uc.repo.CreateVideo(ctx, &params)
@@ -117,16 +134,84 @@ func (uc *VideoUsecase) BatchGetVideoInfo(ctx context.Context, ids []int64, page
 	return uc.repo.BatchGetVideoInfo(ctx, ids, page, pageSize)
 }
 
-func (uc *VideoUsecase) CheckVideoExistsByID(ctx context.Context, videoID int64) (bool, error) {
-	return uc.repo.CheckVideoExistsByID(ctx, videoID)
+// CheckVideoVisible 视频是否存在，以及查看者能否看到：已发布、公开且未删除，或查看者是作者
+func (uc *VideoUsecase) CheckVideoVisible(ctx context.Context, videoID, viewerID int64) (bool, bool, error) {
+	video, err := uc.repo.GetVideoByID(ctx, videoID)
+	if err != nil || video == nil {
+		return false, false, err
+	}
+	if viewerID != 0 && video.UserId == viewerID {
+		return true, true, nil
+	}
+	return true, video.PublishStatus == consts.PublishStatusPublished && video.IsPublic && !video.Deleted, nil
 }
 
-func (uc *VideoUsecase) CalcVideoScore(ctx context.Context, count int64, count2 int64, time *timestamppb.Timestamp) float64 {
-	return uc.repo.CalcVideoScore(ctx, count, count2, time)
+func (uc *VideoUsecase) GetVideoFavoriteAndCommentCount(ctx context.Context, videoID int64) (*params.VideoStats, error) {
+	return uc.repo.GetVideoFavoriteAndCommentCount(ctx, videoID)
 }
 
-func (uc *VideoUsecase) GetVideoFavoriteAndCommentCount(ctx context.Context, videoID int64) (int64, int64, time.Time, error) {
-	return uc.repo.GetVideoFavoriteAndCommentCount(ctx, videoID)
+// PublishVideo 发布草稿，publishAt 为零值时立即发布，否则定时发布
+func (uc *VideoUsecase) PublishVideo(ctx context.Context, userID, videoID int64, publishAt time.Time) (int32, time.Time, error) {
+	video, err := uc.repo.GetVideoByID(ctx, videoID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("get video error: %v", err)
+		return 0, time.Time{}, errors.InternalServer("QUERY_ERROR", err.Error())
+	}
+	if video == nil {
+		return 0, time.Time{}, errors.NotFound("VIDEO_NOT_FOUND", "视频不存在")
+	}
+	if video.UserId != userID {
+		return 0, time.Time{}, errors.Forbidden("FORBIDDEN", "不能发布他人的视频")
+	}
+	if video.PublishStatus == consts.PublishStatusPublished {
+		return 0, time.Time{}, errors.BadRequest("VIDEO_ALREADY_PUBLISHED", "视频已发布")
+	}
+
+	status, publishAt, err := resolvePublish(false, publishAt)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if status == consts.PublishStatusScheduled {
+		if err := uc.repo.ScheduleVideo(ctx, videoID, publishAt); err != nil {
+			uc.log.WithContext(ctx).Errorf("schedule video error: %v", err)
+			return 0, time.Time{}, errors.InternalServer("SCHEDULE_VIDEO_FAILED", err.Error())
+		}
+		return status, publishAt, nil
+	}
+
+	if _, err := uc.repo.PublishVideo(ctx, videoID); err != nil {
+		uc.log.WithContext(ctx).Errorf("publish video error: %v", err)
+		return 0, time.Time{}, errors.InternalServer("PUBLISH_VIDEO_FAILED", err.Error())
+	}
+	return status, publishAt, nil
+}
+
+// ListDraftVideos 获取用户的草稿与定时发布视频
+func (uc *VideoUsecase) ListDraftVideos(ctx context.Context, userID int64, page, pageSize int32) ([]*params.Video, int32, error) {
+	videos, total, err := uc.repo.ListDraftVideos(ctx, userID, page, pageSize)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list draft videos error: %v", err)
+		return nil, 0, errors.InternalServer("LIST_DRAFT_VIDEOS_FAILED", err.Error())
+	}
+	return videos, total, nil
+}
+
+// resolvePublish 根据是否草稿与定时发布时间计算发布状态
+func resolvePublish(isDraft bool, publishAt time.Time) (int32, time.Time, error) {
+	now := time.Now()
+	switch {
+	case isDraft:
+		return consts.PublishStatusDraft, time.Time{}, nil
+	case publishAt.IsZero() || publishAt.Before(now.Add(consts.ScheduleMinLead)):
+		if !publishAt.IsZero() && publishAt.Before(now.Add(-consts.ScheduleMinLead)) {
+			return 0, time.Time{}, errors.BadRequest("INVALID_PUBLISH_AT", "定时发布时间不能早于当前时间")
+		}
+		return consts.PublishStatusPublished, now, nil
+	case publishAt.After(now.Add(consts.ScheduleMaxAhead)):
+		return 0, time.Time{}, errors.BadRequest("INVALID_PUBLISH_AT", "定时发布时间超出可设置范围")
+	default:
+		return consts.PublishStatusScheduled, publishAt, nil
+	}
 }
 
 func (uc *VideoUsecase) GetVideoByTitle(ctx context.Context, title string) ([]*v1.Video, error) {
@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+)
+
+// VideoEventRepo 事件驱动的视频计数
+type VideoEventRepo interface {
+	// ApplyEvent 在同一事务中记录事件并更新计数，事件已处理过时返回 false
+	ApplyEvent(ctx context.Context, event *params.VideoEvent) (bool, error)
+	// MarkScoreDirty 标记视频分数待 job-service 重算
+	MarkScoreDirty(ctx context.Context, videoID int64) error
+	// ApplyPublished 执行定时视频发布后的副作用，trending 为 false 时不累加话题热度
+	ApplyPublished(ctx context.Context, videoID int64, trending bool) error
+	// DeleteProcessedEvents 删除 before 之前处理的一批事件，返回删除的数量
+	DeleteProcessedEvents(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+const (
+	// DefaultProcessedEventRetention 已处理事件默认保留时间，kafka 默认保留 7 天
+	DefaultProcessedEventRetention = 14 * 24 * time.Hour
+	// ProcessedEventCleanupBatchSize 每批删除的事件数，避免长时间锁表
+	ProcessedEventCleanupBatchSize = 1000
+)
+
+// VideoEventUsecase is a VideoEvent usecase.
+type VideoEventUsecase struct {
+	repo VideoEventRepo
+	log  *log.Helper
+}
+
+// NewVideoEventUsecase new a VideoEvent usecase.
+func NewVideoEventUsecase(repo VideoEventRepo, logger log.Logger) *VideoEventUsecase {
+	return &VideoEventUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// HandleEvent 处理点赞、收藏、评论及定时发布事件，重复投递的事件只生效一次
+func (uc *VideoEventUsecase) HandleEvent(ctx context.Context, event *params.VideoEvent) error {
+	switch event.Type {
+	case consts.EventVideoLiked, consts.EventVideoUnliked,
+		consts.EventVideoCollected, consts.EventVideoUncollected,
+		consts.EventCommentCreated, consts.EventCommentDeleted,
+		consts.EventVideoPublished:
+	default:
+		uc.log.WithContext(ctx).Warnf("ignore unknown video event: id=%s type=%s", event.EventID, event.Type)
+		return nil
+	}
+	if event.EventID == "" || event.VideoID == 0 {
+		uc.log.WithContext(ctx).Warnf("ignore invalid video event: %+v", event)
+		return nil
+	}
+
+	applied, err := uc.repo.ApplyEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		uc.log.WithContext(ctx).Infof("skip duplicate video event: id=%s type=%s", event.EventID, event.Type)
+	}
+
+	if event.Type == consts.EventVideoPublished {
+		// 位置索引、分数标记可重复执行，失败时重试；话题热度只在首次处理时累加
+		return uc.repo.ApplyPublished(ctx, event.VideoID, applied)
+	}
+
+	// 重复事件也标记一次，避免上次提交后标记失败导致分数不更新
+	if err := uc.repo.MarkScoreDirty(ctx, event.VideoID); err != nil {
+		uc.log.WithContext(ctx).Errorf("mark video %d score dirty failed: %v", event.VideoID, err)
+	}
+	return nil
+}
+
+// CleanupProcessedEvents 分批删除超过保留时间的已处理事件，保留时间内重复投递的事件仍能去重
+func (uc *VideoEventUsecase) CleanupProcessedEvents(ctx context.Context, retention time.Duration) {
+	before := time.Now().Add(-retention)
+	var total int64
+	for {
+		n, err := uc.repo.DeleteProcessedEvents(ctx, before, ProcessedEventCleanupBatchSize)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("delete processed events failed: %v", err)
+			return
+		}
+		total += n
+		if n < ProcessedEventCleanupBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		uc.log.WithContext(ctx).Infof("processed events cleanup deleted %d events before %s", total, before.Format(time.DateTime))
+	}
+}
@@ -32,6 +32,9 @@ type Bootstrap struct {
 	Service       *Service               `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
 	Elasticsearch *Elasticsearch         `protobuf:"bytes,7,opt,name=elasticsearch,proto3" json:"elasticsearch,omitempty"`
 	OpenTelemetry *OpenTelemetry         `protobuf:"bytes,8,opt,name=open_telemetry,json=openTelemetry,proto3" json:"open_telemetry,omitempty"`
+	Search        *Search                `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
+	Share         *Share                 `protobuf:"bytes,10,opt,name=share,proto3" json:"share,omitempty"`
+	BlobGc        *BlobGC                `protobuf:"bytes,11,opt,name=blob_gc,json=blobGc,proto3" json:"blob_gc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,6 +125,27 @@ func (x *Bootstrap) GetOpenTelemetry() *OpenTelemetry {
 	return nil
 }
 
+func (x *Bootstrap) GetSearch() *Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetShare() *Share {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetBlobGc() *BlobGC {
+	if x != nil {
+		return x.BlobGc
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -188,6 +212,8 @@ type Data struct {
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
 	Minio         *Data_MinIO            `protobuf:"bytes,3,opt,name=minio,proto3" json:"minio,omitempty"`
 	UserService   *Data_UserService      `protobuf:"bytes,4,opt,name=user_service,json=userService,proto3" json:"user_service,omitempty"`
+	Kafka         *Data_Kafka            `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Storage       *Data_Storage          `protobuf:"bytes,6,opt,name=storage,proto3" json:"storage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -250,6 +276,20 @@ func (x *Data) GetUserService() *Data_UserService {
 	return nil
 }
 
+func (x *Data) GetKafka() *Data_Kafka {
+	if x != nil {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (x *Data) GetStorage() *Data_Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
 type JWT struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -470,6 +510,8 @@ type Elasticsearch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	Index         string                 `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	UserIndex     string                 `protobuf:"bytes,3,opt,name=user_index,json=userIndex,proto3" json:"user_index,omitempty"`          // 用户索引，搜索作者名时使用
+	SuggestIndex  string                 `protobuf:"bytes,4,opt,name=suggest_index,json=suggestIndex,proto3" json:"suggest_index,omitempty"` // 搜索建议索引，由 job-service 写入
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -518,6 +560,209 @@ func (x *Elasticsearch) GetIndex() string {
 	return ""
 }
 
+func (x *Elasticsearch) GetUserIndex() string {
+	if x != nil {
+		return x.UserIndex
+	}
+	return ""
+}
+
+func (x *Elasticsearch) GetSuggestIndex() string {
+	if x != nil {
+		return x.SuggestIndex
+	}
+	return ""
+}
+
+type Search struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminIds      []int64                `protobuf:"varint,1,rep,packed,name=admin_ids,json=adminIds,proto3" json:"admin_ids,omitempty"` // 可以置顶、屏蔽热搜词的管理员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Search) Reset() {
+	*x = Search{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Search) ProtoMessage() {}
+
+func (x *Search) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Search.ProtoReflect.Descriptor instead.
+func (*Search) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Search) GetAdminIds() []int64 {
+	if x != nil {
+		return x.AdminIds
+	}
+	return nil
+}
+
+type Share struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseUrl       string                 `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`          // 分享短链前缀，分享码拼接在后面
+	LandingUrl    string                 `protobuf:"bytes,2,opt,name=landing_url,json=landingUrl,proto3" json:"landing_url,omitempty"` // 短链跳转的落地页，%d 为视频id，%s 为分享码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Share) Reset() {
+	*x = Share{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Share) ProtoMessage() {}
+
+func (x *Share) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Share.ProtoReflect.Descriptor instead.
+func (*Share) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Share) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Share) GetLandingUrl() string {
+	if x != nil {
+		return x.LandingUrl
+	}
+	return ""
+}
+
+// 清理未被视频引用的上传对象
+type BlobGC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"` // 上传后超过该时间仍未被引用才清理，给 CreateVideo 留出时间
+	Prefixes      []string               `protobuf:"bytes,4,rep,name=prefixes,proto3" json:"prefixes,omitempty"`                          // 扫描的对象前缀，如 video/
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // 只输出报告不删除
+	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`      // 每批核对的对象数
+	MaxDeletes    int32                  `protobuf:"varint,7,opt,name=max_deletes,json=maxDeletes,proto3" json:"max_deletes,omitempty"`   // 单次最多删除数，超过时只输出报告，避免地址配置变更导致误删；0 不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlobGC) Reset() {
+	*x = BlobGC{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlobGC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobGC) ProtoMessage() {}
+
+func (x *BlobGC) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobGC.ProtoReflect.Descriptor instead.
+func (*BlobGC) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BlobGC) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BlobGC) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *BlobGC) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *BlobGC) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *BlobGC) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BlobGC) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *BlobGC) GetMaxDeletes() int32 {
+	if x != nil {
+		return x.MaxDeletes
+	}
+	return 0
+}
+
 type OpenTelemetry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
@@ -527,7 +772,7 @@ type OpenTelemetry struct {
 
 func (x *OpenTelemetry) Reset() {
 	*x = OpenTelemetry{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +784,7 @@ func (x *OpenTelemetry) String() string {
 func (*OpenTelemetry) ProtoMessage() {}
 
 func (x *OpenTelemetry) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +797,7 @@ func (x *OpenTelemetry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenTelemetry.ProtoReflect.Descriptor instead.
 func (*OpenTelemetry) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OpenTelemetry) GetEndpoint() string {
@@ -573,7 +818,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -585,7 +830,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +878,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +890,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +936,7 @@ type Server_GIN struct {
 
 func (x *Server_GIN) Reset() {
 	*x = Server_GIN{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +948,7 @@ func (x *Server_GIN) String() string {
 func (*Server_GIN) ProtoMessage() {}
 
 func (x *Server_GIN) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +981,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +993,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1035,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +1047,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1104,7 @@ type Data_MinIO struct {
 
 func (x *Data_MinIO) Reset() {
 	*x = Data_MinIO{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -871,7 +1116,7 @@ func (x *Data_MinIO) String() string {
 func (*Data_MinIO) ProtoMessage() {}
 
 func (x *Data_MinIO) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,6 +1167,59 @@ func (x *Data_MinIO) GetUseSSL() bool {
 	return false
 }
 
+// 对象存储，driver 为 minio（默认）或 local
+type Data_Storage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Local         *Data_Storage_Local    `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage) Reset() {
+	*x = Data_Storage{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage) ProtoMessage() {}
+
+func (x *Data_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage.ProtoReflect.Descriptor instead.
+func (*Data_Storage) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Storage) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetLocal() *Data_Storage_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
 type Data_UserService struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
@@ -931,7 +1229,7 @@ type Data_UserService struct {
 
 func (x *Data_UserService) Reset() {
 	*x = Data_UserService{}
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1241,7 @@ func (x *Data_UserService) String() string {
 func (*Data_UserService) ProtoMessage() {}
 
 func (x *Data_UserService) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1254,7 @@ func (x *Data_UserService) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_UserService.ProtoReflect.Descriptor instead.
 func (*Data_UserService) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
 }
 
 func (x *Data_UserService) GetEndpoint() string {
@@ -966,6 +1264,142 @@ func (x *Data_UserService) GetEndpoint() string {
 	return ""
 }
 
+type Data_Kafka struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Brokers                 []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	PlayTopic               string                 `protobuf:"bytes,2,opt,name=play_topic,json=playTopic,proto3" json:"play_topic,omitempty"`                                             // 播放事件，由 job-service 批量汇总
+	VideoEventTopic         string                 `protobuf:"bytes,3,opt,name=video_event_topic,json=videoEventTopic,proto3" json:"video_event_topic,omitempty"`                         // favorite-service、comment-service 发布的点赞、收藏、评论事件，job-service 发布的定时发布事件
+	GroupId                 string                 `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                                                   // 消费 video_event_topic 的消费组
+	ProcessedEventRetention *durationpb.Duration   `protobuf:"bytes,5,opt,name=processed_event_retention,json=processedEventRetention,proto3" json:"processed_event_retention,omitempty"` // 已处理事件的保留时间，需长于 video_event_topic 的 kafka 保留时间
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Data_Kafka) Reset() {
+	*x = Data_Kafka{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Kafka) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Kafka) ProtoMessage() {}
+
+func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
+func (*Data_Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Kafka) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Data_Kafka) GetPlayTopic() string {
+	if x != nil {
+		return x.PlayTopic
+	}
+	return ""
+}
+
+func (x *Data_Kafka) GetVideoEventTopic() string {
+	if x != nil {
+		return x.VideoEventTopic
+	}
+	return ""
+}
+
+func (x *Data_Kafka) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Data_Kafka) GetProcessedEventRetention() *durationpb.Duration {
+	if x != nil {
+		return x.ProcessedEventRetention
+	}
+	return nil
+}
+
+type Data_Storage_Local struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`                      // 文件存放目录
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"` // 访问地址，路径部分作为 HTTP 路由前缀，如 http://127.0.0.1:8082/blob/
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`                  // 预签名密钥，为空时不支持预签名上传
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage_Local) Reset() {
+	*x = Data_Storage_Local{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage_Local) ProtoMessage() {}
+
+func (x *Data_Storage_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage_Local.ProtoReflect.Descriptor instead.
+func (*Data_Storage_Local) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
+func (x *Data_Storage_Local) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Data_Storage_Local) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Data_Storage_Local) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
 type Registry_Consul struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -976,7 +1410,7 @@ type Registry_Consul struct {
 
 func (x *Registry_Consul) Reset() {
 	*x = Registry_Consul{}
-	mi := &file_conf_conf_proto_msgTypes[16]
+	mi := &file_conf_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -988,7 +1422,7 @@ func (x *Registry_Consul) String() string {
 func (*Registry_Consul) ProtoMessage() {}
 
 func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[16]
+	mi := &file_conf_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1461,7 @@ type Registry_Advertise struct {
 
 func (x *Registry_Advertise) Reset() {
 	*x = Registry_Advertise{}
-	mi := &file_conf_conf_proto_msgTypes[17]
+	mi := &file_conf_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1473,7 @@ func (x *Registry_Advertise) String() string {
 func (*Registry_Advertise) ProtoMessage() {}
 
 func (x *Registry_Advertise) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[17]
+	mi := &file_conf_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1501,7 @@ var File_conf_conf_proto protoreflect.FileDescriptor
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x8d\x03\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x8f\x04\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
@@ -1076,7 +1510,11 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\bregistry\x18\x05 \x01(\v2\x14.kratos.api.RegistryR\bregistry\x12-\n" +
 	"\aservice\x18\x06 \x01(\v2\x13.kratos.api.ServiceR\aservice\x12?\n" +
 	"\relasticsearch\x18\a \x01(\v2\x19.kratos.api.ElasticsearchR\relasticsearch\x12@\n" +
-	"\x0eopen_telemetry\x18\b \x01(\v2\x19.kratos.api.OpenTelemetryR\ropenTelemetry\"\xfd\x02\n" +
+	"\x0eopen_telemetry\x18\b \x01(\v2\x19.kratos.api.OpenTelemetryR\ropenTelemetry\x12*\n" +
+	"\x06search\x18\t \x01(\v2\x12.kratos.api.SearchR\x06search\x12'\n" +
+	"\x05share\x18\n" +
+	" \x01(\v2\x11.kratos.api.ShareR\x05share\x12+\n" +
+	"\ablob_gc\x18\v \x01(\v2\x12.kratos.api.BlobGCR\x06blobGc\"\xfd\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12(\n" +
@@ -1090,12 +1528,14 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
 	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x19\n" +
 	"\x03GIN\x12\x12\n" +
-	"\x04port\x18\x01 \x01(\x05R\x04port\"\xa1\x05\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\"\x8e\t\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12,\n" +
 	"\x05minio\x18\x03 \x01(\v2\x16.kratos.api.Data.MinIOR\x05minio\x12?\n" +
-	"\fuser_service\x18\x04 \x01(\v2\x1c.kratos.api.Data.UserServiceR\vuserService\x1a:\n" +
+	"\fuser_service\x18\x04 \x01(\v2\x1c.kratos.api.Data.UserServiceR\vuserService\x12,\n" +
+	"\x05kafka\x18\x05 \x01(\v2\x16.kratos.api.Data.KafkaR\x05kafka\x122\n" +
+	"\astorage\x18\x06 \x01(\v2\x18.kratos.api.Data.StorageR\astorage\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
@@ -1111,9 +1551,23 @@ const file_conf_conf_proto_rawDesc = "" +
 	"bucketName\x12 \n" +
 	"\vaccessKeyID\x18\x03 \x01(\tR\vaccessKeyID\x12(\n" +
 	"\x0fsecretAccessKey\x18\x04 \x01(\tR\x0fsecretAccessKey\x12\x16\n" +
-	"\x06useSSL\x18\x05 \x01(\bR\x06useSSL\x1a)\n" +
+	"\x06useSSL\x18\x05 \x01(\bR\x06useSSL\x1a\xa7\x01\n" +
+	"\aStorage\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x124\n" +
+	"\x05local\x18\x02 \x01(\v2\x1e.kratos.api.Data.Storage.LocalR\x05local\x1aN\n" +
+	"\x05Local\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x1a)\n" +
 	"\vUserService\x12\x1a\n" +
-	"\bendpoint\x18\x01 \x01(\tR\bendpoint\"M\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a\xde\x01\n" +
+	"\x05Kafka\x12\x18\n" +
+	"\abrokers\x18\x01 \x03(\tR\abrokers\x12\x1d\n" +
+	"\n" +
+	"play_topic\x18\x02 \x01(\tR\tplayTopic\x12*\n" +
+	"\x11video_event_topic\x18\x03 \x01(\tR\x0fvideoEventTopic\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\x12U\n" +
+	"\x19processed_event_retention\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x17processedEventRetention\"M\n" +
 	"\x03JWT\x12\x16\n" +
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
 	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x16\n" +
@@ -1133,10 +1587,29 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\"7\n" +
 	"\aService\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"C\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\x87\x01\n" +
 	"\rElasticsearch\x12\x1c\n" +
 	"\taddresses\x18\x01 \x03(\tR\taddresses\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\tR\x05index\"+\n" +
+	"\x05index\x18\x02 \x01(\tR\x05index\x12\x1d\n" +
+	"\n" +
+	"user_index\x18\x03 \x01(\tR\tuserIndex\x12#\n" +
+	"\rsuggest_index\x18\x04 \x01(\tR\fsuggestIndex\"%\n" +
+	"\x06Search\x12\x1b\n" +
+	"\tadmin_ids\x18\x01 \x03(\x03R\badminIds\"C\n" +
+	"\x05Share\x12\x19\n" +
+	"\bbase_url\x18\x01 \x01(\tR\abaseUrl\x12\x1f\n" +
+	"\vlanding_url\x18\x02 \x01(\tR\n" +
+	"landingUrl\"\x8c\x02\n" +
+	"\x06BlobGC\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12<\n" +
+	"\fgrace_period\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12\x1a\n" +
+	"\bprefixes\x18\x04 \x03(\tR\bprefixes\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x06 \x01(\x05R\tbatchSize\x12\x1f\n" +
+	"\vmax_deletes\x18\a \x01(\x05R\n" +
+	"maxDeletes\"+\n" +
 	"\rOpenTelemetry\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpointB\"Z video-service/internal/conf;confb\x06proto3"
 
@@ -1152,7 +1625,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -1162,17 +1635,23 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Registry)(nil),            // 5: kratos.api.Registry
 	(*Service)(nil),             // 6: kratos.api.Service
 	(*Elasticsearch)(nil),       // 7: kratos.api.Elasticsearch
-	(*OpenTelemetry)(nil),       // 8: kratos.api.OpenTelemetry
-	(*Server_HTTP)(nil),         // 9: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 10: kratos.api.Server.GRPC
-	(*Server_GIN)(nil),          // 11: kratos.api.Server.GIN
-	(*Data_Database)(nil),       // 12: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 13: kratos.api.Data.Redis
-	(*Data_MinIO)(nil),          // 14: kratos.api.Data.MinIO
-	(*Data_UserService)(nil),    // 15: kratos.api.Data.UserService
-	(*Registry_Consul)(nil),     // 16: kratos.api.Registry.Consul
-	(*Registry_Advertise)(nil),  // 17: kratos.api.Registry.Advertise
-	(*durationpb.Duration)(nil), // 18: google.protobuf.Duration
+	(*Search)(nil),              // 8: kratos.api.Search
+	(*Share)(nil),               // 9: kratos.api.Share
+	(*BlobGC)(nil),              // 10: kratos.api.BlobGC
+	(*OpenTelemetry)(nil),       // 11: kratos.api.OpenTelemetry
+	(*Server_HTTP)(nil),         // 12: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 13: kratos.api.Server.GRPC
+	(*Server_GIN)(nil),          // 14: kratos.api.Server.GIN
+	(*Data_Database)(nil),       // 15: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 16: kratos.api.Data.Redis
+	(*Data_MinIO)(nil),          // 17: kratos.api.Data.MinIO
+	(*Data_Storage)(nil),        // 18: kratos.api.Data.Storage
+	(*Data_UserService)(nil),    // 19: kratos.api.Data.UserService
+	(*Data_Kafka)(nil),          // 20: kratos.api.Data.Kafka
+	(*Data_Storage_Local)(nil),  // 21: kratos.api.Data.Storage.Local
+	(*Registry_Consul)(nil),     // 22: kratos.api.Registry.Consul
+	(*Registry_Advertise)(nil),  // 23: kratos.api.Registry.Advertise
+	(*durationpb.Duration)(nil), // 24: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1182,25 +1661,34 @@ var file_conf_conf_proto_depIdxs = []int32{
 	5,  // 4: kratos.api.Bootstrap.registry:type_name -> kratos.api.Registry
 	6,  // 5: kratos.api.Bootstrap.service:type_name -> kratos.api.Service
 	7,  // 6: kratos.api.Bootstrap.elasticsearch:type_name -> kratos.api.Elasticsearch
-	8,  // 7: kratos.api.Bootstrap.open_telemetry:type_name -> kratos.api.OpenTelemetry
-	9,  // 8: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	10, // 9: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	11, // 10: kratos.api.Server.gin:type_name -> kratos.api.Server.GIN
-	12, // 11: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	13, // 12: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	14, // 13: kratos.api.Data.minio:type_name -> kratos.api.Data.MinIO
-	15, // 14: kratos.api.Data.user_service:type_name -> kratos.api.Data.UserService
-	16, // 15: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	17, // 16: kratos.api.Registry.advertise:type_name -> kratos.api.Registry.Advertise
-	18, // 17: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	18, // 18: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	18, // 19: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	18, // 20: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	11, // 7: kratos.api.Bootstrap.open_telemetry:type_name -> kratos.api.OpenTelemetry
+	8,  // 8: kratos.api.Bootstrap.search:type_name -> kratos.api.Search
+	9,  // 9: kratos.api.Bootstrap.share:type_name -> kratos.api.Share
+	10, // 10: kratos.api.Bootstrap.blob_gc:type_name -> kratos.api.BlobGC
+	12, // 11: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	13, // 12: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	14, // 13: kratos.api.Server.gin:type_name -> kratos.api.Server.GIN
+	15, // 14: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	16, // 15: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	17, // 16: kratos.api.Data.minio:type_name -> kratos.api.Data.MinIO
+	19, // 17: kratos.api.Data.user_service:type_name -> kratos.api.Data.UserService
+	20, // 18: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
+	18, // 19: kratos.api.Data.storage:type_name -> kratos.api.Data.Storage
+	22, // 20: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
+	23, // 21: kratos.api.Registry.advertise:type_name -> kratos.api.Registry.Advertise
+	24, // 22: kratos.api.BlobGC.interval:type_name -> google.protobuf.Duration
+	24, // 23: kratos.api.BlobGC.grace_period:type_name -> google.protobuf.Duration
+	24, // 24: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	24, // 25: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	24, // 26: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	24, // 27: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	21, // 28: kratos.api.Data.Storage.local:type_name -> kratos.api.Data.Storage.Local
+	24, // 29: kratos.api.Data.Kafka.processed_event_retention:type_name -> google.protobuf.Duration
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1214,7 +1702,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg"
+	"video-service/internal/pkg/consts"
+)
+
+type blobGCRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewBlobGCRepo .
+func NewBlobGCRepo(data *Data, logger log.Logger) biz.BlobGCRepo {
+	return &blobGCRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// TryLock 锁在 ttl 后自动释放，不主动删除，保证一个周期内只执行一次
+func (r *blobGCRepo) TryLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	return r.data.rdb.SetNX(ctx, consts.BlobGCLockKey, time.Now().Unix(), ttl).Result()
+}
+
+// ListObjects 遍历对象
+func (r *blobGCRepo) ListObjects(ctx context.Context, prefix string, fn func(params.BlobObject) error) error {
+	return r.data.blob.List(ctx, prefix, func(info *pkg.BlobInfo) error {
+		return fn(params.BlobObject{Key: info.Key, Size: info.Size, LastModified: info.LastModified})
+	})
+}
+
+// ReferencedKeys 视频中保存的是对象的访问地址，按当前存储配置将 key 转换为地址后匹配
+func (r *blobGCRepo) ReferencedKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	urlToKey := make(map[string]string, len(keys))
+	urls := make([]string, 0, len(keys))
+	for _, key := range keys {
+		url := r.data.blob.URL(key)
+		urlToKey[url] = key
+		urls = append(urls, url)
+	}
+
+	v := r.data.query.Video
+	videos, err := v.WithContext(ctx).
+		Select(v.PlayURL, v.CoverURL).
+		Where(v.WithContext(ctx).Where(v.PlayURL.In(urls...)).Or(v.CoverURL.In(urls...))).
+		Where(v.DeleteAt.IsNull()).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]bool, len(videos))
+	for _, video := range videos {
+		if key, ok := urlToKey[video.PlayURL]; ok {
+			res[key] = true
+		}
+		if key, ok := urlToKey[video.CoverURL]; ok {
+			res[key] = true
+		}
+	}
+	return res, nil
+}
+
+// DeleteObject 删除对象
+func (r *blobGCRepo) DeleteObject(ctx context.Context, key string) error {
+	return r.data.blob.Delete(ctx, key)
+}
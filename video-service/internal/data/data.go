@@ -9,12 +9,14 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/hashicorp/consul/api"
 	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	gogrpc "google.golang.org/grpc"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"strings"
+	"time"
 	"video-service/internal/conf"
 	"video-service/internal/data/query"
 	"video-service/internal/pkg"
@@ -26,40 +28,49 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewVideoRepo, NewDB, NewRedisClient, NewEsClient, NewDiscover, NewUserServiceClient)
+var ProviderSet = wire.NewSet(NewData, NewVideoRepo, NewTagRepo, NewSearchRepo, NewPlayRepo, NewShareRepo, NewBlobGCRepo, NewVideoEventRepo, NewDB, NewRedisClient, NewEsClient, NewPlayWriter, NewDiscover, NewUserServiceClient)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
-	log     *log.Helper
-	jwt     *pkg.JWTManager
-	uploade *pkg.MinioUploader
-	db      *gorm.DB
-	rdb     *redis.Client
-	idg     *pkg.IDGenerator
-	query   *query.Query
-	es      *elasticsearch.TypedClient
-	esIndex string
+	log         *log.Helper
+	jwt         *pkg.JWTManager
+	blob        pkg.BlobStore
+	db          *gorm.DB
+	rdb         *redis.Client
+	idg         *pkg.IDGenerator
+	query       *query.Query
+	es          *elasticsearch.TypedClient
+	esIndex     string
+	esUserIndex string
+	esSuggest   string
+	playWriter  *kafka.Writer
 
 	UserClient pbUser.UserServiceClient
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, jwt *pkg.JWTManager, upload *pkg.MinioUploader, db *gorm.DB, rdb *redis.Client, idg *pkg.IDGenerator, cu pbUser.UserServiceClient, esCfg *conf.Elasticsearch, es *elasticsearch.TypedClient) (*Data, func(), error) {
+func NewData(c *conf.Data, logger log.Logger, jwt *pkg.JWTManager, blob pkg.BlobStore, db *gorm.DB, rdb *redis.Client, idg *pkg.IDGenerator, cu pbUser.UserServiceClient, esCfg *conf.Elasticsearch, es *elasticsearch.TypedClient, pw *kafka.Writer) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := pw.Close(); err != nil {
+			log.NewHelper(logger).Errorf("close play writer failed: %v", err)
+		}
 	}
 	query.SetDefault(db)
 
 	return &Data{log: log.NewHelper(logger),
-		jwt:     jwt,
-		uploade: upload,
-		db:      db, rdb: rdb,
-		idg:        idg,
-		query:      query.Q,
-		UserClient: cu,
-		es:         es,
-		esIndex:    esCfg.Index,
+		jwt:  jwt,
+		blob: blob,
+		db:   db, rdb: rdb,
+		idg:         idg,
+		query:       query.Q,
+		UserClient:  cu,
+		es:          es,
+		esIndex:     esCfg.Index,
+		esUserIndex: esCfg.UserIndex,
+		esSuggest:   esCfg.SuggestIndex,
+		playWriter:  pw,
 	}, cleanup, nil
 }
 
@@ -116,3 +127,14 @@ func NewEsClient(cfg *conf.Elasticsearch) (*elasticsearch.TypedClient, error) {
 	}
 	return elasticsearch.NewTypedClient(c)
 }
+
+// NewPlayWriter 播放事件 kafka 生产者，异步批量发送
+func NewPlayWriter(cfg *conf.Data) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Kafka.Brokers...),
+		Topic:        cfg.Kafka.PlayTopic,
+		Balancer:     &kafka.Hash{},
+		BatchTimeout: 50 * time.Millisecond,
+		Async:        true,
+	}
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	v1 "video-service/api/video/v1"
+)
+
+// esDoc es 中的文档，canal 同步过来的字段值多为字符串，videos 中 tags 为关键字数组
+type esDoc map[string]interface{}
+
+// decodeEsVideo 将 es 文档解析为视频信息
+func decodeEsVideo(source json.RawMessage) (*v1.Video, error) {
+	var doc esDoc
+	if err := json.Unmarshal(source, &doc); err != nil {
+		return nil, err
+	}
+	id := doc.int64("id")
+	if id == 0 {
+		return nil, fmt.Errorf("missing video id")
+	}
+	return &v1.Video{
+		Id:          id,
+		UserId:      doc.int64("user_id"),
+		PlayUrl:     doc.string("play_url"),
+		CoverUrl:    doc.string("cover_url"),
+		Title:       doc.string("title"),
+		Description: doc.string("description"),
+		Duration:    float32(doc.float64("duration")),
+		Tags:        doc.tags(),
+		FavoriteCnt: int32(doc.int64("favorite_cnt")),
+		CommentCnt:  int32(doc.int64("comment_cnt")),
+		ShareCnt:    int32(doc.int64("share_cnt")),
+		CollectCnt:  int32(doc.int64("collect_cnt")),
+		ViewCnt:     doc.int64("view_cnt"),
+		IsPublic:    doc.bool("is_public"),
+		AuditStatus: int32(doc.int64("audit_status")),
+		IsOriginal:  doc.bool("is_original"),
+		SourceUrl:   doc.string("source_url"),
+		CreatedAt:   doc.time("created_at"),
+	}, nil
+}
+
+func (d esDoc) string(key string) string {
+	switch v := d[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+func (d esDoc) int64(key string) int64 {
+	switch v := d[key].(type) {
+	case string:
+		n, _ := strconv.ParseInt(v, 10, 64)
+		return n
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+func (d esDoc) float64(key string) float64 {
+	switch v := d[key].(type) {
+	case string:
+		n, _ := strconv.ParseFloat(v, 64)
+		return n
+	case float64:
+		return v
+	}
+	return 0
+}
+
+func (d esDoc) bool(key string) bool {
+	switch v := d[key].(type) {
+	case string:
+		return v == "1" || v == "true"
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	}
+	return false
+}
+
+func (d esDoc) time(key string) *timestamppb.Timestamp {
+	s := d.string(key)
+	if s == "" {
+		return nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// tags 兼容关键字数组和逗号分隔字符串两种格式
+func (d esDoc) tags() string {
+	switch v := d["tags"].(type) {
+	case string:
+		return v
+	case []interface{}:
+		tags := make([]string, 0, len(v))
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+		return strings.Join(tags, ",")
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"common/rediskey"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+)
+
+type playRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPlayRepo .
+func NewPlayRepo(data *Data, logger log.Logger) biz.PlayRepo {
+	return &playRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CheckVideoPlayable 视频是否存在，先查排行榜避免每次播放都访问数据库
+func (r *playRepo) CheckVideoPlayable(ctx context.Context, videoID int64) (bool, error) {
+	err := r.data.rdb.ZScore(ctx, rediskey.VideoScore, strconv.FormatInt(videoID, 10)).Err()
+	if err == nil {
+		return true, nil
+	}
+	if err != redis.Nil {
+		r.log.WithContext(ctx).Warnf("zscore video:score err: %v", err)
+	}
+
+	_, err = r.data.query.Video.WithContext(ctx).
+		Select(r.data.query.Video.ID).
+		Where(r.data.query.Video.ID.Eq(videoID), r.data.query.Video.PublishStatus.Eq(consts.PublishStatusPublished)).
+		First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// AddDailyViewer 记录当天观众，返回是否为新观众。
+// PFADD 的返回值只表示 HyperLogLog 寄存器是否变化，新观众也可能返回 0，是否计数按观众逐个 SETNX 判断；HyperLogLog 只用于估算观众数
+func (r *playRepo) AddDailyViewer(ctx context.Context, videoID int64, viewer string, day time.Time) (bool, error) {
+	date := day.Format("20060102")
+	uvKey := fmt.Sprintf(consts.VideoDailyViewerKey, videoID, date)
+
+	pipe := r.data.rdb.TxPipeline()
+	added := pipe.SetNX(ctx, fmt.Sprintf(consts.VideoDailyViewKey, videoID, date, viewer), 1, consts.VideoDailyViewerTTL)
+	pipe.PFAdd(ctx, uvKey, viewer)
+	pipe.Expire(ctx, uvKey, consts.VideoDailyViewerTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return added.Val(), nil
+}
+
+// SendPlayEvent 写入播放事件，按视频id分区保证同一视频的事件有序
+func (r *playRepo) SendPlayEvent(ctx context.Context, event *params.PlayEvent) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return r.data.playWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(event.VideoID, 10)),
+		Value: value,
+	})
+}
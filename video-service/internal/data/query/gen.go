@@ -16,39 +16,64 @@ import (
 )
 
 var (
-	Q     = new(Query)
-	User  *user
-	Video *video
+	Q              = new(Query)
+	ProcessedEvent *processedEvent
+	ShareClick     *shareClick
+	Tag            *tag
+	User           *user
+	Video          *video
+	VideoShare     *videoShare
+	VideoTag       *videoTag
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	ProcessedEvent = &Q.ProcessedEvent
+	ShareClick = &Q.ShareClick
+	Tag = &Q.Tag
 	User = &Q.User
 	Video = &Q.Video
+	VideoShare = &Q.VideoShare
+	VideoTag = &Q.VideoTag
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:    db,
-		User:  newUser(db, opts...),
-		Video: newVideo(db, opts...),
+		db:             db,
+		ProcessedEvent: newProcessedEvent(db, opts...),
+		ShareClick:     newShareClick(db, opts...),
+		Tag:            newTag(db, opts...),
+		User:           newUser(db, opts...),
+		Video:          newVideo(db, opts...),
+		VideoShare:     newVideoShare(db, opts...),
+		VideoTag:       newVideoTag(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User  user
-	Video video
+	ProcessedEvent processedEvent
+	ShareClick     shareClick
+	Tag            tag
+	User           user
+	Video          video
+	VideoShare     videoShare
+	VideoTag       videoTag
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:    db,
-		User:  q.User.clone(db),
-		Video: q.Video.clone(db),
+		db:             db,
+		ProcessedEvent: q.ProcessedEvent.clone(db),
+		ShareClick:     q.ShareClick.clone(db),
+		Tag:            q.Tag.clone(db),
+		User:           q.User.clone(db),
+		Video:          q.Video.clone(db),
+		VideoShare:     q.VideoShare.clone(db),
+		VideoTag:       q.VideoTag.clone(db),
 	}
 }
 
@@ -62,21 +87,36 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:    db,
-		User:  q.User.replaceDB(db),
-		Video: q.Video.replaceDB(db),
+		db:             db,
+		ProcessedEvent: q.ProcessedEvent.replaceDB(db),
+		ShareClick:     q.ShareClick.replaceDB(db),
+		Tag:            q.Tag.replaceDB(db),
+		User:           q.User.replaceDB(db),
+		Video:          q.Video.replaceDB(db),
+		VideoShare:     q.VideoShare.replaceDB(db),
+		VideoTag:       q.VideoTag.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User  IUserDo
-	Video IVideoDo
+	ProcessedEvent IProcessedEventDo
+	ShareClick     IShareClickDo
+	Tag            ITagDo
+	User           IUserDo
+	Video          IVideoDo
+	VideoShare     IVideoShareDo
+	VideoTag       IVideoTagDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User:  q.User.WithContext(ctx),
-		Video: q.Video.WithContext(ctx),
+		ProcessedEvent: q.ProcessedEvent.WithContext(ctx),
+		ShareClick:     q.ShareClick.WithContext(ctx),
+		Tag:            q.Tag.WithContext(ctx),
+		User:           q.User.WithContext(ctx),
+		Video:          q.Video.WithContext(ctx),
+		VideoShare:     q.VideoShare.WithContext(ctx),
+		VideoTag:       q.VideoTag.WithContext(ctx),
 	}
 }
 
@@ -40,11 +40,16 @@ func newVideo(db *gorm.DB, opts ...gen.DOOption) video {
 	_video.CommentCnt = field.NewInt32(tableName, "comment_cnt")
 	_video.ShareCnt = field.NewInt32(tableName, "share_cnt")
 	_video.CollectCnt = field.NewInt32(tableName, "collect_cnt")
+	_video.ViewCnt = field.NewInt64(tableName, "view_cnt")
 	_video.IsPublic = field.NewBool(tableName, "is_public")
 	_video.AuditStatus = field.NewInt32(tableName, "audit_status")
 	_video.IsOriginal = field.NewBool(tableName, "is_original")
 	_video.SourceURL = field.NewString(tableName, "source_url")
 	_video.TranscodeStatus = field.NewInt32(tableName, "transcode_status")
+	_video.PublishStatus = field.NewInt32(tableName, "publish_status")
+	_video.PublishAt = field.NewTime(tableName, "publish_at")
+	_video.GeoLocation = field.NewString(tableName, "geo_location")
+	_video.CityCode = field.NewString(tableName, "city_code")
 	_video.VideoWidth = field.NewInt32(tableName, "video_width")
 	_video.VideoHeight = field.NewInt32(tableName, "video_height")
 	_video.BizExt = field.NewString(tableName, "biz_ext")
@@ -75,11 +80,16 @@ type video struct {
 	CommentCnt      field.Int32
 	ShareCnt        field.Int32
 	CollectCnt      field.Int32
+	ViewCnt         field.Int64
 	IsPublic        field.Bool  // 01
 	AuditStatus     field.Int32 // 012
 	IsOriginal      field.Bool  // 10
 	SourceURL       field.String
 	TranscodeStatus field.Int32 // 012
+	PublishStatus   field.Int32 // 0 1 2
+	PublishAt       field.Time
+	GeoLocation     field.String
+	CityCode        field.String
 	VideoWidth      field.Int32
 	VideoHeight     field.Int32
 	BizExt          field.String
@@ -116,11 +126,16 @@ func (v *video) updateTableName(table string) *video {
 	v.CommentCnt = field.NewInt32(table, "comment_cnt")
 	v.ShareCnt = field.NewInt32(table, "share_cnt")
 	v.CollectCnt = field.NewInt32(table, "collect_cnt")
+	v.ViewCnt = field.NewInt64(table, "view_cnt")
 	v.IsPublic = field.NewBool(table, "is_public")
 	v.AuditStatus = field.NewInt32(table, "audit_status")
 	v.IsOriginal = field.NewBool(table, "is_original")
 	v.SourceURL = field.NewString(table, "source_url")
 	v.TranscodeStatus = field.NewInt32(table, "transcode_status")
+	v.PublishStatus = field.NewInt32(table, "publish_status")
+	v.PublishAt = field.NewTime(table, "publish_at")
+	v.GeoLocation = field.NewString(table, "geo_location")
+	v.CityCode = field.NewString(table, "city_code")
 	v.VideoWidth = field.NewInt32(table, "video_width")
 	v.VideoHeight = field.NewInt32(table, "video_height")
 	v.BizExt = field.NewString(table, "biz_ext")
@@ -153,7 +168,7 @@ func (v *video) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (v *video) fillFieldMap() {
-	v.fieldMap = make(map[string]field.Expr, 25)
+	v.fieldMap = make(map[string]field.Expr, 30)
 	v.fieldMap["id"] = v.ID
 	v.fieldMap["user_id"] = v.UserID
 	v.fieldMap["play_url"] = v.PlayURL
@@ -166,11 +181,16 @@ func (v *video) fillFieldMap() {
 	v.fieldMap["comment_cnt"] = v.CommentCnt
 	v.fieldMap["share_cnt"] = v.ShareCnt
 	v.fieldMap["collect_cnt"] = v.CollectCnt
+	v.fieldMap["view_cnt"] = v.ViewCnt
 	v.fieldMap["is_public"] = v.IsPublic
 	v.fieldMap["audit_status"] = v.AuditStatus
 	v.fieldMap["is_original"] = v.IsOriginal
 	v.fieldMap["source_url"] = v.SourceURL
 	v.fieldMap["transcode_status"] = v.TranscodeStatus
+	v.fieldMap["publish_status"] = v.PublishStatus
+	v.fieldMap["publish_at"] = v.PublishAt
+	v.fieldMap["geo_location"] = v.GeoLocation
+	v.fieldMap["city_code"] = v.CityCode
 	v.fieldMap["video_width"] = v.VideoWidth
 	v.fieldMap["video_height"] = v.VideoHeight
 	v.fieldMap["biz_ext"] = v.BizExt
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// decayRanking 按小时分桶计数、读取时按衰减权重合并的排行榜，用于热门话题、热搜词
+type decayRanking struct {
+	rdb       *redis.Client
+	bucketKey string        // 小时分桶 key 格式，%s 为 2006010215 格式的小时
+	key       string        // 合并后的榜单 key
+	window    int           // 合并的小时数
+	decay     float64       // 每过一小时的衰减系数
+	cacheTTL  time.Duration // 合并榜单缓存时间
+}
+
+// Incr 增加成员在当前小时分桶的热度
+func (d *decayRanking) Incr(ctx context.Context, members []string, delta float64) error {
+	if len(members) == 0 {
+		return nil
+	}
+	key := d.bucket(time.Now())
+	pipe := d.rdb.Pipeline()
+	for _, m := range members {
+		pipe.ZIncrBy(ctx, key, delta, m)
+	}
+	pipe.Expire(ctx, key, time.Duration(d.window+1)*time.Hour)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Top 获取榜单前 n 名，合并榜单过期时重新合并
+func (d *decayRanking) Top(ctx context.Context, n int64) ([]redis.Z, error) {
+	ttl, err := d.rdb.TTL(ctx, d.key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		if err := d.merge(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return d.rdb.ZRevRangeWithScores(ctx, d.key, 0, n-1).Result()
+}
+
+func (d *decayRanking) merge(ctx context.Context) error {
+	now := time.Now()
+	keys := make([]string, 0, d.window)
+	weights := make([]float64, 0, d.window)
+	for i := 0; i < d.window; i++ {
+		keys = append(keys, d.bucket(now.Add(-time.Duration(i)*time.Hour)))
+		weights = append(weights, math.Pow(d.decay, float64(i)))
+	}
+
+	tmpKey := fmt.Sprintf("%s:tmp:%d", d.key, now.UnixNano())
+	pipe := d.rdb.TxPipeline()
+	pipe.ZUnionStore(ctx, tmpKey, &redis.ZStore{Keys: keys, Weights: weights, Aggregate: "SUM"})
+	pipe.Rename(ctx, tmpKey, d.key)
+	pipe.Expire(ctx, d.key, d.cacheTTL)
+	_, err := pipe.Exec(ctx)
+	// 所有分桶都为空时 ZUNIONSTORE 不会生成 tmpKey，RENAME 会报错
+	if err != nil && err.Error() == "ERR no such key" {
+		return nil
+	}
+	return err
+}
+
+func (d *decayRanking) bucket(t time.Time) string {
+	return fmt.Sprintf(d.bucketKey, t.Format("2006010215"))
+}
@@ -0,0 +1,411 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pbUser "video-service/api/user/v1"
+	v1 "video-service/api/video/v1"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+)
+
+const (
+	// searchAuthorLimit 按作者名匹配时最多取的作者数
+	searchAuthorLimit = 20
+	// searchDBMaxOffset sql 兜底最多翻到的条数，避免深分页扫表
+	searchDBMaxOffset = 200
+	// searchDBTimeout sql 兜底查询超时时间
+	searchDBTimeout = 2 * time.Second
+	// esDateLayout canal 同步到 es 的时间格式
+	esDateLayout = "2006-01-02 15:04:05"
+)
+
+const (
+	searchSourceES = "es"
+	searchSourceDB = "db"
+)
+
+// likeEscaper 转义 LIKE 中的通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchCursor 搜索分页游标，序列化后 base64 返回给前端
+type searchCursor struct {
+	Source string             `json:"src"`
+	Sort   v1.SearchSort      `json:"s"`
+	After  []types.FieldValue `json:"a,omitempty"` // es search_after
+	Offset int                `json:"o,omitempty"` // sql 兜底时的偏移
+}
+
+func encodeSearchCursor(c *searchCursor) string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSearchCursor(s string) (*searchCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	c := new(searchCursor)
+	// UseNumber 避免 search_after 中的数值精度丢失
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+type searchRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSearchRepo .
+func NewSearchRepo(data *Data, logger log.Logger) biz.SearchRepo {
+	return &searchRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SearchVideos 搜索视频，es 不可用时用 sql 兜底
+func (r *searchRepo) SearchVideos(ctx context.Context, p *params.SearchVideosRequest) (*params.SearchVideosReply, error) {
+	cursor := &searchCursor{Source: searchSourceES, Sort: p.Sort}
+	if p.Cursor != "" {
+		c, err := decodeSearchCursor(p.Cursor)
+		if err != nil || c.Sort != p.Sort {
+			return nil, biz.ErrInvalidCursor
+		}
+		cursor = c
+	}
+
+	// 翻页时保持和首页同一数据源
+	if cursor.Source == searchSourceDB {
+		return r.searchVideosFromDB(ctx, p, cursor)
+	}
+	res, err := r.searchVideosFromES(ctx, p, cursor)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("search videos from es err: %v, fallback to DB", err)
+		if p.Cursor != "" {
+			return nil, err
+		}
+		return r.searchVideosFromDB(ctx, p, &searchCursor{Source: searchSourceDB, Sort: p.Sort})
+	}
+	return res, nil
+}
+
+func (r *searchRepo) searchVideosFromES(ctx context.Context, p *params.SearchVideosRequest, cursor *searchCursor) (*params.SearchVideosReply, error) {
+	should := []types.Query{
+		{
+			MultiMatch: &types.MultiMatchQuery{
+				Query:     p.Keyword,
+				Fields:    []string{"title^3", "tags^2", "description"},
+				Fuzziness: "AUTO",
+				Lenient:   ptr(true),
+			},
+		},
+	}
+	// 作者名匹配
+	authorIDs, err := r.searchAuthorIDs(ctx, p.Keyword)
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("search author err: %v", err)
+	}
+	if len(authorIDs) > 0 {
+		should = append(should, types.Query{
+			Terms: &types.TermsQuery{
+				TermsQuery: map[string]types.TermsQueryField{"user_id": authorIDs},
+				Boost:      ptr(float32(2)),
+			},
+		})
+	}
+
+	req := r.data.es.Search().
+		Index(r.data.esIndex).
+		Query(&types.Query{
+			Bool: &types.BoolQuery{
+				Should:             should,
+				MinimumShouldMatch: 1,
+				Filter:             esSearchFilters(p),
+			},
+		}).
+		Sort(esSearchSorts(p.Sort)...).
+		Highlight(&types.Highlight{
+			Fields: map[string]types.HighlightField{
+				"title":       {},
+				"description": {},
+				"tags":        {},
+			},
+			PreTags:  []string{"<em>"},
+			PostTags: []string{"</em>"},
+		}).
+		TrackTotalHits(false).
+		Size(int(p.PageSize) + 1)
+	if len(cursor.After) > 0 {
+		req = req.SearchAfter(cursor.After...)
+	}
+
+	res, err := req.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := res.Hits.Hits
+	hasMore := len(hits) > int(p.PageSize)
+	if hasMore {
+		hits = hits[:p.PageSize]
+	}
+
+	items := make([]*params.SearchVideoItem, 0, len(hits))
+	for _, hit := range hits {
+		video, err := decodeEsVideo(hit.Source_)
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("unmarshal video err: %v, source: %s", err, string(hit.Source_))
+			continue
+		}
+		highlights := make(map[string]string, len(hit.Highlight))
+		for field, fragments := range hit.Highlight {
+			highlights[field] = strings.Join(fragments, "...")
+		}
+		items = append(items, &params.SearchVideoItem{Video: video, Highlights: highlights})
+	}
+
+	reply := &params.SearchVideosReply{Items: items, HasMore: hasMore}
+	if hasMore && len(hits) > 0 {
+		reply.NextCursor = encodeSearchCursor(&searchCursor{
+			Source: searchSourceES,
+			Sort:   p.Sort,
+			After:  hits[len(hits)-1].Sort,
+		})
+	}
+	return reply, nil
+}
+
+// searchAuthorIDs 在用户索引中按用户名模糊匹配作者
+func (r *searchRepo) searchAuthorIDs(ctx context.Context, keyword string) ([]int64, error) {
+	if r.data.esUserIndex == "" {
+		return nil, nil
+	}
+	res, err := r.data.es.Search().
+		Index(r.data.esUserIndex).
+		Query(&types.Query{
+			Match: map[string]types.MatchQuery{
+				"username": {Query: keyword, Fuzziness: "AUTO"},
+			},
+		}).
+		Source_(&types.SourceFilter{Includes: []string{"id"}}).
+		Size(searchAuthorLimit).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(res.Hits.Hits))
+	for _, hit := range res.Hits.Hits {
+		var doc esDoc
+		if err := json.Unmarshal(hit.Source_, &doc); err != nil {
+			continue
+		}
+		if id := doc.int64("id"); id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func esSearchFilters(p *params.SearchVideosRequest) []types.Query {
+	filters := []types.Query{
+		{Term: map[string]types.TermQuery{"is_public": {Value: true}}},
+	}
+	if p.MinDuration > 0 || p.MaxDuration > 0 {
+		rq := types.NumberRangeQuery{}
+		if p.MinDuration > 0 {
+			rq.Gte = ptr(types.Float64(p.MinDuration))
+		}
+		if p.MaxDuration > 0 {
+			rq.Lte = ptr(types.Float64(p.MaxDuration))
+		}
+		filters = append(filters, types.Query{Range: map[string]types.RangeQuery{"duration": rq}})
+	}
+	if !p.PublishStart.IsZero() || !p.PublishEnd.IsZero() {
+		rq := types.DateRangeQuery{Format: ptr("yyyy-MM-dd HH:mm:ss")}
+		if !p.PublishStart.IsZero() {
+			rq.Gte = ptr(p.PublishStart.In(time.Local).Format(esDateLayout))
+		}
+		if !p.PublishEnd.IsZero() {
+			rq.Lte = ptr(p.PublishEnd.In(time.Local).Format(esDateLayout))
+		}
+		filters = append(filters, types.Query{Range: map[string]types.RangeQuery{"created_at": rq}})
+	}
+	if p.OriginalOnly {
+		filters = append(filters, types.Query{Term: map[string]types.TermQuery{"is_original": {Value: true}}})
+	}
+	return filters
+}
+
+// esSearchSorts 排序字段，最后按 id 兜底保证 search_after 翻页稳定
+func esSearchSorts(sort v1.SearchSort) []types.SortCombinations {
+	var first types.SortOptions
+	switch sort {
+	case v1.SearchSort_SEARCH_SORT_NEWEST:
+		first = types.SortOptions{SortOptions: map[string]types.FieldSort{"created_at": {Order: &sortorder.Desc}}}
+	case v1.SearchSort_SEARCH_SORT_MOST_LIKED:
+		first = types.SortOptions{SortOptions: map[string]types.FieldSort{"favorite_cnt": {Order: &sortorder.Desc}}}
+	default:
+		first = types.SortOptions{Score_: &types.ScoreSort{Order: &sortorder.Desc}}
+	}
+	return []types.SortCombinations{
+		first,
+		types.SortOptions{SortOptions: map[string]types.FieldSort{"id": {Order: &sortorder.Desc}}},
+	}
+}
+
+// searchVideosFromDB sql 兜底，限制最大偏移和查询时间
+func (r *searchRepo) searchVideosFromDB(ctx context.Context, p *params.SearchVideosRequest, cursor *searchCursor) (*params.SearchVideosReply, error) {
+	if cursor.Offset >= searchDBMaxOffset {
+		return &params.SearchVideosReply{Items: []*params.SearchVideoItem{}}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, searchDBTimeout)
+	defer cancel()
+
+	v := r.data.query.Video
+	like := fmt.Sprintf("%%%s%%", likeEscaper.Replace(p.Keyword))
+	db := v.WithContext(ctx).
+		Where(v.IsPublic.Is(true), v.PublishStatus.Eq(consts.PublishStatusPublished)).
+		Where(v.WithContext(ctx).Where(v.Title.Like(like)).Or(v.Description.Like(like)))
+	if p.MinDuration > 0 {
+		db = db.Where(v.Duration.Gte(p.MinDuration))
+	}
+	if p.MaxDuration > 0 {
+		db = db.Where(v.Duration.Lte(p.MaxDuration))
+	}
+	if !p.PublishStart.IsZero() {
+		db = db.Where(v.CreatedAt.Gte(p.PublishStart))
+	}
+	if !p.PublishEnd.IsZero() {
+		db = db.Where(v.CreatedAt.Lte(p.PublishEnd))
+	}
+	if p.OriginalOnly {
+		db = db.Where(v.IsOriginal.Is(true))
+	}
+	switch p.Sort {
+	case v1.SearchSort_SEARCH_SORT_MOST_LIKED:
+		db = db.Order(v.FavoriteCnt.Desc(), v.ID.Desc())
+	default:
+		// sql 无相关度，按最新发布排序
+		db = db.Order(v.CreatedAt.Desc(), v.ID.Desc())
+	}
+
+	videos, err := db.Offset(cursor.Offset).Limit(int(p.PageSize) + 1).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(videos) > int(p.PageSize) && cursor.Offset+int(p.PageSize) < searchDBMaxOffset
+	if len(videos) > int(p.PageSize) {
+		videos = videos[:p.PageSize]
+	}
+	items := make([]*params.SearchVideoItem, 0, len(videos))
+	for _, v := range videos {
+		items = append(items, &params.SearchVideoItem{
+			Video: &v1.Video{
+				Id:          v.ID,
+				UserId:      v.UserID,
+				PlayUrl:     v.PlayURL,
+				CoverUrl:    v.CoverURL,
+				Title:       v.Title,
+				Description: v.Description,
+				Duration:    v.Duration,
+				Tags:        v.Tags,
+				FavoriteCnt: v.FavoriteCnt,
+				CommentCnt:  v.CommentCnt,
+				ShareCnt:    v.ShareCnt,
+				CollectCnt:  v.CollectCnt,
+				ViewCnt:     v.ViewCnt,
+				IsOriginal:  v.IsOriginal,
+				CreatedAt:   timestamppb.New(v.CreatedAt),
+			},
+		})
+	}
+
+	reply := &params.SearchVideosReply{Items: items, HasMore: hasMore}
+	if hasMore {
+		reply.NextCursor = encodeSearchCursor(&searchCursor{
+			Source: searchSourceDB,
+			Sort:   p.Sort,
+			Offset: cursor.Offset + len(videos),
+		})
+	}
+	return reply, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// SearchUsers 调用 user-service 按用户名搜索
+func (r *searchRepo) SearchUsers(ctx context.Context, keyword string, size int32) ([]*params.SearchUser, bool, error) {
+	reply, err := r.data.UserClient.SearchUsers(ctx, &pbUser.SearchUsersRequest{Keyword: keyword, Page: 1, PageSize: size})
+	if err != nil {
+		return nil, false, err
+	}
+	users := make([]*params.SearchUser, 0, len(reply.Users))
+	for _, u := range reply.Users {
+		users = append(users, &params.SearchUser{
+			Id:            u.Id,
+			Name:          u.Name,
+			Avatar:        u.Avatar,
+			Signature:     u.Signature,
+			FollowerCount: u.FollowerCount,
+			WorkCount:     u.WorkCount,
+		})
+	}
+	return users, reply.HasMore, nil
+}
+
+// SearchTags 按话题名前缀匹配，精确匹配的单独查询并排在最前，不受视频数排序影响，其余按视频数排序
+func (r *searchRepo) SearchTags(ctx context.Context, name string, size int32) ([]*params.Tag, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, searchDBTimeout)
+	defer cancel()
+
+	t := r.data.query.Tag
+	exact, err := t.WithContext(ctx).Where(t.Name.Eq(name)).Limit(1).Find()
+	if err != nil {
+		return nil, false, err
+	}
+
+	limit := int(size) - len(exact)
+	do := t.WithContext(ctx).Where(t.Name.Like(likeEscaper.Replace(name) + "%"))
+	if len(exact) > 0 {
+		do = do.Where(t.ID.Neq(exact[0].ID))
+	}
+	tags, err := do.Order(t.VideoCnt.Desc(), t.ID.Desc()).
+		Limit(limit + 1).
+		Find()
+	if err != nil {
+		return nil, false, err
+	}
+	hasMore := len(tags) > limit
+	if hasMore {
+		tags = tags[:limit]
+	}
+
+	res := make([]*params.Tag, 0, len(exact)+len(tags))
+	for _, tag := range append(exact, tags...) {
+		res = append(res, toTagParams(tag))
+	}
+	return res, hasMore, nil
+}
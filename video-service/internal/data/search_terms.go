@@ -0,0 +1,249 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/redis/go-redis/v9"
+	"video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
+)
+
+// SuggestQueries 搜索建议，es 不可用时从热搜榜中按前缀匹配
+func (r *searchRepo) SuggestQueries(ctx context.Context, prefix string, limit int) ([]*params.Suggestion, error) {
+	res, err := r.suggestFromES(ctx, prefix, limit)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("suggest from es err: %v, fallback to hot search", err)
+		res, err = r.suggestFromHot(ctx, prefix, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r.filterBlockedSuggestions(ctx, res)
+}
+
+func (r *searchRepo) suggestFromES(ctx context.Context, prefix string, limit int) ([]*params.Suggestion, error) {
+	if r.data.esSuggest == "" {
+		return nil, fmt.Errorf("suggest index not configured")
+	}
+	resp, err := r.data.es.Search().
+		Index(r.data.esSuggest).
+		Suggest(&types.Suggester{
+			Suggesters: map[string]types.FieldSuggester{
+				"q": {
+					Prefix: &prefix,
+					Completion: &types.CompletionSuggester{
+						Field:          "suggest",
+						Size:           &limit,
+						SkipDuplicates: ptr(true),
+						Fuzzy:          &types.SuggestFuzziness{Fuzziness: "AUTO"},
+					},
+				},
+			},
+		}).
+		Source_(false).
+		TypedKeys(true).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*params.Suggestion, 0, limit)
+	for _, s := range resp.Suggest["q"] {
+		var options []types.CompletionSuggestOption
+		switch cs := s.(type) {
+		case *types.CompletionSuggest:
+			options = cs.Options
+		case types.CompletionSuggest:
+			options = cs.Options
+		}
+		for _, opt := range options {
+			res = append(res, &params.Suggestion{Text: opt.Text, Type: suggestType(opt.Id_)})
+		}
+	}
+	return res, nil
+}
+
+// suggestType 建议文档 id 格式为 {type}:{text}
+func suggestType(id *string) string {
+	if id == nil {
+		return ""
+	}
+	typ, _, _ := strings.Cut(*id, ":")
+	return typ
+}
+
+// suggestFromHot 从热搜榜前若干名中按前缀匹配
+func (r *searchRepo) suggestFromHot(ctx context.Context, prefix string, limit int) ([]*params.Suggestion, error) {
+	zs, err := newHotSearch(r.data.rdb).Top(ctx, 200)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*params.Suggestion, 0, limit)
+	for _, z := range zs {
+		kw := z.Member.(string)
+		if !strings.HasPrefix(kw, prefix) {
+			continue
+		}
+		res = append(res, &params.Suggestion{Text: kw, Type: "hot"})
+		if len(res) >= limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+func (r *searchRepo) filterBlockedSuggestions(ctx context.Context, in []*params.Suggestion) ([]*params.Suggestion, error) {
+	if len(in) == 0 {
+		return in, nil
+	}
+	members := make([]interface{}, 0, len(in))
+	for _, s := range in {
+		members = append(members, strings.ToLower(s.Text))
+	}
+	blocked, err := r.data.rdb.SMIsMember(ctx, consts.SearchHotBlockedKey, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*params.Suggestion, 0, len(in))
+	for i, s := range in {
+		if !blocked[i] {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+// RecordSearch 记录搜索历史及热搜，userID 为 0 时只计入热搜
+func (r *searchRepo) RecordSearch(ctx context.Context, userID int64, keyword string) error {
+	if keyword == "" {
+		return nil
+	}
+	if userID != 0 {
+		key := fmt.Sprintf(consts.SearchHistoryKey, userID)
+		pipe := r.data.rdb.TxPipeline()
+		pipe.LRem(ctx, key, 0, keyword)
+		pipe.LPush(ctx, key, keyword)
+		pipe.LTrim(ctx, key, 0, consts.SearchHistorySize-1)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	blocked, err := r.data.rdb.SIsMember(ctx, consts.SearchHotBlockedKey, keyword).Result()
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return nil
+	}
+	return newHotSearch(r.data.rdb).Incr(ctx, []string{keyword}, 1)
+}
+
+// ListSearchHistory 获取搜索历史，最近的在前
+func (r *searchRepo) ListSearchHistory(ctx context.Context, userID int64) ([]string, error) {
+	return r.data.rdb.LRange(ctx, fmt.Sprintf(consts.SearchHistoryKey, userID), 0, consts.SearchHistorySize-1).Result()
+}
+
+// ClearSearchHistory 清除搜索历史，keyword 为空时清除全部
+func (r *searchRepo) ClearSearchHistory(ctx context.Context, userID int64, keyword string) error {
+	key := fmt.Sprintf(consts.SearchHistoryKey, userID)
+	if keyword == "" {
+		return r.data.rdb.Del(ctx, key).Err()
+	}
+	return r.data.rdb.LRem(ctx, key, 0, keyword).Err()
+}
+
+// ListHotSearches 热搜榜，置顶词按位置插入，屏蔽词过滤
+func (r *searchRepo) ListHotSearches(ctx context.Context, limit int64) ([]*params.HotSearch, error) {
+	pinned, err := r.data.rdb.ZRangeWithScores(ctx, consts.SearchHotPinnedKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := r.data.rdb.SMembers(ctx, consts.SearchHotBlockedKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	// 多取一些，过滤后仍能填满
+	zs, err := newHotSearch(r.data.rdb).Top(ctx, limit+int64(len(pinned))+int64(len(blocked)))
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]struct{}, len(pinned)+len(blocked))
+	for _, kw := range blocked {
+		skip[kw] = struct{}{}
+	}
+	for _, z := range pinned {
+		skip[z.Member.(string)] = struct{}{}
+	}
+	ranked := make([]*params.HotSearch, 0, len(zs))
+	for _, z := range zs {
+		kw := z.Member.(string)
+		if _, ok := skip[kw]; ok {
+			continue
+		}
+		ranked = append(ranked, &params.HotSearch{Keyword: kw, Score: z.Score})
+	}
+
+	res := make([]*params.HotSearch, 0, limit)
+	for int64(len(res)) < limit && (len(pinned) > 0 || len(ranked) > 0) {
+		// 置顶词位置从 1 开始
+		if len(pinned) > 0 && int64(pinned[0].Score) <= int64(len(res))+1 {
+			res = append(res, &params.HotSearch{Keyword: pinned[0].Member.(string), Pinned: true})
+			pinned = pinned[1:]
+			continue
+		}
+		if len(ranked) == 0 {
+			// 热搜不足时剩余置顶词顺延
+			res = append(res, &params.HotSearch{Keyword: pinned[0].Member.(string), Pinned: true})
+			pinned = pinned[1:]
+			continue
+		}
+		res = append(res, ranked[0])
+		ranked = ranked[1:]
+	}
+	return res, nil
+}
+
+// PinHotSearch 置顶热搜词
+func (r *searchRepo) PinHotSearch(ctx context.Context, keyword string, position int32) error {
+	pipe := r.data.rdb.TxPipeline()
+	pipe.SRem(ctx, consts.SearchHotBlockedKey, keyword)
+	pipe.ZAdd(ctx, consts.SearchHotPinnedKey, redis.Z{Score: float64(position), Member: keyword})
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// UnpinHotSearch 取消置顶
+func (r *searchRepo) UnpinHotSearch(ctx context.Context, keyword string) error {
+	return r.data.rdb.ZRem(ctx, consts.SearchHotPinnedKey, keyword).Err()
+}
+
+// BlockHotSearch 屏蔽热搜词，同时取消置顶
+func (r *searchRepo) BlockHotSearch(ctx context.Context, keyword string) error {
+	pipe := r.data.rdb.TxPipeline()
+	pipe.ZRem(ctx, consts.SearchHotPinnedKey, keyword)
+	pipe.SAdd(ctx, consts.SearchHotBlockedKey, keyword)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// UnblockHotSearch 取消屏蔽
+func (r *searchRepo) UnblockHotSearch(ctx context.Context, keyword string) error {
+	return r.data.rdb.SRem(ctx, consts.SearchHotBlockedKey, keyword).Err()
+}
+
+// newHotSearch 热搜榜
+func newHotSearch(rdb *redis.Client) *decayRanking {
+	return &decayRanking{
+		rdb:       rdb,
+		bucketKey: consts.SearchHotBucketKey,
+		key:       consts.SearchHotKey,
+		window:    consts.SearchHotWindow,
+		decay:     consts.SearchHotDecay,
+		cacheTTL:  consts.SearchHotCacheTTL,
+	}
+}
@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math/big"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/data/model"
+	"video-service/internal/data/query"
+	"video-service/internal/pkg/consts"
+)
+
+const shareCodeAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// 分享码冲突时的最大重试次数
+const shareCodeRetry = 3
+
+type shareRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewShareRepo .
+func NewShareRepo(data *Data, logger log.Logger) biz.ShareRepo {
+	return &shareRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetOrCreateShare 获取或生成分享码，新生成时分享数加一并更新视频分数
+func (r *shareRepo) GetOrCreateShare(ctx context.Context, videoID, userID int64, channel int32) (*params.Share, bool, error) {
+	vs := r.data.query.VideoShare
+	share, err := vs.WithContext(ctx).
+		Where(vs.VideoID.Eq(videoID), vs.UserID.Eq(userID), vs.Channel.Eq(channel)).
+		First()
+	if err == nil {
+		return toShareParams(share), false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	for i := 0; i < shareCodeRetry; i++ {
+		code, err := newShareCode()
+		if err != nil {
+			return nil, false, err
+		}
+		share = &model.VideoShare{Code: code, VideoID: videoID, UserID: userID, Channel: channel}
+
+		created := false
+		err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// 分享码或 (video_id, user_id, channel) 冲突时不插入
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(share)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return nil
+			}
+			created = true
+			txQuery := query.Use(tx)
+			_, err := txQuery.Video.WithContext(ctx).
+				Where(txQuery.Video.ID.Eq(videoID)).
+				UpdateSimple(txQuery.Video.ShareCnt.Add(1))
+			return err
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if created {
+			if err := markScoreDirty(ctx, r.data.rdb, videoID); err != nil {
+				r.log.WithContext(ctx).Errorf("mark score dirty err: %v", err)
+			}
+			return toShareParams(share), true, nil
+		}
+
+		// 并发分享时已被其他请求创建，直接复用
+		existing, err := vs.WithContext(ctx).
+			Where(vs.VideoID.Eq(videoID), vs.UserID.Eq(userID), vs.Channel.Eq(channel)).
+			First()
+		if err == nil {
+			return toShareParams(existing), false, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, err
+		}
+		// 分享码冲突，重新生成
+	}
+	return nil, false, errors.New("generate share code failed")
+}
+
+// GetShareByCode 根据分享码获取分享，不存在时返回 nil
+func (r *shareRepo) GetShareByCode(ctx context.Context, code string) (*params.Share, error) {
+	vs := r.data.query.VideoShare
+	share, err := vs.WithContext(ctx).Where(vs.Code.Eq(code)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toShareParams(share), nil
+}
+
+// RecordShareClick 记录分享点击
+func (r *shareRepo) RecordShareClick(ctx context.Context, share *params.Share, click params.ShareClick) error {
+	// 同一访客在去重窗口内只计一次
+	key := fmt.Sprintf(consts.ShareClickDedupKey, share.Code, click.Visitor)
+	ok, err := r.data.rdb.SetNX(ctx, key, 1, consts.ShareClickDedupTTL).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txQuery := query.Use(tx)
+		if err := txQuery.ShareClick.WithContext(ctx).Create(&model.ShareClick{
+			ShareID:    share.ID,
+			VideoID:    share.VideoID,
+			ReferrerID: share.UserID,
+			VisitorID:  click.VisitorID,
+			Visitor:    click.Visitor,
+			Source:     click.Source,
+		}); err != nil {
+			return err
+		}
+		_, err := txQuery.VideoShare.WithContext(ctx).
+			Where(txQuery.VideoShare.ID.Eq(share.ID)).
+			UpdateSimple(txQuery.VideoShare.ClickCnt.Add(1))
+		return err
+	})
+	if err != nil {
+		// 写库失败时释放去重标记，下次点击可以重新记录
+		r.data.rdb.Del(ctx, key)
+		return err
+	}
+	return nil
+}
+
+// newShareCode 生成随机分享码
+func newShareCode() (string, error) {
+	buf := make([]byte, consts.ShareCodeLen)
+	max := big.NewInt(int64(len(shareCodeAlphabet)))
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		buf[i] = shareCodeAlphabet[n.Int64()]
+	}
+	return string(buf), nil
+}
+
+func toShareParams(m *model.VideoShare) *params.Share {
+	return &params.Share{
+		ID:        m.ID,
+		Code:      m.Code,
+		VideoID:   m.VideoID,
+		UserID:    m.UserID,
+		Channel:   m.Channel,
+		ClickCnt:  m.ClickCnt,
+		CreatedAt: m.CreatedAt,
+	}
+}
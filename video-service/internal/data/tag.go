@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/data/model"
+	"video-service/internal/data/query"
+	"video-service/internal/pkg/consts"
+)
+
+type tagRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTagRepo .
+func NewTagRepo(data *Data, logger log.Logger) biz.TagRepo {
+	return &tagRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetTagByName 根据规范化后的话题名获取话题，不存在时返回 nil
+func (r *tagRepo) GetTagByName(ctx context.Context, name string) (*params.Tag, error) {
+	tag, err := r.data.query.Tag.WithContext(ctx).Where(r.data.query.Tag.Name.Eq(name)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toTagParams(tag), nil
+}
+
+// ListVideosByTag 获取话题下的视频，按关联时间倒序
+func (r *tagRepo) ListVideosByTag(ctx context.Context, tagID int64, page, pageSize int32) ([]*params.Video, int32, error) {
+	offset := (page - 1) * pageSize
+	v, vt := r.data.query.Video, r.data.query.VideoTag
+
+	db := v.WithContext(ctx).
+		Join(vt, vt.VideoID.EqCol(v.ID)).
+		Where(vt.TagID.Eq(tagID), v.IsPublic.Is(true), v.PublishStatus.Eq(consts.PublishStatusPublished)).
+		Order(vt.CreatedAt.Desc(), vt.ID.Desc())
+
+	total, err := db.Count()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("list tag video count err: %v", err)
+		return nil, 0, err
+	}
+	videos, err := db.Offset(int(offset)).Limit(int(pageSize)).Find()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("list tag video find err: %v", err)
+		return nil, 0, err
+	}
+
+	res := make([]*params.Video, 0, len(videos))
+	for _, v := range videos {
+		res = append(res, &params.Video{
+			Id:          v.ID,
+			UserId:      v.UserID,
+			PlayUrl:     v.PlayURL,
+			CoverUrl:    v.CoverURL,
+			Title:       v.Title,
+			Description: v.Description,
+			Duration:    v.Duration,
+			Tags:        v.Tags,
+			FavoriteCnt: v.FavoriteCnt,
+			CommentCnt:  v.CommentCnt,
+			ShareCnt:    v.ShareCnt,
+			CollectCnt:  v.CollectCnt,
+			ViewCnt:     v.ViewCnt,
+		})
+	}
+	return res, int32(total), nil
+}
+
+// TrendingTags 热门话题，将最近 TagTrendingWindow 个小时分桶按衰减权重合并
+func (r *tagRepo) TrendingTags(ctx context.Context, limit int64) ([]*params.Tag, error) {
+	zs, err := newTagTrending(r.data.rdb).Top(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(zs) == 0 {
+		return []*params.Tag{}, nil
+	}
+
+	names := make([]string, 0, len(zs))
+	for _, z := range zs {
+		names = append(names, z.Member.(string))
+	}
+	tags, err := r.data.query.Tag.WithContext(ctx).Where(r.data.query.Tag.Name.In(names...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	tagMap := make(map[string]*model.Tag, len(tags))
+	for _, t := range tags {
+		tagMap[t.Name] = t
+	}
+
+	res := make([]*params.Tag, 0, len(zs))
+	for _, z := range zs {
+		t, ok := tagMap[z.Member.(string)]
+		if !ok {
+			continue
+		}
+		tag := toTagParams(t)
+		tag.Score = z.Score
+		res = append(res, tag)
+	}
+	return res, nil
+}
+
+// saveVideoTags 在事务中写入话题及视频-话题关联；话题的视频数只统计可见视频，
+// 由 job-service 在视频变为可见或不可见时重算，这里不累加
+func saveVideoTags(ctx context.Context, tx *query.Query, videoID int64, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	tags := make([]*model.Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &model.Tag{Name: name})
+	}
+	err := tx.Tag.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: tx.Tag.Name.ColumnName().String()}},
+		DoNothing: true,
+	}).Create(tags...)
+	if err != nil {
+		return err
+	}
+
+	// 已存在的话题拿不到 id，重新查一次
+	saved, err := tx.Tag.WithContext(ctx).Where(tx.Tag.Name.In(names...)).Find()
+	if err != nil {
+		return err
+	}
+	videoTags := make([]*model.VideoTag, 0, len(saved))
+	for _, t := range saved {
+		videoTags = append(videoTags, &model.VideoTag{VideoID: videoID, TagID: t.ID})
+	}
+	return tx.VideoTag.WithContext(ctx).Create(videoTags...)
+}
+
+// newTagTrending 热门话题榜
+func newTagTrending(rdb *redis.Client) *decayRanking {
+	return &decayRanking{
+		rdb:       rdb,
+		bucketKey: consts.TagTrendingBucketKey,
+		key:       consts.TagTrendingKey,
+		window:    consts.TagTrendingWindow,
+		decay:     consts.TagTrendingDecay,
+		cacheTTL:  consts.TagTrendingCacheTTL,
+	}
+}
+
+func toTagParams(t *model.Tag) *params.Tag {
+	return &params.Tag{
+		Id:         t.ID,
+		Name:       t.Name,
+		VideoCount: t.VideoCnt,
+		ViewCount:  t.ViewCnt,
+	}
+}
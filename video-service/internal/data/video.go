@@ -1,6 +1,7 @@
 package data
 
 import (
+	"common/rediskey"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,13 +10,16 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 	"io"
-	"math"
+	"strconv"
+	"strings"
 	"time"
 	pbUser "video-service/api/user/v1"
 	v1 "video-service/api/video/v1"
 	"video-service/internal/biz/params"
 	"video-service/internal/data/model"
+	"video-service/internal/data/query"
 	"video-service/internal/pkg/consts"
+	"video-service/internal/pkg/hashtag"
 
 	"video-service/internal/biz"
 
@@ -55,9 +59,9 @@ func (r *videoRepo) VerifyAndRefreshTokens(ctx context.Context, token string, re
 
 // UploadVideo 上传视频
 func (r *videoRepo) UploadVideo(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) (string, error) {
-	url, err := r.data.uploade.Upload(ctx, objectName, reader, size, contentType)
+	url, err := r.data.blob.Put(ctx, objectName, reader, size, contentType)
 	if err != nil {
-		return "", fmt.Errorf("minio upload failed: %w", err)
+		return "", fmt.Errorf("blob upload failed: %w", err)
 	}
 	return url, nil
 }
@@ -92,6 +96,18 @@ func (r *videoRepo) CreateVideo(ctx context.Context, in *params.CreateVideoReq)
 		IsPublic:    in.IsPublic,
 		AuditStatus: consts.AuditStatusPending,
 		IsOriginal:  in.IsOriginal,
+
+		PublishStatus: in.PublishStatus,
+	}
+	if !in.PublishAt.IsZero() {
+		video.PublishAt = &in.PublishAt
+	}
+	if loc := in.Location; loc != nil {
+		if loc.HasCoord {
+			// es 的 geo_point 字符串格式为 "lat,lon"
+			video.GeoLocation = strconv.FormatFloat(loc.Latitude, 'f', -1, 64) + "," + strconv.FormatFloat(loc.Longitude, 'f', -1, 64)
+		}
+		video.CityCode = loc.CityCode
 	}
 
 	// **关键补充：保证 BizExt 不为空**
@@ -99,9 +115,14 @@ func (r *videoRepo) CreateVideo(ctx context.Context, in *params.CreateVideoReq)
 		video.BizExt = "{}"
 	}
 
-	// 1. 保存到sql
-	err := r.data.query.Video.
-		WithContext(ctx).Omit(r.data.query.Video.DeleteAt).Create(video)
+	// 1. 保存到sql，视频与话题在同一事务中写入
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txQuery := query.Use(tx)
+		if err := txQuery.Video.WithContext(ctx).Omit(txQuery.Video.DeleteAt).Create(video); err != nil {
+			return err
+		}
+		return saveVideoTags(ctx, txQuery, video.ID, in.TagList)
+	})
 	if err != nil {
 		r.log.Errorf("Create video err :%v", err)
 		return 0, err
@@ -120,9 +141,11 @@ func (r *videoRepo) CreateVideo(ctx context.Context, in *params.CreateVideoReq)
 		r.log.Errorf("Create video err :%v", err)
 	}
 
-	// 初始化分数
-	err = r.videoScore(ctx, video.ID)
-	if err != nil {
+	// 草稿、定时发布的视频在发布时再初始化分数、写入位置索引
+	if video.PublishStatus != consts.PublishStatusPublished {
+		return video.ID, nil
+	}
+	if err := onPublished(ctx, r.data.rdb, r.log, video, true); err != nil {
 		r.log.Errorf("Create video err :%v", err)
 		return 0, err
 	}
@@ -130,16 +153,124 @@ func (r *videoRepo) CreateVideo(ctx context.Context, in *params.CreateVideoReq)
 	return video.ID, nil
 }
 
-func (r *videoRepo) videoScore(ctx context.Context, videoID int64) error {
-	likeCnt, commentCnt := int64(0), int64(0)
-	uploadTime := timestamppb.New(time.Now())
-	score := r.CalcVideoScore(ctx, likeCnt, commentCnt, uploadTime)
-	err := r.data.rdb.ZAdd(ctx, "video:score", redis.Z{Score: float64(score), Member: videoID}).Err()
+// indexLocation 写入附近视频的 GEO 集合与同城视频列表，同城列表只保留最近的视频
+func indexLocation(ctx context.Context, rdb *redis.Client, videoID int64, createdAt time.Time, loc *params.Location) error {
+	member := strconv.FormatInt(videoID, 10)
+	pipe := rdb.Pipeline()
+	if loc.HasCoord {
+		pipe.GeoAdd(ctx, rediskey.VideoGeo, &redis.GeoLocation{Name: member, Longitude: loc.Longitude, Latitude: loc.Latitude})
+	}
+	if loc.CityCode != "" {
+		key := rediskey.VideoCity(loc.CityCode)
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(createdAt.UnixMilli()), Member: member})
+		pipe.ZRemRangeByRank(ctx, key, 0, -consts.VideoCitySize-1)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// publicLocation 公开视频保存的模糊位置，私密或没有位置时返回 nil
+func publicLocation(v *model.Video) *params.Location {
+	if !v.IsPublic || (v.GeoLocation == "" && v.CityCode == "") {
+		return nil
+	}
+	loc := &params.Location{CityCode: v.CityCode}
+	if lat, lon, ok := strings.Cut(v.GeoLocation, ","); ok {
+		var err1, err2 error
+		loc.Latitude, err1 = strconv.ParseFloat(lat, 64)
+		loc.Longitude, err2 = strconv.ParseFloat(lon, 64)
+		loc.HasCoord = err1 == nil && err2 == nil
+	}
+	return loc
+}
+
+// onPublished 视频发布后写入位置索引（私密视频不写入）、累加话题热度，标记待 job-service 计算分数
+// 立即发布与 job-service 的定时发布事件共用；trending 为 false 时不累加话题热度，用于重复投递的事件
+func onPublished(ctx context.Context, rdb *redis.Client, logger *log.Helper, video *model.Video, trending bool) error {
+	if loc := publicLocation(video); loc != nil {
+		if err := indexLocation(ctx, rdb, video.ID, video.CreatedAt, loc); err != nil {
+			return err
+		}
+	}
+	if trending {
+		// 话题热度不可重复累加，失败时不重试
+		if err := newTagTrending(rdb).Incr(ctx, hashtag.Split(video.Tags), 1); err != nil {
+			logger.Errorf("incr tag trending err :%v", err)
+		}
+	}
+
+	// 由 job-service 按 score.weights 计算初始分数并加入榜单
+	return markScoreDirty(ctx, rdb, video.ID)
+}
+
+// GetVideoByID 根据id获取视频，不存在时返回 nil
+func (r *videoRepo) GetVideoByID(ctx context.Context, videoID int64) (*params.Video, error) {
+	v, err := r.data.query.Video.WithContext(ctx).Where(r.data.query.Video.ID.Eq(videoID)).First()
 	if err != nil {
-		r.log.Errorf("Score err: %v", err)
-		return err
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
 	}
-	return nil
+	return toVideoParams(v), nil
+}
+
+// PublishVideo 立即发布草稿或定时视频，发布时间即为创建时间；返回是否由本次调用发布
+func (r *videoRepo) PublishVideo(ctx context.Context, videoID int64) (bool, error) {
+	v := r.data.query.Video
+	now := time.Now()
+	// 只更新未发布的视频，避免与 job-service 的定时发布重复
+	result, err := v.WithContext(ctx).
+		Where(v.ID.Eq(videoID), v.PublishStatus.Neq(consts.PublishStatusPublished)).
+		UpdateSimple(v.PublishStatus.Value(consts.PublishStatusPublished), v.PublishAt.Value(now), v.CreatedAt.Value(now))
+	if err != nil {
+		return false, err
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	video, err := v.WithContext(ctx).Where(v.ID.Eq(videoID)).First()
+	if err != nil {
+		return true, err
+	}
+	return true, onPublished(ctx, r.data.rdb, r.log, video, true)
+}
+
+// ScheduleVideo 设置定时发布
+func (r *videoRepo) ScheduleVideo(ctx context.Context, videoID int64, publishAt time.Time) error {
+	v := r.data.query.Video
+	_, err := v.WithContext(ctx).
+		Where(v.ID.Eq(videoID), v.PublishStatus.Neq(consts.PublishStatusPublished)).
+		UpdateSimple(v.PublishStatus.Value(consts.PublishStatusScheduled), v.PublishAt.Value(publishAt))
+	return err
+}
+
+// ListDraftVideos 获取用户未发布的视频，按更新时间倒序
+func (r *videoRepo) ListDraftVideos(ctx context.Context, userID int64, page, pageSize int32) ([]*params.Video, int32, error) {
+	offset := (page - 1) * pageSize
+	v := r.data.query.Video
+
+	db := v.WithContext(ctx).
+		Where(v.UserID.Eq(userID), v.PublishStatus.Neq(consts.PublishStatusPublished)).
+		Order(v.UpdateTime.Desc(), v.ID.Desc())
+
+	total, err := db.Count()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("list draft video count err: %v", err)
+		return nil, 0, err
+	}
+	videos, err := db.Offset(int(offset)).Limit(int(pageSize)).Find()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("list draft video find err: %v", err)
+		return nil, 0, err
+	}
+
+	res := make([]*params.Video, 0, len(videos))
+	for _, video := range videos {
+		res = append(res, toVideoParams(video))
+	}
+	return res, int32(total), nil
 }
 
 // ListUserVideos 根据用户id获取视频列表
@@ -148,7 +279,7 @@ func (r *videoRepo) ListUserVideos(ctx context.Context, userID int64, page int32
 
 	db := r.data.query.Video.
 		WithContext(ctx).
-		Where(r.data.query.Video.UserID.Eq(userID)).
+		Where(r.data.query.Video.UserID.Eq(userID), r.data.query.Video.PublishStatus.Eq(consts.PublishStatusPublished)).
 		Order(r.data.query.Video.CreatedAt.Desc(), r.data.query.Video.ID.Desc())
 
 	total, err := db.Count()
@@ -177,6 +308,7 @@ func (r *videoRepo) ListUserVideos(ctx context.Context, userID int64, page int32
 			CommentCnt:  v.CommentCnt,
 			ShareCnt:    v.ShareCnt,
 			CollectCnt:  v.CollectCnt,
+			ViewCnt:     v.ViewCnt,
 		})
 	}
 
@@ -197,7 +329,7 @@ func (r *videoRepo) BatchGetVideoInfo(ctx context.Context, ids []int64, page, pa
 	//}
 	//offset := (page - 1) * pageSize
 
-	videos, err := r.data.query.Video.WithContext(ctx).Where(r.data.query.Video.UserID.In(ids...)).Find()
+	videos, err := r.data.query.Video.WithContext(ctx).Where(r.data.query.Video.ID.In(ids...)).Find()
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +350,7 @@ func (r *videoRepo) BatchGetVideoInfo(ctx context.Context, ids []int64, page, pa
 			Title:       v.Title,
 			FavoriteCnt: v.FavoriteCnt,
 			CommentCnt:  v.CommentCnt,
+			ViewCnt:     v.ViewCnt,
 			CreatedAt:   timestamppb.New(v.CreatedAt),
 		})
 	}
@@ -236,26 +369,21 @@ func (r *videoRepo) CheckVideoExistsByID(ctx context.Context, videoID int64) (bo
 	return true, nil
 }
 
-func (r *videoRepo) CalcVideoScore(ctx context.Context, likeCnt int64, commentCnt int64, uploadTime *timestamppb.Timestamp) float64 {
-	hours := time.Since(uploadTime.AsTime()).Hours()
-	r.log.WithContext(ctx).Infof("CalcVideoScore likeCnt: %d, commentCnt: %d, uploadTime: %s", likeCnt, commentCnt, hours)
-	if hours < 0 {
-		hours = 0
-	}
-	timeDecay := 1 / math.Pow(hours+2, 1.2)
-	return float64(likeCnt)*1 + float64(commentCnt)*2 + 1000*timeDecay
-}
-
-func (r *videoRepo) GetVideoFavoriteAndCommentCount(ctx context.Context, videoID int64) (int64, int64, time.Time, error) {
+func (r *videoRepo) GetVideoFavoriteAndCommentCount(ctx context.Context, videoID int64) (*params.VideoStats, error) {
 	r.log.WithContext(ctx).Infof("GetVideoFavoriteAndCommentCount videoID: %d", videoID)
 	videoInfo, err := r.data.query.Video.WithContext(ctx).Where(r.data.query.Video.ID.Eq(videoID)).First()
 	if err != nil {
 		r.log.WithContext(ctx).Errorf("get video err: %v", err)
-		return 0, 0, time.Time{}, err
+		return nil, err
 	}
 
-	return int64(videoInfo.FavoriteCnt), int64(videoInfo.CommentCnt), videoInfo.CreatedAt, nil
-
+	return &params.VideoStats{
+		FavoriteCnt: int64(videoInfo.FavoriteCnt),
+		CommentCnt:  int64(videoInfo.CommentCnt),
+		ViewCnt:     videoInfo.ViewCnt,
+		ShareCnt:    int64(videoInfo.ShareCnt),
+		UploadTime:  videoInfo.CreatedAt,
+	}, nil
 }
 
 func (r *videoRepo) GetVideoByTitle(ctx context.Context, title string) ([]*v1.Video, error) {
@@ -287,12 +415,12 @@ func (r *videoRepo) GetVideoByTitle(ctx context.Context, title string) ([]*v1.Vi
 
 	videos := make([]*v1.Video, 0, len(res.Hits.Hits))
 	for _, hit := range res.Hits.Hits {
-		var video v1.Video
-		if err := json.Unmarshal(hit.Source_, &video); err != nil {
+		video, err := decodeEsVideo(hit.Source_)
+		if err != nil {
 			r.log.WithContext(ctx).Errorf("unmarshal video err: %v, source: %s", err, string(hit.Source_))
 			continue
 		}
-		videos = append(videos, &video)
+		videos = append(videos, video)
 	}
 
 	if len(videos) == 0 {
@@ -307,8 +435,9 @@ func (r *videoRepo) getVideoByTitleFromDB(ctx context.Context, title string) ([]
 
 	res, err := r.data.query.Video.
 		WithContext(ctx).
-		Where(r.data.query.Video.Title.Like(fmt.Sprintf("%%%s%%", title))).
+		Where(r.data.query.Video.Title.Like(fmt.Sprintf("%%%s%%", title)), r.data.query.Video.PublishStatus.Eq(consts.PublishStatusPublished)).
 		Order(r.data.query.Video.CreatedAt.Desc()).
+		Limit(20).
 		Find()
 	if err != nil {
 		return nil, err
@@ -330,7 +459,34 @@ func (r *videoRepo) getVideoByTitleFromDB(ctx context.Context, title string) ([]
 
 			ShareCnt:   v.ShareCnt,
 			CollectCnt: v.CollectCnt,
+			ViewCnt:    v.ViewCnt,
 		})
 	}
 	return videos, nil
 }
+
+func toVideoParams(v *model.Video) *params.Video {
+	res := &params.Video{
+		Id:            v.ID,
+		UserId:        v.UserID,
+		PlayUrl:       v.PlayURL,
+		CoverUrl:      v.CoverURL,
+		Title:         v.Title,
+		Description:   v.Description,
+		Duration:      v.Duration,
+		Tags:          v.Tags,
+		FavoriteCnt:   v.FavoriteCnt,
+		CommentCnt:    v.CommentCnt,
+		ShareCnt:      v.ShareCnt,
+		CollectCnt:    v.CollectCnt,
+		ViewCnt:       v.ViewCnt,
+		PublishStatus: v.PublishStatus,
+		UpdateTime:    v.UpdateTime,
+		IsPublic:      v.IsPublic,
+		Deleted:       !v.DeleteAt.IsZero(),
+	}
+	if v.PublishAt != nil {
+		res.PublishAt = *v.PublishAt
+	}
+	return res
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"common/rediskey"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/data/model"
+	"video-service/internal/data/query"
+	"video-service/internal/pkg/consts"
+)
+
+type videoEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewVideoEventRepo .
+func NewVideoEventRepo(data *Data, logger log.Logger) biz.VideoEventRepo {
+	return &videoEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ApplyEvent 先写入已处理事件表去重，再更新视频计数；发布事件只记录，副作用由 ApplyPublished 执行
+func (r *videoEventRepo) ApplyEvent(ctx context.Context, event *params.VideoEvent) (bool, error) {
+	applied := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ProcessedEvent{
+			EventID:   event.EventID,
+			EventType: event.Type,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		applied = true
+		if event.Type == consts.EventVideoPublished {
+			return nil
+		}
+
+		v := query.Use(tx).Video
+		var cnt field.Int32
+		switch event.Type {
+		case consts.EventVideoLiked, consts.EventVideoUnliked:
+			cnt = v.FavoriteCnt
+		case consts.EventVideoCollected, consts.EventVideoUncollected:
+			cnt = v.CollectCnt
+		case consts.EventCommentCreated, consts.EventCommentDeleted:
+			cnt = v.CommentCnt
+		}
+		do := v.WithContext(ctx).Where(v.ID.Eq(event.VideoID))
+		var err error
+		switch event.Type {
+		case consts.EventVideoLiked, consts.EventVideoCollected, consts.EventCommentCreated:
+			_, err = do.UpdateSimple(cnt.Add(1))
+		default:
+			// 计数不减到负数，乱序或历史数据不一致时保持为 0
+			_, err = do.Where(cnt.Gt(0)).UpdateSimple(cnt.Sub(1))
+		}
+		return err
+	})
+	return applied, err
+}
+
+// MarkScoreDirty 交给 job-service 按配置的权重重算分数
+func (r *videoEventRepo) MarkScoreDirty(ctx context.Context, videoID int64) error {
+	return markScoreDirty(ctx, r.data.rdb, videoID)
+}
+
+// ApplyPublished 重新读取视频，仍为已发布且未删除时执行与立即发布相同的副作用
+func (r *videoEventRepo) ApplyPublished(ctx context.Context, videoID int64, trending bool) error {
+	v := r.data.query.Video
+	video, err := v.WithContext(ctx).
+		Where(v.ID.Eq(videoID), v.PublishStatus.Eq(consts.PublishStatusPublished), v.DeleteAt.IsNull()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	return onPublished(ctx, r.data.rdb, r.log, video, trending)
+}
+
+// markScoreDirty video:score 只由 job-service 按 score.weights 写入，其他变更只标记待重算
+func markScoreDirty(ctx context.Context, rdb *redis.Client, videoID int64) error {
+	return rdb.SAdd(ctx, rediskey.VideoScoreDirty, videoID).Err()
+}
+
+// DeleteProcessedEvents 按处理时间取出一批过期事件再按主键删除
+func (r *videoEventRepo) DeleteProcessedEvents(ctx context.Context, before time.Time, limit int) (int64, error) {
+	pe := r.data.query.ProcessedEvent
+	var ids []string
+	if err := pe.WithContext(ctx).Where(pe.CreatedAt.Lt(before)).Order(pe.CreatedAt).Limit(limit).Pluck(pe.EventID, &ids); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res, err := pe.WithContext(ctx).Where(pe.EventID.In(ids...)).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected, nil
+}
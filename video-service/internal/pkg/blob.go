@@ -0,0 +1,54 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrBlobNotFound     = errors.New("blob not found")
+	ErrInvalidBlobKey   = errors.New("invalid blob key")
+	ErrUploadNotFound   = errors.New("multipart upload not found")
+	ErrInvalidSignature = errors.New("invalid or expired signature")
+)
+
+// BlobInfo 对象元信息
+type BlobInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// BlobPart 分片上传中已上传的分片
+type BlobPart struct {
+	Number int
+	ETag   string
+	Size   int64
+}
+
+// BlobStore 对象存储，key 为 bucket 内的对象名，如 video/1/a.mp4
+type BlobStore interface {
+	// Put 上传对象，返回外部可访问的地址
+	Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) (string, error)
+	// Get 读取对象，返回值支持 Seek，调用方负责关闭
+	Get(ctx context.Context, key string) (io.ReadSeekCloser, *BlobInfo, error)
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	// List 按 key 前缀遍历对象，fn 返回错误时停止遍历并返回该错误
+	List(ctx context.Context, prefix string, fn func(*BlobInfo) error) error
+	// Delete 删除对象，对象不存在时不报错
+	Delete(ctx context.Context, key string) error
+	// URL 对象的公开访问地址
+	URL(key string) string
+	PresignGet(ctx context.Context, key string, expires time.Duration) (string, error)
+	PresignPut(ctx context.Context, key string, expires time.Duration) (string, error)
+
+	// 分片上传
+	NewMultipartUpload(ctx context.Context, key string, contentType string) (uploadID string, err error)
+	PutPart(ctx context.Context, key, uploadID string, number int, reader io.Reader, size int64) (BlobPart, error)
+	CompleteMultipartUpload(ctx context.Context, key, uploadID string, parts []BlobPart) (string, error)
+	AbortMultipartUpload(ctx context.Context, key, uploadID string) error
+}
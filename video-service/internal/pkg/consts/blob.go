@@ -0,0 +1,6 @@
+package consts
+
+const (
+	// BlobGCLockKey 上传对象清理的分布式锁
+	BlobGCLockKey = "blob:gc:lock"
+)
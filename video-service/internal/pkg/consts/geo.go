@@ -0,0 +1,10 @@
+package consts
+
+const (
+	// VideoCitySize 每个城市保留的最近视频数
+	VideoCitySize = 1000
+	// GeoGridDegrees 坐标模糊的网格大小，约 1 公里，只保存网格中心
+	GeoGridDegrees = 0.01
+	// CityCodeMaxLen 城市代码最大长度
+	CityCodeMaxLen = 12
+)
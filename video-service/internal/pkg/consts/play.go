@@ -0,0 +1,14 @@
+package consts
+
+import "time"
+
+const (
+	// VideoDailyViewerKey 视频每日观众数估算 HyperLogLog，%d 为视频id，%s 为 20060102 格式的日期
+	VideoDailyViewerKey = "video:uv:%d:%s"
+	// VideoDailyViewKey 观众当天是否已计入播放数，%d 为视频id，%s 依次为日期与观众标识
+	VideoDailyViewKey = "video:view:%d:%s:%s"
+	// VideoDailyViewerTTL 去重数据保留时间，跨天上报时仍能命中前一天
+	VideoDailyViewerTTL = 48 * time.Hour
+	// PlayMaxWatchMs 单次上报观看时长上限，防止异常值
+	PlayMaxWatchMs = int64(6 * time.Hour / time.Millisecond)
+)
@@ -0,0 +1,28 @@
+package consts
+
+import "time"
+
+const (
+	// SearchHistoryKey 用户搜索历史 list，%d 为用户id
+	SearchHistoryKey = "search:history:%d"
+	// SearchHistorySize 每个用户保留的搜索历史条数
+	SearchHistorySize = 20
+
+	// SearchHotBucketKey 热搜词按小时分桶，%s 为 2006010215 格式的小时
+	SearchHotBucketKey = "search:hot:%s"
+	// SearchHotKey 合并各小时分桶后的热搜榜
+	SearchHotKey = "search:hot"
+	// SearchHotPinnedKey 置顶热搜词 zset，分数为置顶位置
+	SearchHotPinnedKey = "search:hot:pinned"
+	// SearchHotBlockedKey 屏蔽热搜词 set
+	SearchHotBlockedKey = "search:hot:blocked"
+	// SearchHotWindow 热搜统计的小时数
+	SearchHotWindow = 24
+	// SearchHotDecay 每过一小时热度衰减系数
+	SearchHotDecay = 0.8
+	// SearchHotCacheTTL 合并榜单缓存时间
+	SearchHotCacheTTL = time.Minute
+
+	// SearchTermMaxLen 搜索词最大字符数
+	SearchTermMaxLen = 64
+)
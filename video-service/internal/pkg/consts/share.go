@@ -0,0 +1,12 @@
+package consts
+
+import "time"
+
+const (
+	// ShareCodeLen 分享码长度
+	ShareCodeLen = 8
+	// ShareClickDedupKey 分享点击去重，%s 为分享码与访客标识
+	ShareClickDedupKey = "share:click:%s:%s"
+	// ShareClickDedupTTL 同一访客重复点击不重复计数的时间
+	ShareClickDedupTTL = 24 * time.Hour
+)
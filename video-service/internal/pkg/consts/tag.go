@@ -0,0 +1,16 @@
+package consts
+
+import "time"
+
+const (
+	// TagTrendingBucketKey 话题热度按小时分桶，%s 为 2006010215 格式的小时
+	TagTrendingBucketKey = "tag:trending:%s"
+	// TagTrendingKey 合并各小时分桶后的热门话题榜
+	TagTrendingKey = "tag:trending"
+	// TagTrendingWindow 热门话题统计的小时数
+	TagTrendingWindow = 24
+	// TagTrendingDecay 每过一小时热度衰减系数
+	TagTrendingDecay = 0.8
+	// TagTrendingCacheTTL 合并榜单缓存时间
+	TagTrendingCacheTTL = time.Minute
+)
@@ -0,0 +1,14 @@
+package consts
+
+const (
+	// 点赞、收藏、评论事件类型，由 favorite-service、comment-service 经 outbox 发布
+	EventVideoLiked       = "VideoLiked"
+	EventVideoUnliked     = "VideoUnliked"
+	EventVideoCollected   = "VideoCollected"
+	EventVideoUncollected = "VideoUncollected"
+	EventCommentCreated   = "CommentCreated"
+	EventCommentDeleted   = "CommentDeleted"
+
+	// EventVideoPublished 定时视频到期发布，由 job-service 经 outbox 发布，发布后的副作用由本服务执行
+	EventVideoPublished = "VideoPublished"
+)
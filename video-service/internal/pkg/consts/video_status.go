@@ -1,7 +1,22 @@
 package consts
 
+import "time"
+
 const (
 	AuditStatusPending = 1
 	AuditStatusPassed  = 2
 	AuditStatusFailed  = 3
 )
+
+const (
+	PublishStatusPublished = 0 // 已发布
+	PublishStatusDraft     = 1 // 草稿
+	PublishStatusScheduled = 2 // 定时发布，到期后由 job-service 发布
+)
+
+const (
+	// ScheduleMinLead 定时发布时间距当前不足该时长时直接发布
+	ScheduleMinLead = time.Minute
+	// ScheduleMaxAhead 最多可提前多久设置定时发布
+	ScheduleMaxAhead = 30 * 24 * time.Hour
+)
@@ -0,0 +1,98 @@
+package hashtag
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTagLen 单个话题最大字符数
+	MaxTagLen = 32
+	// MaxTagsPerVideo 单个视频最多关联的话题数
+	MaxTagsPerVideo = 10
+)
+
+// Normalize 规范化话题：去掉前缀#、转小写，只保留字母、数字、下划线
+// 不合法时返回空串
+func Normalize(tag string) string {
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimLeft(tag, "#＃")
+	var b strings.Builder
+	for _, r := range tag {
+		if !isTagRune(r) {
+			break
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	res := b.String()
+	if utf8.RuneCountInString(res) > MaxTagLen {
+		res = string([]rune(res)[:MaxTagLen])
+	}
+	return res
+}
+
+// Extract 从文本中提取 #话题
+func Extract(text string) []string {
+	var res []string
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '#' && runes[i] != '＃' {
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && isTagRune(runes[j]) {
+			j++
+		}
+		if tag := Normalize(string(runes[i+1 : j])); tag != "" {
+			res = append(res, tag)
+		}
+		i = j - 1
+	}
+	return res
+}
+
+// Split 解析用户手动填写的 tags 字段，支持逗号、空格、# 分隔
+func Split(tags string) []string {
+	fields := strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ',' || r == '，' || r == '#' || r == '＃' || unicode.IsSpace(r)
+	})
+	res := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if tag := Normalize(f); tag != "" {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
+
+// Parse 汇总标题、描述中的 #话题 以及手填的 tags，去重后按出现顺序返回
+func Parse(title, description, tags string) []string {
+	all := make([]string, 0)
+	all = append(all, Split(tags)...)
+	all = append(all, Extract(title)...)
+	all = append(all, Extract(description)...)
+
+	seen := make(map[string]struct{}, len(all))
+	res := make([]string, 0, len(all))
+	for _, tag := range all {
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+		if len(res) >= MaxTagsPerVideo {
+			break
+		}
+	}
+	return res
+}
+
+// Join 将话题列表拼接为 videos.tags 中存储的格式
+func Join(tags []string) string {
+	return strings.Join(tags, ",")
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
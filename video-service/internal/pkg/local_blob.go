@@ -0,0 +1,424 @@
+package pkg
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"video-service/internal/conf"
+)
+
+// 分片上传的临时目录，位于 root 下
+const localMultipartDir = ".multipart"
+
+// LocalBlobStore 本地磁盘存储，用于开发与集成测试，文件通过 video-service 的 HTTP 服务访问
+type LocalBlobStore struct {
+	root    string
+	baseURL string // 访问地址，以 / 结尾
+	prefix  string // baseURL 中的路径，作为 HTTP 路由前缀
+	secret  []byte // 预签名密钥
+}
+
+// NewLocalBlobStore 初始化本地存储目录
+func NewLocalBlobStore(cfg *conf.Data_Storage_Local) (*LocalBlobStore, error) {
+	if cfg.GetRoot() == "" || cfg.GetBaseUrl() == "" {
+		return nil, errors.New("local storage requires root and base_url")
+	}
+	root, err := filepath.Abs(cfg.Root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(root, localMultipartDir), 0o755); err != nil {
+		return nil, err
+	}
+
+	baseURL := strings.TrimSuffix(cfg.BaseUrl, "/") + "/"
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalBlobStore{
+		root:    root,
+		baseURL: baseURL,
+		prefix:  u.Path,
+		secret:  []byte(cfg.Secret),
+	}, nil
+}
+
+// Prefix HTTP 路由前缀
+func (s *LocalBlobStore) Prefix() string {
+	return s.prefix
+}
+
+// Put 写入临时文件后重命名，避免读到写了一半的文件
+func (s *LocalBlobStore) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) (string, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return "", err
+	}
+	if size >= 0 {
+		reader = io.LimitReader(reader, size)
+	}
+	if _, err := writeFileAtomic(name, reader); err != nil {
+		return "", err
+	}
+	return s.URL(key), nil
+}
+
+// Get 读取对象
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadSeekCloser, *BlobInfo, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, toLocalError(err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if fi.IsDir() {
+		f.Close()
+		return nil, nil, ErrBlobNotFound
+	}
+	return f, s.info(key, fi), nil
+}
+
+// Stat 获取对象元信息，ContentType 按扩展名推断
+func (s *LocalBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return nil, toLocalError(err)
+	}
+	if fi.IsDir() {
+		return nil, ErrBlobNotFound
+	}
+	return s.info(key, fi), nil
+}
+
+// List 按前缀遍历对象，跳过分片目录与写入中的临时文件
+func (s *LocalBlobStore) List(ctx context.Context, prefix string, fn func(*BlobInfo) error) error {
+	// 从前缀所在的目录开始遍历
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+prefix[:i])))
+	}
+	err := filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		rel, err := filepath.Rel(s.root, name)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if d.IsDir() {
+			if key == localMultipartDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".tmp-") || !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			// 遍历期间被删除
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		return fn(s.info(key, fi))
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Delete 删除对象，对象不存在时不报错
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// URL 对象的访问地址
+func (s *LocalBlobStore) URL(key string) string {
+	return s.baseURL + strings.TrimPrefix(path.Clean("/"+key), "/")
+}
+
+// PresignGet 本地文件可直接访问，签名仅为与 MinIO 保持一致
+func (s *LocalBlobStore) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	return s.presign(http.MethodGet, key, expires), nil
+}
+
+// PresignPut 生成限时上传地址，客户端 PUT 到 video-service 的 HTTP 服务
+func (s *LocalBlobStore) PresignPut(ctx context.Context, key string, expires time.Duration) (string, error) {
+	if len(s.secret) == 0 {
+		return "", errors.New("local storage presign requires secret")
+	}
+	return s.presign(http.MethodPut, key, expires), nil
+}
+
+// NewMultipartUpload 开始分片上传，分片暂存在 .multipart/<uploadID> 下
+func (s *LocalBlobStore) NewMultipartUpload(ctx context.Context, key string, contentType string) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	uploadID := hex.EncodeToString(buf)
+	dir := filepath.Join(s.root, localMultipartDir, uploadID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	// 记录对象名，防止用其他 key 完成上传
+	if err := os.WriteFile(filepath.Join(dir, "key"), []byte(key), 0o644); err != nil {
+		return "", err
+	}
+	return uploadID, nil
+}
+
+// PutPart 上传分片，number 从 1 开始
+func (s *LocalBlobStore) PutPart(ctx context.Context, key, uploadID string, number int, reader io.Reader, size int64) (BlobPart, error) {
+	if number <= 0 {
+		return BlobPart{}, fmt.Errorf("invalid part number %d", number)
+	}
+	dir, err := s.uploadDir(key, uploadID)
+	if err != nil {
+		return BlobPart{}, err
+	}
+	if size >= 0 {
+		reader = io.LimitReader(reader, size)
+	}
+	h := md5.New()
+	n, err := writeFileAtomic(filepath.Join(dir, strconv.Itoa(number)), io.TeeReader(reader, h))
+	if err != nil {
+		return BlobPart{}, err
+	}
+	return BlobPart{Number: number, ETag: hex.EncodeToString(h.Sum(nil)), Size: n}, nil
+}
+
+// CompleteMultipartUpload 按分片顺序合并成完整对象
+func (s *LocalBlobStore) CompleteMultipartUpload(ctx context.Context, key, uploadID string, parts []BlobPart) (string, error) {
+	dir, err := s.uploadDir(key, uploadID)
+	if err != nil {
+		return "", err
+	}
+	if len(parts) == 0 {
+		return "", errors.New("no parts to complete")
+	}
+	files := make([]*os.File, 0, len(parts))
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+	readers := make([]io.Reader, 0, len(parts))
+	for i, p := range parts {
+		if i > 0 && p.Number <= parts[i-1].Number {
+			return "", errors.New("parts must be in ascending order")
+		}
+		f, err := os.Open(filepath.Join(dir, strconv.Itoa(p.Number)))
+		if err != nil {
+			return "", fmt.Errorf("part %d: %w", p.Number, toLocalError(err))
+		}
+		files = append(files, f)
+		readers = append(readers, f)
+	}
+
+	u, err := s.Put(ctx, key, io.MultiReader(readers...), -1, "")
+	if err != nil {
+		return "", err
+	}
+	os.RemoveAll(dir)
+	return u, nil
+}
+
+// AbortMultipartUpload 取消分片上传并清理已上传的分片
+func (s *LocalBlobStore) AbortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	dir, err := s.uploadDir(key, uploadID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// ServeHTTP GET/HEAD 读取文件，支持 Range；PUT 需携带预签名参数
+func (s *LocalBlobStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, s.prefix)
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		f, info, err := s.Get(r.Context(), key)
+		if err != nil {
+			writeBlobError(w, err)
+			return
+		}
+		defer f.Close()
+		if info.ContentType != "" {
+			w.Header().Set("Content-Type", info.ContentType)
+		}
+		// ServeContent 处理 Range、If-Modified-Since 等请求头
+		http.ServeContent(w, r, path.Base(key), info.LastModified, f)
+	case http.MethodPut:
+		if err := s.verify(http.MethodPut, key, r.URL.Query()); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if _, err := s.Put(r.Context(), key, r.Body, r.ContentLength, r.Header.Get("Content-Type")); err != nil {
+			writeBlobError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// path 将 key 转换为 root 下的文件路径，拒绝跳出 root 的 key
+func (s *LocalBlobStore) path(key string) (string, error) {
+	clean := strings.TrimPrefix(path.Clean("/"+key), "/")
+	if clean == "" || clean != strings.TrimPrefix(key, "/") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidBlobKey, key)
+	}
+	if clean == localMultipartDir || strings.HasPrefix(clean, localMultipartDir+"/") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidBlobKey, key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+// uploadDir 获取分片目录，并校验 key 与创建时一致
+func (s *LocalBlobStore) uploadDir(key, uploadID string) (string, error) {
+	if uploadID == "" || strings.ContainsAny(uploadID, `/\.`) {
+		return "", ErrUploadNotFound
+	}
+	dir := filepath.Join(s.root, localMultipartDir, uploadID)
+	saved, err := os.ReadFile(filepath.Join(dir, "key"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrUploadNotFound
+		}
+		return "", err
+	}
+	if string(saved) != key {
+		return "", ErrUploadNotFound
+	}
+	return dir, nil
+}
+
+func (s *LocalBlobStore) info(key string, fi os.FileInfo) *BlobInfo {
+	return &BlobInfo{
+		Key:          key,
+		Size:         fi.Size(),
+		ContentType:  mime.TypeByExtension(path.Ext(key)),
+		ETag:         fmt.Sprintf("%x-%x", fi.ModTime().UnixNano(), fi.Size()),
+		LastModified: fi.ModTime(),
+	}
+}
+
+func (s *LocalBlobStore) presign(method, key string, expires time.Duration) string {
+	exp := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	q := url.Values{}
+	q.Set("expires", exp)
+	q.Set("signature", s.sign(method, key, exp))
+	return s.URL(key) + "?" + q.Encode()
+}
+
+func (s *LocalBlobStore) verify(method, key string, q url.Values) error {
+	if len(s.secret) == 0 {
+		return ErrInvalidSignature
+	}
+	exp, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		return ErrInvalidSignature
+	}
+	want := s.sign(method, key, q.Get("expires"))
+	if !hmac.Equal([]byte(want), []byte(q.Get("signature"))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) sign(method, key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(method + "\n" + key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// writeFileAtomic 先写同目录下的临时文件，完成后重命名
+func writeFileAtomic(name string, reader io.Reader) (int64, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(tmp, reader)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), name)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return 0, err
+	}
+	return n, nil
+}
+
+func toLocalError(err error) error {
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrBlobNotFound
+	}
+	return err
+}
+
+func writeBlobError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrBlobNotFound) {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, ErrInvalidBlobKey) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
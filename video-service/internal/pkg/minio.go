@@ -6,17 +6,20 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"io"
+	"net/url"
+	"time"
 	"video-service/internal/conf"
 )
 
-type MinioUploader struct {
+type MinioBlobStore struct {
 	client     *minio.Client // MinIO 客户端
+	core       minio.Core    // 分片上传使用的底层接口
 	bucketName string        // 存储桶名称
 	endpoint   string        // MinIO 访问地址（含端口）
 }
 
-// NewMinioUploader 初始化 MinIO 客户端并确保 bucket 存在
-func NewMinioUploader(cfg *conf.Data_MinIO) (*MinioUploader, error) {
+// NewMinioBlobStore 初始化 MinIO 客户端并确保 bucket 存在
+func NewMinioBlobStore(cfg *conf.Data_MinIO) (*MinioBlobStore, error) {
 	// 创建 MinIO 客户端
 	client, err := minio.New(cfg.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
@@ -40,21 +43,143 @@ func NewMinioUploader(cfg *conf.Data_MinIO) (*MinioUploader, error) {
 		}
 	}
 
-	return &MinioUploader{
+	return &MinioBlobStore{
 		client:     client,
+		core:       minio.Core{Client: client},
 		bucketName: cfg.BucketName,
 		endpoint:   cfg.Endpoint,
 	}, nil
 }
 
-// Upload 上传文件到 MinIO 并返回外部可访问的 URL
-func (u *MinioUploader) Upload(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) (string, error) {
+// Put 上传文件到 MinIO 并返回外部可访问的 URL
+func (s *MinioBlobStore) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) (string, error) {
 	// 上传对象
-	_, err := u.client.PutObject(ctx, u.bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType})
+	_, err := s.client.PutObject(ctx, s.bucketName, key, reader, size, minio.PutObjectOptions{ContentType: contentType})
 	if err != nil {
 		return "", err
 	}
-	// 构建播放地址（假设 MinIO 配置了公共访问）
-	playURL := fmt.Sprintf("http://%s/%s/%s", u.endpoint, u.bucketName, objectName)
-	return playURL, nil
+	return s.URL(key), nil
+}
+
+// Get 读取对象
+func (s *MinioBlobStore) Get(ctx context.Context, key string) (io.ReadSeekCloser, *BlobInfo, error) {
+	obj, err := s.client.GetObject(ctx, s.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, toBlobError(err)
+	}
+	// GetObject 不会立即请求，通过 Stat 确认对象存在
+	stat, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, nil, toBlobError(err)
+	}
+	return obj, toBlobInfo(stat), nil
+}
+
+// Stat 获取对象元信息
+func (s *MinioBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	stat, err := s.client.StatObject(ctx, s.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, toBlobError(err)
+	}
+	return toBlobInfo(stat), nil
+}
+
+// List 按前缀递归遍历对象
+func (s *MinioBlobStore) List(ctx context.Context, prefix string, fn func(*BlobInfo) error) error {
+	// 提前结束遍历时取消 ctx，让 ListObjects 的 goroutine 退出
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for obj := range s.client.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		if err := fn(toBlobInfo(obj)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete 删除对象，MinIO 删除不存在的对象不会报错
+func (s *MinioBlobStore) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucketName, key, minio.RemoveObjectOptions{})
+}
+
+// URL 构建播放地址（假设 MinIO 配置了公共访问）
+func (s *MinioBlobStore) URL(key string) string {
+	return fmt.Sprintf("http://%s/%s/%s", s.endpoint, s.bucketName, key)
+}
+
+// PresignGet 生成限时下载地址
+func (s *MinioBlobStore) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	u, err := s.client.PresignedGetObject(ctx, s.bucketName, key, expires, url.Values{})
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// PresignPut 生成限时上传地址，客户端可直接 PUT 到 MinIO
+func (s *MinioBlobStore) PresignPut(ctx context.Context, key string, expires time.Duration) (string, error) {
+	u, err := s.client.PresignedPutObject(ctx, s.bucketName, key, expires)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// NewMultipartUpload 开始分片上传
+func (s *MinioBlobStore) NewMultipartUpload(ctx context.Context, key string, contentType string) (string, error) {
+	return s.core.NewMultipartUpload(ctx, s.bucketName, key, minio.PutObjectOptions{ContentType: contentType})
+}
+
+// PutPart 上传分片，number 从 1 开始
+func (s *MinioBlobStore) PutPart(ctx context.Context, key, uploadID string, number int, reader io.Reader, size int64) (BlobPart, error) {
+	part, err := s.core.PutObjectPart(ctx, s.bucketName, key, uploadID, number, reader, size, minio.PutObjectPartOptions{})
+	if err != nil {
+		return BlobPart{}, toBlobError(err)
+	}
+	return BlobPart{Number: part.PartNumber, ETag: part.ETag, Size: part.Size}, nil
+}
+
+// CompleteMultipartUpload 按分片顺序合并成完整对象
+func (s *MinioBlobStore) CompleteMultipartUpload(ctx context.Context, key, uploadID string, parts []BlobPart) (string, error) {
+	completed := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		completed = append(completed, minio.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+	if _, err := s.core.CompleteMultipartUpload(ctx, s.bucketName, key, uploadID, completed, minio.PutObjectOptions{}); err != nil {
+		return "", toBlobError(err)
+	}
+	return s.URL(key), nil
+}
+
+// AbortMultipartUpload 取消分片上传并清理已上传的分片
+func (s *MinioBlobStore) AbortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	return toBlobError(s.core.AbortMultipartUpload(ctx, s.bucketName, key, uploadID))
+}
+
+func toBlobInfo(stat minio.ObjectInfo) *BlobInfo {
+	return &BlobInfo{
+		Key:          stat.Key,
+		Size:         stat.Size,
+		ContentType:  stat.ContentType,
+		ETag:         stat.ETag,
+		LastModified: stat.LastModified,
+	}
+}
+
+// toBlobError 将 MinIO 的错误码转换为通用错误
+func toBlobError(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey":
+		return ErrBlobNotFound
+	case "NoSuchUpload":
+		return ErrUploadNotFound
+	}
+	return err
 }
@@ -1,22 +1,27 @@
 package pkg
 
 import (
+	"fmt"
 	"github.com/google/wire"
 	"video-service/internal/conf"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewMinioUploaderProvider, NewJWTManagerProvider, NewIDGenerator)
+var ProviderSet = wire.NewSet(NewBlobStore, NewJWTManagerProvider, NewIDGenerator)
 
 // NewJWTManagerProvider JWT
 func NewJWTManagerProvider(c *conf.JWT) *JWTManager {
 	return NewJWTManager(c.Secret, c.Issuer, c.Expire)
 }
 
-func NewMinioUploaderProvider(c *conf.Data_MinIO) *MinioUploader {
-	minio, err := NewMinioUploader(c)
-	if err != nil {
-		panic(err)
+// NewBlobStore 按 data.storage.driver 选择对象存储，默认 minio
+func NewBlobStore(c *conf.Data) (BlobStore, error) {
+	switch driver := c.GetStorage().GetDriver(); driver {
+	case "", "minio":
+		return NewMinioBlobStore(c.Minio)
+	case "local":
+		return NewLocalBlobStore(c.GetStorage().GetLocal())
+	default:
+		return nil, fmt.Errorf("unsupported storage driver %q", driver)
 	}
-	return minio
 }
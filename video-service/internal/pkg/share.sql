@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS `video_shares` (
+                                              `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                              `code` VARCHAR(16) NOT NULL COMMENT '分享码',
+                                              `video_id` BIGINT UNSIGNED NOT NULL COMMENT '视频ID',
+                                              `user_id` BIGINT UNSIGNED NOT NULL COMMENT '分享者ID',
+                                              `channel` TINYINT NOT NULL DEFAULT 0 COMMENT '分享渠道',
+                                              `click_cnt` BIGINT NOT NULL DEFAULT 0 COMMENT '去重后的点击数',
+                                              `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '分享时间',
+                                              PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code` (`code`),
+    UNIQUE KEY `uk_video_user_channel` (`video_id`, `user_id`, `channel`),
+    INDEX `idx_user_created` (`user_id`, `created_at`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频分享表';
+
+CREATE TABLE IF NOT EXISTS `share_clicks` (
+                                              `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                              `share_id` BIGINT UNSIGNED NOT NULL COMMENT '分享ID',
+                                              `video_id` BIGINT UNSIGNED NOT NULL COMMENT '视频ID',
+                                              `referrer_id` BIGINT UNSIGNED NOT NULL COMMENT '分享者ID',
+                                              `visitor_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '点击者用户ID，未登录为0',
+                                              `visitor` VARCHAR(128) NOT NULL COMMENT '访客标识，用户id、设备id或ip指纹',
+                                              `source` VARCHAR(16) NOT NULL COMMENT '点击来源 web、app',
+                                              `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '点击时间',
+                                              PRIMARY KEY (`id`),
+    INDEX `idx_share_created` (`share_id`, `created_at`),
+    INDEX `idx_referrer_created` (`referrer_id`, `created_at`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='分享链接点击表';
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `tags` (
+                                      `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                      `name` VARCHAR(64) NOT NULL COMMENT '规范化后的话题名',
+                                      `video_cnt` BIGINT NOT NULL DEFAULT 0 COMMENT '关联视频数',
+                                      `view_cnt` BIGINT NOT NULL DEFAULT 0 COMMENT '话题下视频播放数',
+                                      `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                      `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                      PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_name` (`name`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='话题表';
+
+CREATE TABLE IF NOT EXISTS `video_tags` (
+                                            `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+                                            `video_id` BIGINT UNSIGNED NOT NULL COMMENT '视频ID',
+                                            `tag_id` BIGINT UNSIGNED NOT NULL COMMENT '话题ID',
+                                            `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                            PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_video_tag` (`video_id`, `tag_id`),
+    INDEX `idx_tag_created` (`tag_id`, `created_at`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频话题关联表';
@@ -0,0 +1,5 @@
+-- 话题视频数改为只统计已发布、公开且未删除的视频，之后由 job-service 在视频可见性变化时重算；上线时执行一次修正已有计数
+UPDATE `tags` SET `video_cnt` = (
+    SELECT COUNT(*) FROM `video_tags` vt JOIN `videos` v ON v.`id` = vt.`video_id`
+    WHERE vt.`tag_id` = `tags`.`id` AND v.`publish_status` = 0 AND v.`is_public` = 1 AND v.`delete_at` IS NULL
+);
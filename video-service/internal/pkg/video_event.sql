@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS `processed_events` (
+                                                  `event_id` VARCHAR(64) NOT NULL COMMENT '事件ID',
+                                                  `event_type` VARCHAR(64) NOT NULL COMMENT '事件类型',
+                                                  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '处理时间',
+                                                  PRIMARY KEY (`event_id`),
+    INDEX `idx_created_at` (`created_at`)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='已处理的事件，用于消费去重';
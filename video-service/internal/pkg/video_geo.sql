@@ -0,0 +1,3 @@
+ALTER TABLE `videos`
+    ADD COLUMN `geo_location` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '模糊处理后的坐标 lat,lon，用户授权后才记录' AFTER `publish_at`,
+    ADD COLUMN `city_code` VARCHAR(12) NOT NULL DEFAULT '' COMMENT '城市代码' AFTER `geo_location`;
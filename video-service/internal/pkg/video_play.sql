@@ -0,0 +1,3 @@
+ALTER TABLE `videos`
+    ADD COLUMN `view_cnt` BIGINT NOT NULL DEFAULT 0 COMMENT '播放次数' AFTER `collect_cnt`,
+    ADD KEY `idx_view_cnt` (`view_cnt` DESC);
@@ -0,0 +1,4 @@
+ALTER TABLE `videos`
+    ADD COLUMN `publish_status` TINYINT NOT NULL DEFAULT 0 COMMENT '0已发布 1草稿 2定时发布' AFTER `transcode_status`,
+    ADD COLUMN `publish_at` DATETIME DEFAULT NULL COMMENT '定时发布时间' AFTER `publish_status`,
+    ADD KEY `idx_status_publish_at` (`publish_status`, `publish_at`);
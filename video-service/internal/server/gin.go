@@ -13,5 +13,10 @@ func NewGinServer() *gin.Engine {
 		service.GlobalVideoService.UploadVideoGin(c)
 	})
 
+	// 分享短链跳转
+	r.GET("/s/:code", func(c *gin.Context) {
+		service.GlobalVideoService.ShareRedirectGin(c)
+	})
+
 	return r
 }
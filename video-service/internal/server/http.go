@@ -3,6 +3,7 @@ package server
 import (
 	v1 "video-service/api/video/v1"
 	"video-service/internal/conf"
+	"video-service/internal/pkg"
 	"video-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.VideoService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.VideoService, blob pkg.BlobStore, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -28,5 +29,9 @@ func NewHTTPServer(c *conf.Server, greeter *service.VideoService, logger log.Log
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterVideoServiceHTTPServer(srv, greeter)
+	// 本地存储的文件由本服务提供访问
+	if local, ok := blob.(*pkg.LocalBlobStore); ok {
+		srv.HandlePrefix(local.Prefix(), local)
+	}
 	return srv
 }
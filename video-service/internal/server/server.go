@@ -9,7 +9,7 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRegistry)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewBlobGCServer, NewVideoEventServer, NewRegistry)
 
 func NewRegistry(cfg *conf.Registry) registry.Registrar {
 	c := api.DefaultConfig()
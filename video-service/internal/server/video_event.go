@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"time"
+	"video-service/internal/biz"
+	"video-service/internal/biz/params"
+	"video-service/internal/conf"
+)
+
+const (
+	videoEventRetryMin = 100 * time.Millisecond
+	videoEventRetryMax = 10 * time.Second
+
+	processedEventCleanupInterval = time.Hour
+)
+
+// 事件消息体，与 favorite-service、comment-service 的 outbox.Envelope 对应
+type videoEventEnvelope struct {
+	EventID    string `json:"event_id"`
+	Type       string `json:"type"`
+	OccurredAt int64  `json:"occurred_at"`
+	Data       struct {
+		VideoID   int64 `json:"video_id"`
+		UserID    int64 `json:"user_id"`
+		CommentID int64 `json:"comment_id"`
+	} `json:"data"`
+}
+
+// VideoEventServer 消费点赞、收藏、评论及定时发布事件，维护视频计数与分数
+type VideoEventServer struct {
+	reader    *kafka.Reader
+	uc        *biz.VideoEventUsecase
+	retention time.Duration // 已处理事件的保留时间
+	log       *log.Helper
+}
+
+// NewVideoEventServer new a video event consumer.
+func NewVideoEventServer(c *conf.Data, uc *biz.VideoEventUsecase, logger log.Logger) *VideoEventServer {
+	s := &VideoEventServer{uc: uc, retention: biz.DefaultProcessedEventRetention, log: log.NewHelper(logger)}
+	if c.GetKafka().GetProcessedEventRetention().AsDuration() > 0 {
+		s.retention = c.GetKafka().GetProcessedEventRetention().AsDuration()
+	}
+	if c.GetKafka().GetVideoEventTopic() != "" {
+		s.reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers: c.GetKafka().GetBrokers(),
+			Topic:   c.GetKafka().GetVideoEventTopic(),
+			GroupID: c.GetKafka().GetGroupId(),
+		})
+	}
+	return s
+}
+
+// Start 逐条处理并提交 offset，处理失败时退避重试，保证同一视频的事件按顺序生效
+func (s *VideoEventServer) Start(ctx context.Context) error {
+	if s.reader == nil {
+		return nil
+	}
+	s.log.Info("video event consumer start")
+	go s.cleanupLoop(ctx)
+
+	for {
+		m, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.log.Errorf("fetch video event failed: %v", err)
+			return err
+		}
+		if !s.handle(ctx, m) {
+			return nil
+		}
+		if err := s.reader.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			s.log.Errorf("commit video event offset failed: %v", err)
+		}
+	}
+}
+
+// handle 处理单条消息直到成功，ctx 取消时返回 false
+func (s *VideoEventServer) handle(ctx context.Context, m kafka.Message) bool {
+	env := new(videoEventEnvelope)
+	if err := json.Unmarshal(m.Value, env); err != nil {
+		// 无法解析的消息重试也不会成功，跳过
+		s.log.Errorf("unmarshal video event failed: %v, value: %s", err, string(m.Value))
+		return true
+	}
+	event := &params.VideoEvent{
+		EventID:    env.EventID,
+		Type:       env.Type,
+		OccurredAt: time.UnixMilli(env.OccurredAt),
+		VideoID:    env.Data.VideoID,
+		UserID:     env.Data.UserID,
+		CommentID:  env.Data.CommentID,
+	}
+
+	backoff := videoEventRetryMin
+	for {
+		err := s.uc.HandleEvent(ctx, event)
+		if err == nil {
+			return true
+		}
+		s.log.Errorf("handle video event %s failed, retry in %s: %v", event.EventID, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, videoEventRetryMax)
+	}
+}
+
+// cleanupLoop 定期清理过期的已处理事件
+func (s *VideoEventServer) cleanupLoop(ctx context.Context) {
+	ticker := time.NewTicker(processedEventCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.uc.CleanupProcessedEvents(ctx, s.retention)
+		}
+	}
+}
+
+func (s *VideoEventServer) Stop(ctx context.Context) error {
+	if s.reader == nil {
+		return nil
+	}
+	s.log.Info("video event consumer stop")
+	return s.reader.Close()
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	v1 "video-service/api/video/v1"
+	"video-service/internal/biz/params"
+)
+
+// ReportPlay 上报播放
+func (s *VideoService) ReportPlay(ctx context.Context, in *v1.ReportPlayRequest) (*v1.ReportPlayReply, error) {
+	if in.VideoId <= 0 {
+		return nil, errors.BadRequest("ReportPlay", "invalid params")
+	}
+
+	// token 可选，未登录用户按 device_id 去重
+	var userID int64
+	if in.Token != "" {
+		uid, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = uid
+	}
+
+	counted, err := s.pc.ReportPlay(ctx, params.ReportPlayRequest{
+		VideoID:  in.VideoId,
+		UserID:   userID,
+		DeviceID: in.DeviceId,
+		WatchMs:  in.WatchMs,
+		Finished: in.Finished,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReportPlayReply{Counted: counted}, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+
+	v1 "video-service/api/video/v1"
+	"video-service/internal/biz/params"
+)
+
+// SearchVideos 搜索视频
+func (s *VideoService) SearchVideos(ctx context.Context, in *v1.SearchVideosRequest) (*v1.SearchVideosReply, error) {
+	in.Keyword = strings.TrimSpace(in.Keyword)
+	if in.Keyword == "" {
+		return nil, errors.BadRequest("SearchVideos", "keyword 不能为空")
+	}
+	if in.PageSize <= 0 || in.PageSize > 50 {
+		in.PageSize = 20
+	}
+
+	// token 可选，登录用户记录搜索历史
+	var userID int64
+	if in.Token != "" {
+		uid, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = uid
+	}
+
+	p := params.SearchVideosRequest{
+		Keyword:      in.Keyword,
+		MinDuration:  in.MinDuration,
+		MaxDuration:  in.MaxDuration,
+		OriginalOnly: in.OriginalOnly,
+		Sort:         in.Sort,
+		Cursor:       in.Cursor,
+		PageSize:     in.PageSize,
+		UserID:       userID,
+	}
+	if in.PublishStart != nil {
+		p.PublishStart = in.PublishStart.AsTime()
+	}
+	if in.PublishEnd != nil {
+		p.PublishEnd = in.PublishEnd.AsTime()
+	}
+
+	res, err := s.sc.SearchVideos(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.SearchVideoItem, 0, len(res.Items))
+	for _, item := range res.Items {
+		items = append(items, &v1.SearchVideoItem{Video: item.Video, Highlights: item.Highlights})
+	}
+	return &v1.SearchVideosReply{Items: items, NextCursor: res.NextCursor, HasMore: res.HasMore}, nil
+}
+
+// SuggestQueries 搜索建议
+func (s *VideoService) SuggestQueries(ctx context.Context, in *v1.SuggestQueriesRequest) (*v1.SuggestQueriesReply, error) {
+	if in.Limit <= 0 || in.Limit > 20 {
+		in.Limit = 10
+	}
+	suggestions, err := s.sc.SuggestQueries(ctx, in.Prefix, int(in.Limit))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*v1.Suggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		res = append(res, &v1.Suggestion{Text: sg.Text, Type: sg.Type})
+	}
+	return &v1.SuggestQueriesReply{Suggestions: res}, nil
+}
+
+// ListSearchHistory 获取搜索历史
+func (s *VideoService) ListSearchHistory(ctx context.Context, in *v1.ListSearchHistoryRequest) (*v1.ListSearchHistoryReply, error) {
+	userID, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	keywords, err := s.sc.ListSearchHistory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListSearchHistoryReply{Keywords: keywords}, nil
+}
+
+// ClearSearchHistory 清除搜索历史
+func (s *VideoService) ClearSearchHistory(ctx context.Context, in *v1.ClearSearchHistoryRequest) (*v1.ClearSearchHistoryReply, error) {
+	userID, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sc.ClearSearchHistory(ctx, userID, in.Keyword); err != nil {
+		return nil, err
+	}
+	return &v1.ClearSearchHistoryReply{}, nil
+}
+
+// ListHotSearches 热搜榜
+func (s *VideoService) ListHotSearches(ctx context.Context, in *v1.ListHotSearchesRequest) (*v1.ListHotSearchesReply, error) {
+	if in.Limit <= 0 || in.Limit > 50 {
+		in.Limit = 20
+	}
+	hots, err := s.sc.ListHotSearches(ctx, int64(in.Limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.HotSearch, 0, len(hots))
+	for _, h := range hots {
+		items = append(items, &v1.HotSearch{Keyword: h.Keyword, Score: h.Score, Pinned: h.Pinned})
+	}
+	return &v1.ListHotSearchesReply{Items: items}, nil
+}
+
+// ManageHotSearch 管理热搜词
+func (s *VideoService) ManageHotSearch(ctx context.Context, in *v1.ManageHotSearchRequest) (*v1.ManageHotSearchReply, error) {
+	userID, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sc.ManageHotSearch(ctx, userID, in.Keyword, in.Action, in.Position); err != nil {
+		return nil, err
+	}
+	return &v1.ManageHotSearchReply{}, nil
+}
+
+// UniversalSearch 综合搜索
+func (s *VideoService) UniversalSearch(ctx context.Context, in *v1.UniversalSearchRequest) (*v1.UniversalSearchReply, error) {
+	in.Keyword = strings.TrimSpace(in.Keyword)
+	if in.Keyword == "" {
+		return nil, errors.BadRequest("UniversalSearch", "keyword 不能为空")
+	}
+	if in.SectionSize <= 0 || in.SectionSize > 20 {
+		in.SectionSize = 5
+	}
+	var userID int64
+	if in.Token != "" {
+		uid, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = uid
+	}
+
+	sections, err := s.sc.UniversalSearch(ctx, in.Keyword, in.SectionSize, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*v1.SearchSection, 0, len(sections))
+	for _, sec := range sections {
+		section := &v1.SearchSection{Type: sec.Type, Score: sec.Score, HasMore: sec.HasMore}
+		for _, item := range sec.Videos {
+			section.Videos = append(section.Videos, &v1.SearchVideoItem{Video: item.Video, Highlights: item.Highlights})
+		}
+		for _, u := range sec.Users {
+			section.Users = append(section.Users, &v1.SearchUser{
+				Id:            u.Id,
+				Name:          u.Name,
+				Avatar:        u.Avatar,
+				Signature:     u.Signature,
+				FollowerCount: u.FollowerCount,
+				WorkCount:     u.WorkCount,
+			})
+		}
+		for _, t := range sec.Tags {
+			section.Tags = append(section.Tags, toTagReply(t))
+		}
+		res = append(res, section)
+	}
+	return &v1.UniversalSearchReply{Sections: res}, nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/go-kratos/kratos/v2/errors"
+	"hash/fnv"
+	"net/http"
+
+	v1 "video-service/api/video/v1"
+	"video-service/internal/biz/params"
+)
+
+// ShareVideo 分享视频
+func (s *VideoService) ShareVideo(ctx context.Context, in *v1.ShareVideoRequest) (*v1.ShareVideoReply, error) {
+	if in.VideoId <= 0 {
+		return nil, errors.BadRequest("ShareVideo", "invalid params")
+	}
+	if _, ok := v1.ShareChannel_name[int32(in.Channel)]; !ok {
+		return nil, errors.BadRequest("ShareVideo", "invalid channel")
+	}
+	userID, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	share, url, err := s.shc.ShareVideo(ctx, userID, in.VideoId, int32(in.Channel))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ShareVideoReply{ShareCode: share.Code, ShareUrl: url}, nil
+}
+
+// ResolveShare 解析分享码
+func (s *VideoService) ResolveShare(ctx context.Context, in *v1.ResolveShareRequest) (*v1.ResolveShareReply, error) {
+	if in.Code == "" {
+		return nil, errors.BadRequest("ResolveShare", "code 不能为空")
+	}
+
+	// token 可选，未登录用户按 device_id 区分访客
+	click := params.ShareClick{Source: "app"}
+	if in.Token != "" {
+		uid, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		click.VisitorID = uid
+		click.Visitor = fmt.Sprintf("u:%d", uid)
+	} else {
+		if in.DeviceId == "" {
+			return nil, errors.BadRequest("ResolveShare", "未登录时 device_id 不能为空")
+		}
+		click.Visitor = "d:" + in.DeviceId
+	}
+
+	share, err := s.shc.ResolveShare(ctx, in.Code, click)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ResolveShareReply{
+		VideoId:    share.VideoID,
+		ReferrerId: share.UserID,
+		Channel:    v1.ShareChannel(share.Channel),
+	}, nil
+}
+
+// ShareRedirectGin 分享短链跳转，网页打开时按 ip 与 UA 区分访客
+func (s *VideoService) ShareRedirectGin(c *gin.Context) {
+	h := fnv.New64a()
+	h.Write([]byte(c.ClientIP() + "|" + c.Request.UserAgent()))
+	click := params.ShareClick{
+		Visitor: fmt.Sprintf("w:%x", h.Sum64()),
+		Source:  "web",
+	}
+
+	share, err := s.shc.ResolveShare(c, c.Param("code"), click)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			c.String(http.StatusNotFound, "分享链接不存在")
+			return
+		}
+		c.String(http.StatusInternalServerError, "服务繁忙，请稍后再试")
+		return
+	}
+	c.Redirect(http.StatusFound, s.shc.LandingURL(share))
+}
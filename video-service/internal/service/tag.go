@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	v1 "video-service/api/video/v1"
+	"video-service/internal/biz/params"
+)
+
+// ListVideosByTag 根据话题获取视频列表
+func (s *VideoService) ListVideosByTag(ctx context.Context, in *v1.ListVideosByTagRequest) (*v1.ListVideosByTagReply, error) {
+	if in.Tag == "" {
+		return nil, errors.BadRequest("ListVideosByTag", "invalid params")
+	}
+	// 分页默认值处理
+	if in.Page <= 0 {
+		in.Page = 1
+	}
+	if in.PageSize <= 0 || in.PageSize > 20 {
+		in.PageSize = 20
+	}
+
+	res, err := s.tc.ListVideosByTag(ctx, params.ListVideosByTagRequest{
+		Tag:      in.Tag,
+		Page:     in.Page,
+		PageSize: in.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	videos := make([]*v1.Video, 0, len(res.Videos))
+	for _, v := range res.Videos {
+		videos = append(videos, &v1.Video{
+			Id:          v.Id,
+			UserId:      v.UserId,
+			PlayUrl:     v.PlayUrl,
+			CoverUrl:    v.CoverUrl,
+			Title:       v.Title,
+			Description: v.Description,
+			Duration:    v.Duration,
+			Tags:        v.Tags,
+			FavoriteCnt: v.FavoriteCnt,
+			CommentCnt:  v.CommentCnt,
+			ShareCnt:    v.ShareCnt,
+			CollectCnt:  v.CollectCnt,
+			ViewCnt:     v.ViewCnt,
+		})
+	}
+	return &v1.ListVideosByTagReply{Tag: toTagReply(res.Tag), Videos: videos, Total: res.Total}, nil
+}
+
+// GetTagInfo 获取话题信息
+func (s *VideoService) GetTagInfo(ctx context.Context, in *v1.GetTagInfoRequest) (*v1.GetTagInfoReply, error) {
+	if in.Tag == "" {
+		return nil, errors.BadRequest("GetTagInfo", "invalid params")
+	}
+	tag, err := s.tc.GetTagInfo(ctx, in.Tag)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetTagInfoReply{Tag: toTagReply(tag)}, nil
+}
+
+// TrendingTags 热门话题
+func (s *VideoService) TrendingTags(ctx context.Context, in *v1.TrendingTagsRequest) (*v1.TrendingTagsReply, error) {
+	if in.Limit <= 0 || in.Limit > 50 {
+		in.Limit = 20
+	}
+	tags, err := s.tc.TrendingTags(ctx, int64(in.Limit))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*v1.Tag, 0, len(tags))
+	for _, t := range tags {
+		res = append(res, toTagReply(t))
+	}
+	return &v1.TrendingTagsReply{Tags: res}, nil
+}
+
+func toTagReply(t *params.Tag) *v1.Tag {
+	return &v1.Tag{
+		Id:         t.Id,
+		Name:       t.Name,
+		VideoCount: t.VideoCount,
+		ViewCount:  t.ViewCount,
+		Score:      t.Score,
+	}
+}
@@ -9,23 +9,30 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"io"
 	"net/http"
+	"time"
 
 	v1 "video-service/api/video/v1"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"video-service/internal/biz"
 	params "video-service/internal/biz/params"
+	"video-service/internal/pkg/consts"
 )
 
 // VideoService is a greeter service.
 type VideoService struct {
 	v1.UnimplementedVideoServiceServer
 
-	uc *biz.VideoUsecase
+	uc  *biz.VideoUsecase
+	tc  *biz.TagUsecase
+	sc  *biz.SearchUsecase
+	pc  *biz.PlayUsecase
+	shc *biz.ShareUsecase
 }
 
 // NewVideoService new a video service.
-func NewVideoService(uc *biz.VideoUsecase) *VideoService {
-	return &VideoService{uc: uc}
+func NewVideoService(uc *biz.VideoUsecase, tc *biz.TagUsecase, sc *biz.SearchUsecase, pc *biz.PlayUsecase, shc *biz.ShareUsecase) *VideoService {
+	return &VideoService{uc: uc, tc: tc, sc: sc, pc: pc, shc: shc}
 }
 
 var GlobalVideoService *VideoService
@@ -49,7 +56,7 @@ func (s *VideoService) UploadVideo(ctx context.Context, in *v1.UploadVideoReques
 	// 3. 构建对象名（加 user_id 防止重复）
 	objectName := fmt.Sprintf("video/%d/%s", userID, in.Filename)
 
-	// 4. 上传到 MinIO（通过依赖注入拿到 uploader）
+	// 4. 上传到对象存储（通过依赖注入拿到 BlobStore）
 	reader := bytes.NewReader(in.Data)
 	playURL, err := s.uc.UploadVideo(ctx, objectName, reader, int64(len(in.Data)), "video/mp4")
 	if err != nil {
@@ -125,6 +132,19 @@ func (s *VideoService) CreateVideo(ctx context.Context, in *v1.CreateVideoReques
 		IsOriginal:  in.IsOriginal,
 		SourceUrl:   in.SourceUrl,
 		UserID:      userID,
+		IsDraft:     in.IsDraft,
+	}
+	if in.PublishAt != nil {
+		p.PublishAt = in.PublishAt.AsTime()
+	}
+	// 用户未授权时忽略位置
+	if loc := in.GetLocation(); loc != nil && in.LocationConsent {
+		p.Location = &params.Location{
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			HasCoord:  loc.Latitude != 0 || loc.Longitude != 0,
+			CityCode:  loc.CityCode,
+		}
 	}
 	// 2. 创建视频
 	videoID, err := s.uc.CreateVideo(ctx, p)
@@ -134,6 +154,68 @@ func (s *VideoService) CreateVideo(ctx context.Context, in *v1.CreateVideoReques
 	return &v1.CreateVideoReply{VideoId: videoID}, nil
 }
 
+// PublishVideo 发布草稿或设置定时发布
+func (s *VideoService) PublishVideo(ctx context.Context, in *v1.PublishVideoRequest) (*v1.PublishVideoReply, error) {
+	if in.VideoId == 0 {
+		return nil, errors.BadRequest("PublishVideo", "invalid params")
+	}
+	userID, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var publishAt time.Time
+	if in.PublishAt != nil {
+		publishAt = in.PublishAt.AsTime()
+	}
+	status, at, err := s.uc.PublishVideo(ctx, userID, in.VideoId, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PublishVideoReply{PublishStatus: status, PublishAt: timestamppb.New(at)}, nil
+}
+
+// ListDraftVideos 获取自己的草稿与定时发布视频
+func (s *VideoService) ListDraftVideos(ctx context.Context, in *v1.ListDraftVideosRequest) (*v1.ListDraftVideosReply, error) {
+	userID, err := s.uc.ParseToken(ctx, in.Token, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 分页默认值处理
+	if in.Page <= 0 {
+		in.Page = 1
+	}
+	if in.PageSize <= 0 || in.PageSize > 10 {
+		in.PageSize = 10
+	}
+
+	drafts, total, err := s.uc.ListDraftVideos(ctx, userID, in.Page, in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	videos := make([]*v1.Video, 0, len(drafts))
+	for _, v := range drafts {
+		video := &v1.Video{
+			Id:            v.Id,
+			UserId:        v.UserId,
+			PlayUrl:       v.PlayUrl,
+			CoverUrl:      v.CoverUrl,
+			Title:         v.Title,
+			Description:   v.Description,
+			Duration:      v.Duration,
+			Tags:          v.Tags,
+			PublishStatus: v.PublishStatus,
+			UpdateTime:    timestamppb.New(v.UpdateTime),
+		}
+		if v.PublishStatus == consts.PublishStatusScheduled {
+			video.PublishAt = timestamppb.New(v.PublishAt)
+		}
+		videos = append(videos, video)
+	}
+	return &v1.ListDraftVideosReply{Videos: videos, Total: total}, nil
+}
+
 // ListUserVideos 获取用户的视频列表
 func (s *VideoService) ListUserVideos(ctx context.Context, in *v1.ListUserVideosRequest) (*v1.ListUserVideosReply, error) {
 	// 1. 参数校验
@@ -180,6 +262,7 @@ func (s *VideoService) ListUserVideos(ctx context.Context, in *v1.ListUserVideos
 			CommentCnt:  v.CommentCnt,
 			ShareCnt:    v.ShareCnt,
 			CollectCnt:  v.CollectCnt,
+			ViewCnt:     v.ViewCnt,
 		})
 	}
 
@@ -208,32 +291,29 @@ func (s *VideoService) BatchGetVideoInfo(ctx context.Context, in *v1.BatchGetVid
 	return &v1.BatchGetVideoInfoReply{Videos: videos}, nil
 }
 
-// CheckVideoExists 检查视频是否存在
+// CheckVideoExists 检查视频是否存在，以及 viewer_id 能否看到
 func (s *VideoService) CheckVideoExists(ctx context.Context, in *v1.CheckVideoExistsRequest) (*v1.CheckVideoExistsReply, error) {
 	if in.VideoId == 0 {
 		return nil, errors.BadRequest("CheckVideoExists", "invalid params")
 	}
-	exist, err := s.uc.CheckVideoExistsByID(ctx, in.VideoId)
+	exist, visible, err := s.uc.CheckVideoVisible(ctx, in.VideoId, in.ViewerId)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.CheckVideoExistsReply{Exist: exist}, nil
-}
-
-func (s *VideoService) CalcVideoScore(ctx context.Context, req *v1.CalcVideoScoreRequest) (*v1.CalcVideoScoreReply, error) {
-	score := s.uc.CalcVideoScore(ctx, req.FavoriteCount, req.CommentCount, req.UploadTime)
-	return &v1.CalcVideoScoreReply{Score: float32(score)}, nil
+	return &v1.CheckVideoExistsReply{Exist: exist, Visible: visible}, nil
 }
 
 func (s *VideoService) GetVideoFavoriteAndCommentCount(ctx context.Context, req *v1.GetVideoFavoriteAndCommentCountRequest) (*v1.GetVideoFavoriteAndCommentCountReply, error) {
-	favoriteCount, commentCount, uploadTime, err := s.uc.GetVideoFavoriteAndCommentCount(ctx, req.VideoId)
+	stats, err := s.uc.GetVideoFavoriteAndCommentCount(ctx, req.VideoId)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.GetVideoFavoriteAndCommentCountReply{
-		FavoriteCount: favoriteCount,
-		CommentCount:  commentCount,
-		UploadTime:    &timestamp.Timestamp{Seconds: uploadTime.Unix()},
+		FavoriteCount: stats.FavoriteCnt,
+		CommentCount:  stats.CommentCnt,
+		UploadTime:    &timestamp.Timestamp{Seconds: stats.UploadTime.Unix()},
+		ViewCount:     stats.ViewCnt,
+		ShareCount:    stats.ShareCnt,
 	}, nil
 }
 